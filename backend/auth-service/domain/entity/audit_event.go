@@ -30,6 +30,13 @@ const (
 	EventAccountDeleted AuditEventType = "account_deleted"
 	EventPasswordReset  AuditEventType = "password_reset"
 	EventAccountLinked  AuditEventType = "account_linked"
+
+	// Workspace management events
+	EventWorkspaceCreated       AuditEventType = "workspace_created"
+	EventWorkspaceRenamed       AuditEventType = "workspace_renamed"
+	EventWorkspaceMemberAdded   AuditEventType = "workspace_member_added"
+	EventWorkspaceMemberRemoved AuditEventType = "workspace_member_removed"
+	EventWorkspaceRoleChanged   AuditEventType = "workspace_role_changed"
 )
 
 // IsValid checks if the event type is valid
@@ -37,7 +44,9 @@ func (e AuditEventType) IsValid() bool {
 	switch e {
 	case EventUserRegistered, EventUserLogin, EventUserLogout,
 		EventTokenRefresh, EventSessionRevoked, EventAccountDeleted,
-		EventPasswordReset, EventAccountLinked:
+		EventPasswordReset, EventAccountLinked,
+		EventWorkspaceCreated, EventWorkspaceRenamed, EventWorkspaceMemberAdded,
+		EventWorkspaceMemberRemoved, EventWorkspaceRoleChanged:
 		return true
 	default:
 		return false
@@ -42,6 +42,31 @@ func (r WorkspaceRole) IsValid() bool {
 	}
 }
 
+// Permission represents an action a user can take on a workspace resource
+type Permission string
+
+const (
+	PermissionRead   Permission = "read"
+	PermissionEdit   Permission = "edit"
+	PermissionDelete Permission = "delete"
+	PermissionManage Permission = "manage" // rename workspace, manage members and roles
+)
+
+// Allows checks if the role grants the permission
+// viewer: read only, member: read and edit, admin: everything
+func (r WorkspaceRole) Allows(p Permission) bool {
+	switch r {
+	case RoleAdmin:
+		return true
+	case RoleMember:
+		return p == PermissionRead || p == PermissionEdit
+	case RoleViewer:
+		return p == PermissionRead
+	default:
+		return false
+	}
+}
+
 // IsAdmin returns true if the role is admin
 func (wm *WorkspaceMember) IsAdmin() bool {
 	return wm.Role == string(RoleAdmin)
@@ -2,11 +2,15 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"twigger-backend/backend/auth-service/domain/entity"
 
 	"github.com/google/uuid"
 )
 
+// ErrMemberNotFound is returned when a user is not a member of the workspace
+var ErrMemberNotFound = errors.New("member not found in workspace")
+
 // WorkspaceRepository defines the interface for workspace data access
 type WorkspaceRepository interface {
 	// Core CRUD operations
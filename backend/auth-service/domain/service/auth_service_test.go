@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 	"twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/auth-service/domain/repository"
 
 	"github.com/google/uuid"
 )
@@ -170,7 +172,10 @@ func (m *MockWorkspaceRepository) Create(ctx context.Context, workspace *entity.
 }
 
 func (m *MockWorkspaceRepository) GetByID(ctx context.Context, workspaceID uuid.UUID) (*entity.Workspace, error) {
-	return m.workspaces[workspaceID.String()], nil
+	if workspace, ok := m.workspaces[workspaceID.String()]; ok {
+		return workspace, nil
+	}
+	return nil, fmt.Errorf("workspace not found: %s", workspaceID)
 }
 
 func (m *MockWorkspaceRepository) GetByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*entity.Workspace, error) {
@@ -178,6 +183,10 @@ func (m *MockWorkspaceRepository) GetByOwnerID(ctx context.Context, ownerID uuid
 }
 
 func (m *MockWorkspaceRepository) Update(ctx context.Context, workspace *entity.Workspace) error {
+	if _, ok := m.workspaces[workspace.WorkspaceID.String()]; !ok {
+		return fmt.Errorf("workspace not found: %s", workspace.WorkspaceID)
+	}
+	m.workspaces[workspace.WorkspaceID.String()] = workspace
 	return nil
 }
 
@@ -187,12 +196,26 @@ func (m *MockWorkspaceRepository) Delete(ctx context.Context, workspaceID uuid.U
 
 func (m *MockWorkspaceRepository) AddMember(ctx context.Context, member *entity.WorkspaceMember) error {
 	key := member.WorkspaceID.String()
+	// Upsert, matching ON CONFLICT DO UPDATE in the Postgres repository
+	for _, existing := range m.members[key] {
+		if existing.UserID == member.UserID {
+			existing.Role = member.Role
+			return nil
+		}
+	}
 	m.members[key] = append(m.members[key], member)
 	return nil
 }
 
 func (m *MockWorkspaceRepository) RemoveMember(ctx context.Context, workspaceID, userID uuid.UUID) error {
-	return nil
+	key := workspaceID.String()
+	for i, member := range m.members[key] {
+		if member.UserID == userID {
+			m.members[key] = append(m.members[key][:i], m.members[key][i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("member not found in workspace")
 }
 
 func (m *MockWorkspaceRepository) GetMembers(ctx context.Context, workspaceID uuid.UUID) ([]*entity.WorkspaceMember, error) {
@@ -200,19 +223,45 @@ func (m *MockWorkspaceRepository) GetMembers(ctx context.Context, workspaceID uu
 }
 
 func (m *MockWorkspaceRepository) GetMemberRole(ctx context.Context, workspaceID, userID uuid.UUID) (string, error) {
-	return "admin", nil
+	for _, member := range m.members[workspaceID.String()] {
+		if member.UserID == userID {
+			return member.Role, nil
+		}
+	}
+	return "", repository.ErrMemberNotFound
 }
 
 func (m *MockWorkspaceRepository) UpdateMemberRole(ctx context.Context, workspaceID, userID uuid.UUID, role string) error {
-	return nil
+	for _, member := range m.members[workspaceID.String()] {
+		if member.UserID == userID {
+			member.Role = role
+			return nil
+		}
+	}
+	return fmt.Errorf("member not found in workspace")
 }
 
 func (m *MockWorkspaceRepository) GetUserWorkspaces(ctx context.Context, userID uuid.UUID) ([]*entity.Workspace, error) {
-	return []*entity.Workspace{}, nil
+	workspaces := []*entity.Workspace{}
+	for key, members := range m.members {
+		for _, member := range members {
+			if member.UserID == userID {
+				if workspace, ok := m.workspaces[key]; ok {
+					workspaces = append(workspaces, workspace)
+				}
+			}
+		}
+	}
+	return workspaces, nil
 }
 
 func (m *MockWorkspaceRepository) IsMember(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error) {
-	return true, nil
+	for _, member := range m.members[workspaceID.String()] {
+		if member.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 // MockSessionRepository is a mock implementation of SessionRepository
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/auth-service/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrForbidden is returned when the caller lacks permission on a resource
+	ErrForbidden = errors.New("forbidden: insufficient permissions")
+
+	// ErrUserNotRegistered is returned when a Firebase UID has no matching user
+	ErrUserNotRegistered = errors.New("user not registered")
+)
+
+// AuthorizationService decides whether a user may act on a workspace-scoped resource
+type AuthorizationService struct {
+	userRepo      repository.UserRepository
+	workspaceRepo repository.WorkspaceRepository
+}
+
+// NewAuthorizationService creates a new AuthorizationService
+func NewAuthorizationService(
+	userRepo repository.UserRepository,
+	workspaceRepo repository.WorkspaceRepository,
+) *AuthorizationService {
+	return &AuthorizationService{
+		userRepo:      userRepo,
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// ResolveUser maps a Firebase UID to the registered user
+func (s *AuthorizationService) ResolveUser(ctx context.Context, firebaseUID string) (*entity.User, error) {
+	if firebaseUID == "" {
+		return nil, ErrUserNotRegistered
+	}
+
+	user, err := s.userRepo.GetByFirebaseUID(ctx, firebaseUID)
+	if err != nil || user == nil {
+		return nil, ErrUserNotRegistered
+	}
+
+	if !user.IsActive() {
+		return nil, ErrUserNotRegistered
+	}
+
+	return user, nil
+}
+
+// Authorize checks whether a user may perform an action on a resource.
+// Owners have full access; otherwise the user's role in the resource's workspace decides.
+func (s *AuthorizationService) Authorize(
+	ctx context.Context,
+	userID uuid.UUID,
+	ownerID uuid.UUID,
+	workspaceID *uuid.UUID,
+	permission entity.Permission,
+) error {
+	if userID == ownerID {
+		return nil
+	}
+
+	if workspaceID == nil {
+		return ErrForbidden
+	}
+
+	role, err := s.GetRole(ctx, *workspaceID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !role.Allows(permission) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// GetRole returns the user's role in a workspace, or ErrForbidden if they are not a member
+func (s *AuthorizationService) GetRole(ctx context.Context, workspaceID, userID uuid.UUID) (entity.WorkspaceRole, error) {
+	role, err := s.workspaceRepo.GetMemberRole(ctx, workspaceID, userID)
+	if errors.Is(err, repository.ErrMemberNotFound) {
+		// Non-members get the same response as under-privileged members
+		return "", ErrForbidden
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get workspace role: %w", err)
+	}
+
+	workspaceRole := entity.WorkspaceRole(role)
+	if !workspaceRole.IsValid() {
+		return "", fmt.Errorf("invalid workspace role: %s", role)
+	}
+
+	return workspaceRole, nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/auth-service/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorizationService_ResolveUser(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	service := NewAuthorizationService(userRepo, NewMockWorkspaceRepository())
+
+	firebaseUID := "firebase-uid-123"
+	user := &entity.User{UserID: uuid.New(), FirebaseUID: &firebaseUID, Email: "owner@example.com"}
+	require.NoError(t, userRepo.Create(ctx, user))
+
+	t.Run("registered user", func(t *testing.T) {
+		resolved, err := service.ResolveUser(ctx, firebaseUID)
+		require.NoError(t, err)
+		assert.Equal(t, user.UserID, resolved.UserID)
+	})
+
+	t.Run("unknown firebase uid", func(t *testing.T) {
+		_, err := service.ResolveUser(ctx, "unknown-uid")
+		assert.ErrorIs(t, err, ErrUserNotRegistered)
+	})
+
+	t.Run("empty firebase uid", func(t *testing.T) {
+		_, err := service.ResolveUser(ctx, "")
+		assert.ErrorIs(t, err, ErrUserNotRegistered)
+	})
+}
+
+func TestAuthorizationService_Authorize(t *testing.T) {
+	ctx := context.Background()
+	workspaceRepo := NewMockWorkspaceRepository()
+	service := NewAuthorizationService(NewMockUserRepository(), workspaceRepo)
+
+	ownerID := uuid.New()
+	workspaceID := uuid.New()
+	viewerID, memberID, adminID, outsiderID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	for userID, role := range map[uuid.UUID]entity.WorkspaceRole{
+		viewerID: entity.RoleViewer,
+		memberID: entity.RoleMember,
+		adminID:  entity.RoleAdmin,
+	} {
+		require.NoError(t, workspaceRepo.AddMember(ctx, &entity.WorkspaceMember{
+			WorkspaceID: workspaceID,
+			UserID:      userID,
+			Role:        string(role),
+		}))
+	}
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		workspaceID *uuid.UUID
+		permission  entity.Permission
+		allowed     bool
+	}{
+		{"owner can delete without workspace", ownerID, nil, entity.PermissionDelete, true},
+		{"non-owner without workspace is forbidden", memberID, nil, entity.PermissionRead, false},
+		{"viewer can read", viewerID, &workspaceID, entity.PermissionRead, true},
+		{"viewer cannot edit", viewerID, &workspaceID, entity.PermissionEdit, false},
+		{"member can edit", memberID, &workspaceID, entity.PermissionEdit, true},
+		{"member cannot delete", memberID, &workspaceID, entity.PermissionDelete, false},
+		{"admin can delete", adminID, &workspaceID, entity.PermissionDelete, true},
+		{"outsider cannot read", outsiderID, &workspaceID, entity.PermissionRead, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.Authorize(ctx, tt.userID, ownerID, tt.workspaceID, tt.permission)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
+		})
+	}
+}
+
+// failingWorkspaceRepository fails every member role lookup
+type failingWorkspaceRepository struct {
+	repository.WorkspaceRepository
+}
+
+func (r *failingWorkspaceRepository) GetMemberRole(ctx context.Context, workspaceID, userID uuid.UUID) (string, error) {
+	return "", errors.New("connection refused")
+}
+
+func TestAuthorizationService_GetRole_RepositoryError(t *testing.T) {
+	service := NewAuthorizationService(NewMockUserRepository(), &failingWorkspaceRepository{})
+
+	_, err := service.GetRole(context.Background(), uuid.New(), uuid.New())
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrForbidden, "a failed lookup is not a permission decision")
+	assert.Contains(t, err.Error(), "connection refused")
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/auth-service/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+const maxWorkspaceNameLength = 200
+
+var (
+	// ErrWorkspaceOwnerRole is returned when an operation would demote or remove the workspace owner
+	ErrWorkspaceOwnerRole = errors.New("invalid operation: the workspace owner must remain an admin")
+
+	// ErrAlreadyMember is returned when inviting a user who already belongs to the workspace
+	ErrAlreadyMember = errors.New("invalid invitation: user is already a workspace member")
+)
+
+// WorkspaceService handles workspace and membership management
+type WorkspaceService struct {
+	workspaceRepo repository.WorkspaceRepository
+	userRepo      repository.UserRepository
+	auditRepo     repository.AuditRepository
+	authz         *AuthorizationService
+}
+
+// NewWorkspaceService creates a new WorkspaceService
+func NewWorkspaceService(
+	workspaceRepo repository.WorkspaceRepository,
+	userRepo repository.UserRepository,
+	auditRepo repository.AuditRepository,
+) *WorkspaceService {
+	return &WorkspaceService{
+		workspaceRepo: workspaceRepo,
+		userRepo:      userRepo,
+		auditRepo:     auditRepo,
+		authz:         NewAuthorizationService(userRepo, workspaceRepo),
+	}
+}
+
+// CreateWorkspace creates a workspace owned by the user, who becomes its admin
+func (s *WorkspaceService) CreateWorkspace(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	name string,
+	ipAddress *string,
+	userAgent *string,
+) (*entity.Workspace, error) {
+	name, err := validateWorkspaceName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := &entity.Workspace{
+		WorkspaceID: uuid.New(),
+		OwnerID:     ownerID,
+		Name:        name,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := s.workspaceRepo.Create(ctx, workspace); err != nil {
+		return nil, fmt.Errorf("failed to create workspace: %w", err)
+	}
+
+	// The trigger in migration 008 also adds the owner as admin; AddMember is an upsert
+	if err := s.workspaceRepo.AddMember(ctx, &entity.WorkspaceMember{
+		WorkspaceID: workspace.WorkspaceID,
+		UserID:      ownerID,
+		Role:        string(entity.RoleAdmin),
+		JoinedAt:    time.Now(),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to add workspace owner: %w", err)
+	}
+
+	s.logAuditEvent(ctx, &ownerID, entity.EventWorkspaceCreated, true, ipAddress, userAgent, map[string]interface{}{
+		"workspace_id": workspace.WorkspaceID.String(),
+		"name":         workspace.Name,
+	})
+
+	return workspace, nil
+}
+
+// ListWorkspaces returns every workspace the user belongs to
+func (s *WorkspaceService) ListWorkspaces(ctx context.Context, userID uuid.UUID) ([]*entity.Workspace, error) {
+	workspaces, err := s.workspaceRepo.GetUserWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	if workspaces == nil {
+		workspaces = []*entity.Workspace{}
+	}
+
+	return workspaces, nil
+}
+
+// GetWorkspace returns a workspace the user is a member of
+func (s *WorkspaceService) GetWorkspace(ctx context.Context, userID, workspaceID uuid.UUID) (*entity.Workspace, error) {
+	if err := s.requirePermission(ctx, workspaceID, userID, entity.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	return s.workspaceRepo.GetByID(ctx, workspaceID)
+}
+
+// RenameWorkspace changes a workspace's name (admins only)
+func (s *WorkspaceService) RenameWorkspace(
+	ctx context.Context,
+	userID uuid.UUID,
+	workspaceID uuid.UUID,
+	name string,
+	ipAddress *string,
+	userAgent *string,
+) (*entity.Workspace, error) {
+	name, err := validateWorkspaceName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireManage(ctx, workspaceID, userID, entity.EventWorkspaceRenamed, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousName := workspace.Name
+	workspace.Name = name
+	if err := s.workspaceRepo.Update(ctx, workspace); err != nil {
+		return nil, fmt.Errorf("failed to rename workspace: %w", err)
+	}
+
+	s.logAuditEvent(ctx, &userID, entity.EventWorkspaceRenamed, true, ipAddress, userAgent, map[string]interface{}{
+		"workspace_id":  workspaceID.String(),
+		"previous_name": previousName,
+		"name":          name,
+	})
+
+	return workspace, nil
+}
+
+// ListMembers returns the members of a workspace the user belongs to
+func (s *WorkspaceService) ListMembers(ctx context.Context, userID, workspaceID uuid.UUID) ([]*entity.WorkspaceMember, error) {
+	if err := s.requirePermission(ctx, workspaceID, userID, entity.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	members, err := s.workspaceRepo.GetMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace members: %w", err)
+	}
+
+	if members == nil {
+		members = []*entity.WorkspaceMember{}
+	}
+
+	return members, nil
+}
+
+// InviteMember adds a registered user to a workspace by email (admins only)
+func (s *WorkspaceService) InviteMember(
+	ctx context.Context,
+	userID uuid.UUID,
+	workspaceID uuid.UUID,
+	email string,
+	role entity.WorkspaceRole,
+	ipAddress *string,
+	userAgent *string,
+) (*entity.WorkspaceMember, error) {
+	if !role.IsValid() {
+		return nil, fmt.Errorf("invalid workspace role: %s", role)
+	}
+
+	email = strings.TrimSpace(strings.ToLower(email))
+	if email == "" {
+		return nil, fmt.Errorf("invalid invitation: email is required")
+	}
+
+	if err := s.requireManage(ctx, workspaceID, userID, entity.EventWorkspaceMemberAdded, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+
+	invitee, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil || invitee == nil || !invitee.IsActive() {
+		return nil, fmt.Errorf("user not found: %s", email)
+	}
+
+	// AddMember upserts, so check first to avoid silently changing an existing role
+	isMember, err := s.workspaceRepo.IsMember(ctx, workspaceID, invitee.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check workspace membership: %w", err)
+	}
+	if isMember {
+		return nil, ErrAlreadyMember
+	}
+
+	member := &entity.WorkspaceMember{
+		WorkspaceID: workspaceID,
+		UserID:      invitee.UserID,
+		Role:        string(role),
+		JoinedAt:    time.Now(),
+	}
+
+	if err := s.workspaceRepo.AddMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to add workspace member: %w", err)
+	}
+
+	s.logAuditEvent(ctx, &userID, entity.EventWorkspaceMemberAdded, true, ipAddress, userAgent, map[string]interface{}{
+		"workspace_id": workspaceID.String(),
+		"member_id":    invitee.UserID.String(),
+		"role":         string(role),
+	})
+
+	return member, nil
+}
+
+// RemoveMember removes a member from a workspace.
+// Admins can remove anyone except the owner; any member can remove themselves.
+func (s *WorkspaceService) RemoveMember(
+	ctx context.Context,
+	userID uuid.UUID,
+	workspaceID uuid.UUID,
+	memberID uuid.UUID,
+	ipAddress *string,
+	userAgent *string,
+) error {
+	if userID == memberID {
+		if err := s.requirePermission(ctx, workspaceID, userID, entity.PermissionRead); err != nil {
+			return err
+		}
+	} else if err := s.requireManage(ctx, workspaceID, userID, entity.EventWorkspaceMemberRemoved, ipAddress, userAgent); err != nil {
+		return err
+	}
+
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if workspace.OwnerID == memberID {
+		return ErrWorkspaceOwnerRole
+	}
+
+	if err := s.workspaceRepo.RemoveMember(ctx, workspaceID, memberID); err != nil {
+		return err
+	}
+
+	s.logAuditEvent(ctx, &userID, entity.EventWorkspaceMemberRemoved, true, ipAddress, userAgent, map[string]interface{}{
+		"workspace_id": workspaceID.String(),
+		"member_id":    memberID.String(),
+	})
+
+	return nil
+}
+
+// UpdateMemberRole changes a member's role (admins only)
+func (s *WorkspaceService) UpdateMemberRole(
+	ctx context.Context,
+	userID uuid.UUID,
+	workspaceID uuid.UUID,
+	memberID uuid.UUID,
+	role entity.WorkspaceRole,
+	ipAddress *string,
+	userAgent *string,
+) (*entity.WorkspaceMember, error) {
+	if !role.IsValid() {
+		return nil, fmt.Errorf("invalid workspace role: %s", role)
+	}
+
+	if err := s.requireManage(ctx, workspaceID, userID, entity.EventWorkspaceRoleChanged, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace.OwnerID == memberID && role != entity.RoleAdmin {
+		return nil, ErrWorkspaceOwnerRole
+	}
+
+	previousRole, err := s.workspaceRepo.GetMemberRole(ctx, workspaceID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.workspaceRepo.UpdateMemberRole(ctx, workspaceID, memberID, string(role)); err != nil {
+		return nil, err
+	}
+
+	s.logAuditEvent(ctx, &userID, entity.EventWorkspaceRoleChanged, true, ipAddress, userAgent, map[string]interface{}{
+		"workspace_id":  workspaceID.String(),
+		"member_id":     memberID.String(),
+		"previous_role": previousRole,
+		"role":          string(role),
+	})
+
+	return &entity.WorkspaceMember{
+		WorkspaceID: workspaceID,
+		UserID:      memberID,
+		Role:        string(role),
+	}, nil
+}
+
+// requirePermission checks the user's workspace role via GetMemberRole
+func (s *WorkspaceService) requirePermission(ctx context.Context, workspaceID, userID uuid.UUID, permission entity.Permission) error {
+	role, err := s.authz.GetRole(ctx, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !role.Allows(permission) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// requireManage checks the user may manage the workspace, auditing denied attempts
+func (s *WorkspaceService) requireManage(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	userID uuid.UUID,
+	eventType entity.AuditEventType,
+	ipAddress *string,
+	userAgent *string,
+) error {
+	if err := s.requirePermission(ctx, workspaceID, userID, entity.PermissionManage); err != nil {
+		s.logAuditEvent(ctx, &userID, eventType, false, ipAddress, userAgent, map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"error":        err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+// logAuditEvent logs an audit event (non-blocking, best effort)
+func (s *WorkspaceService) logAuditEvent(
+	ctx context.Context,
+	userID *uuid.UUID,
+	eventType entity.AuditEventType,
+	success bool,
+	ipAddress *string,
+	userAgent *string,
+	metadata map[string]interface{},
+) {
+	event := &entity.AuditEvent{
+		UserID:    userID,
+		EventType: eventType,
+		Success:   success,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Metadata:  metadata,
+		CreatedAt: time.Now(),
+	}
+
+	// Log event (ignore errors to prevent blocking workspace operations)
+	_ = s.auditRepo.LogEvent(ctx, event)
+}
+
+// validateWorkspaceName trims and validates a workspace name
+func validateWorkspaceName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("invalid workspace name: name is required")
+	}
+	if len(name) > maxWorkspaceNameLength {
+		return "", fmt.Errorf("invalid workspace name: must be %d characters or fewer", maxWorkspaceNameLength)
+	}
+	return name, nil
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"twigger-backend/backend/auth-service/domain/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupWorkspaceService creates a service with an owner and a workspace they own
+func setupWorkspaceService(t *testing.T) (*WorkspaceService, *MockUserRepository, *MockWorkspaceRepository, *MockAuditRepository, *entity.Workspace) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	workspaceRepo := NewMockWorkspaceRepository()
+	auditRepo := NewMockAuditRepository()
+	service := NewWorkspaceService(workspaceRepo, userRepo, auditRepo)
+
+	owner := &entity.User{UserID: uuid.New(), Email: "owner@example.com"}
+	require.NoError(t, userRepo.Create(ctx, owner))
+
+	workspace, err := service.CreateWorkspace(ctx, owner.UserID, "Family Garden", nil, nil)
+	require.NoError(t, err)
+
+	return service, userRepo, workspaceRepo, auditRepo, workspace
+}
+
+// addUser registers a user and optionally adds them to the workspace with a role
+func addUser(t *testing.T, userRepo *MockUserRepository, workspaceRepo *MockWorkspaceRepository, workspaceID uuid.UUID, email string, role entity.WorkspaceRole) uuid.UUID {
+	ctx := context.Background()
+	user := &entity.User{UserID: uuid.New(), Email: email}
+	require.NoError(t, userRepo.Create(ctx, user))
+
+	if role != "" {
+		require.NoError(t, workspaceRepo.AddMember(ctx, &entity.WorkspaceMember{
+			WorkspaceID: workspaceID,
+			UserID:      user.UserID,
+			Role:        string(role),
+		}))
+	}
+
+	return user.UserID
+}
+
+func TestWorkspaceService_CreateWorkspace(t *testing.T) {
+	ctx := context.Background()
+	service, _, workspaceRepo, auditRepo, workspace := setupWorkspaceService(t)
+
+	assert.Equal(t, "Family Garden", workspace.Name)
+
+	role, err := workspaceRepo.GetMemberRole(ctx, workspace.WorkspaceID, workspace.OwnerID)
+	require.NoError(t, err)
+	assert.Equal(t, string(entity.RoleAdmin), role)
+
+	require.Len(t, auditRepo.events, 1)
+	assert.Equal(t, entity.EventWorkspaceCreated, auditRepo.events[0].EventType)
+	assert.True(t, auditRepo.events[0].Success)
+
+	t.Run("empty name is rejected", func(t *testing.T) {
+		_, err := service.CreateWorkspace(ctx, workspace.OwnerID, "   ", nil, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestWorkspaceService_RenameWorkspace(t *testing.T) {
+	ctx := context.Background()
+	service, userRepo, workspaceRepo, auditRepo, workspace := setupWorkspaceService(t)
+	memberID := addUser(t, userRepo, workspaceRepo, workspace.WorkspaceID, "member@example.com", entity.RoleMember)
+
+	t.Run("admin can rename", func(t *testing.T) {
+		renamed, err := service.RenameWorkspace(ctx, workspace.OwnerID, workspace.WorkspaceID, "Allotment", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "Allotment", renamed.Name)
+
+		last := auditRepo.events[len(auditRepo.events)-1]
+		assert.Equal(t, entity.EventWorkspaceRenamed, last.EventType)
+		assert.Equal(t, "Family Garden", last.Metadata["previous_name"])
+	})
+
+	t.Run("member cannot rename", func(t *testing.T) {
+		_, err := service.RenameWorkspace(ctx, memberID, workspace.WorkspaceID, "Hijacked", nil, nil)
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		last := auditRepo.events[len(auditRepo.events)-1]
+		assert.Equal(t, entity.EventWorkspaceRenamed, last.EventType)
+		assert.False(t, last.Success)
+	})
+}
+
+func TestWorkspaceService_InviteMember(t *testing.T) {
+	ctx := context.Background()
+	service, userRepo, workspaceRepo, auditRepo, workspace := setupWorkspaceService(t)
+	viewerID := addUser(t, userRepo, workspaceRepo, workspace.WorkspaceID, "viewer@example.com", entity.RoleViewer)
+	inviteeID := addUser(t, userRepo, workspaceRepo, workspace.WorkspaceID, "invitee@example.com", "")
+
+	t.Run("admin invites registered user", func(t *testing.T) {
+		member, err := service.InviteMember(ctx, workspace.OwnerID, workspace.WorkspaceID, "Invitee@Example.com", entity.RoleMember, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, inviteeID, member.UserID)
+		assert.Equal(t, string(entity.RoleMember), member.Role)
+
+		last := auditRepo.events[len(auditRepo.events)-1]
+		assert.Equal(t, entity.EventWorkspaceMemberAdded, last.EventType)
+		assert.True(t, last.Success)
+	})
+
+	t.Run("existing member is rejected", func(t *testing.T) {
+		_, err := service.InviteMember(ctx, workspace.OwnerID, workspace.WorkspaceID, "viewer@example.com", entity.RoleAdmin, nil, nil)
+		assert.ErrorIs(t, err, ErrAlreadyMember)
+
+		role, err := workspaceRepo.GetMemberRole(ctx, workspace.WorkspaceID, viewerID)
+		require.NoError(t, err)
+		assert.Equal(t, string(entity.RoleViewer), role)
+	})
+
+	t.Run("viewer cannot invite", func(t *testing.T) {
+		_, err := service.InviteMember(ctx, viewerID, workspace.WorkspaceID, "someone@example.com", entity.RoleMember, nil, nil)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		_, err := service.InviteMember(ctx, workspace.OwnerID, workspace.WorkspaceID, "nobody@example.com", entity.RoleMember, nil, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		_, err := service.InviteMember(ctx, workspace.OwnerID, workspace.WorkspaceID, "invitee@example.com", "superuser", nil, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestWorkspaceService_UpdateMemberRole(t *testing.T) {
+	ctx := context.Background()
+	service, userRepo, workspaceRepo, auditRepo, workspace := setupWorkspaceService(t)
+	memberID := addUser(t, userRepo, workspaceRepo, workspace.WorkspaceID, "member@example.com", entity.RoleMember)
+
+	t.Run("admin promotes member", func(t *testing.T) {
+		_, err := service.UpdateMemberRole(ctx, workspace.OwnerID, workspace.WorkspaceID, memberID, entity.RoleAdmin, nil, nil)
+		require.NoError(t, err)
+
+		role, err := workspaceRepo.GetMemberRole(ctx, workspace.WorkspaceID, memberID)
+		require.NoError(t, err)
+		assert.Equal(t, string(entity.RoleAdmin), role)
+
+		last := auditRepo.events[len(auditRepo.events)-1]
+		assert.Equal(t, entity.EventWorkspaceRoleChanged, last.EventType)
+		assert.Equal(t, string(entity.RoleMember), last.Metadata["previous_role"])
+	})
+
+	t.Run("owner cannot be demoted", func(t *testing.T) {
+		_, err := service.UpdateMemberRole(ctx, memberID, workspace.WorkspaceID, workspace.OwnerID, entity.RoleViewer, nil, nil)
+		assert.ErrorIs(t, err, ErrWorkspaceOwnerRole)
+	})
+}
+
+func TestWorkspaceService_RemoveMember(t *testing.T) {
+	ctx := context.Background()
+	service, userRepo, workspaceRepo, auditRepo, workspace := setupWorkspaceService(t)
+	memberID := addUser(t, userRepo, workspaceRepo, workspace.WorkspaceID, "member@example.com", entity.RoleMember)
+	viewerID := addUser(t, userRepo, workspaceRepo, workspace.WorkspaceID, "viewer@example.com", entity.RoleViewer)
+
+	t.Run("member cannot remove others", func(t *testing.T) {
+		err := service.RemoveMember(ctx, memberID, workspace.WorkspaceID, viewerID, nil, nil)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("member can leave", func(t *testing.T) {
+		require.NoError(t, service.RemoveMember(ctx, memberID, workspace.WorkspaceID, memberID, nil, nil))
+
+		isMember, err := workspaceRepo.IsMember(ctx, workspace.WorkspaceID, memberID)
+		require.NoError(t, err)
+		assert.False(t, isMember)
+	})
+
+	t.Run("admin removes viewer", func(t *testing.T) {
+		require.NoError(t, service.RemoveMember(ctx, workspace.OwnerID, workspace.WorkspaceID, viewerID, nil, nil))
+
+		last := auditRepo.events[len(auditRepo.events)-1]
+		assert.Equal(t, entity.EventWorkspaceMemberRemoved, last.EventType)
+		assert.True(t, last.Success)
+	})
+
+	t.Run("owner cannot be removed", func(t *testing.T) {
+		err := service.RemoveMember(ctx, workspace.OwnerID, workspace.WorkspaceID, workspace.OwnerID, nil, nil)
+		assert.ErrorIs(t, err, ErrWorkspaceOwnerRole)
+	})
+
+	t.Run("outsider cannot list members", func(t *testing.T) {
+		_, err := service.ListMembers(ctx, memberID, workspace.WorkspaceID)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
@@ -275,7 +275,7 @@ func (r *PostgresWorkspaceRepository) GetMemberRole(ctx context.Context, workspa
 	err := r.db.QueryRowContext(ctx, query, workspaceID, userID).Scan(&role)
 
 	if err == sql.ErrNoRows {
-		return "", fmt.Errorf("member not found in workspace")
+		return "", repository.ErrMemberNotFound
 	}
 	if err != nil {
 		return "", fmt.Errorf("failed to get member role: %w", err)
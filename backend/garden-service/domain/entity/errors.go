@@ -101,3 +101,22 @@ func NewInvalidInputError(field, message string) error {
 		Message: message,
 	}
 }
+
+// PlacementRejectedError is returned when a placement check refuses a placement.
+// Warnings explain each conflict that caused the rejection.
+type PlacementRejectedError struct {
+	Message  string
+	Warnings []PlacementWarning
+}
+
+func (e *PlacementRejectedError) Error() string {
+	return fmt.Sprintf("invalid placement: %s", e.Message)
+}
+
+// NewPlacementRejectedError creates a new PlacementRejectedError
+func NewPlacementRejectedError(message string, warnings []PlacementWarning) error {
+	return &PlacementRejectedError{
+		Message:  message,
+		Warnings: warnings,
+	}
+}
@@ -35,6 +35,7 @@ const (
 type Garden struct {
 	GardenID       string      `json:"garden_id"`
 	UserID         string      `json:"user_id"`
+	WorkspaceID    *string     `json:"workspace_id,omitempty"` // Shared with workspace members when set
 	GardenName     string      `json:"garden_name"`
 
 	// Spatial data - stored as GeoJSON strings
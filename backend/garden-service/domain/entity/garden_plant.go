@@ -42,6 +42,32 @@ type GardenPlant struct {
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	// Advice from placement checks when the plant was placed (not persisted)
+	Warnings             []PlacementWarning   `json:"warnings,omitempty"`
+	BeneficialNeighbours []PlacementNeighbour `json:"beneficial_neighbours,omitempty"`
+
+	// Placement options (not persisted)
+	RejectAntagonists bool `json:"-"` // Reject instead of warn when antagonistic plants are nearby
+}
+
+// PlacementWarning is advice about a plant placement that did not prevent it
+type PlacementWarning struct {
+	Code    string                 `json:"code"` // e.g. "crop_rotation"
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// PlacementNeighbour is an existing plant near a placement that has a companion relationship with it
+type PlacementNeighbour struct {
+	GardenPlantID    string   `json:"garden_plant_id"`
+	PlantID          string   `json:"plant_id"`
+	PlantName        string   `json:"plant_name,omitempty"`
+	RelationshipType string   `json:"relationship_type"` // beneficial or antagonistic
+	Benefits         []string `json:"benefits,omitempty"`
+	DistanceM        float64  `json:"distance_m"`
+	OptimalDistanceM *float64 `json:"optimal_distance_m,omitempty"`
+	MaxDistanceM     *float64 `json:"max_distance_m,omitempty"`
 }
 
 // Validate validates the garden plant entity
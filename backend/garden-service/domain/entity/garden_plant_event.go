@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// GardenPlantEventType represents something that happened to a garden plant
+type GardenPlantEventType string
+
+const (
+	EventPlanted       GardenPlantEventType = "planted"
+	EventMoved         GardenPlantEventType = "moved"
+	EventHealthChanged GardenPlantEventType = "health_changed"
+	EventWatered       GardenPlantEventType = "watered"
+	EventHarvested     GardenPlantEventType = "harvested"
+	EventRemoved       GardenPlantEventType = "removed"
+)
+
+// MaxEventNotesLength limits free-text notes on an event
+const MaxEventNotesLength = 2000
+
+// GardenPlantEvent is an append-only history record for a garden plant.
+// Events are kept after the plant itself is removed.
+type GardenPlantEvent struct {
+	EventID       string               `json:"event_id"`
+	GardenPlantID string               `json:"garden_plant_id"`
+	GardenID      string               `json:"garden_id"`
+	PlantID       string               `json:"plant_id"`
+	ZoneID        *string              `json:"zone_id,omitempty"` // Zone at the time of the event
+	EventType     GardenPlantEventType `json:"event_type"`
+	OccurredAt    time.Time            `json:"occurred_at"`
+	Notes         *string              `json:"notes,omitempty"`
+
+	// Event-specific data, e.g. from/to location for moves or health status changes
+	Details map[string]interface{} `json:"details,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IsValid checks if the event type is valid
+func (t GardenPlantEventType) IsValid() bool {
+	switch t {
+	case EventPlanted, EventMoved, EventHealthChanged, EventWatered, EventHarvested, EventRemoved:
+		return true
+	}
+	return false
+}
+
+// IsManual returns true for event types users log directly rather than
+// ones derived from placement changes
+func (t GardenPlantEventType) IsManual() bool {
+	return t == EventWatered || t == EventHarvested
+}
+
+// Validate validates the garden plant event entity
+func (e *GardenPlantEvent) Validate() error {
+	if e.GardenPlantID == "" {
+		return fmt.Errorf("garden_plant_id is required")
+	}
+
+	if e.GardenID == "" {
+		return fmt.Errorf("garden_id is required")
+	}
+
+	if e.PlantID == "" {
+		return fmt.Errorf("plant_id is required")
+	}
+
+	if !e.EventType.IsValid() {
+		return fmt.Errorf("invalid event_type: must be planted, moved, health_changed, watered, harvested, or removed")
+	}
+
+	if e.OccurredAt.IsZero() {
+		return fmt.Errorf("occurred_at is required")
+	}
+
+	if e.Notes != nil && len(*e.Notes) > MaxEventNotesLength {
+		return fmt.Errorf("notes must be %d characters or fewer", MaxEventNotesLength)
+	}
+
+	return nil
+}
+
+// NewGardenPlantEvent creates an event for a garden plant, copying its garden, plant and zone
+func NewGardenPlantEvent(gardenPlant *GardenPlant, eventType GardenPlantEventType, occurredAt time.Time) *GardenPlantEvent {
+	return &GardenPlantEvent{
+		GardenPlantID: gardenPlant.GardenPlantID,
+		GardenID:      gardenPlant.GardenID,
+		PlantID:       gardenPlant.PlantID,
+		ZoneID:        gardenPlant.ZoneID,
+		EventType:     eventType,
+		OccurredAt:    occurredAt,
+		Details:       map[string]interface{}{},
+	}
+}
@@ -18,6 +18,9 @@ type GardenFeatureRepository interface {
 	// Batch operations
 	FindByIDs(ctx context.Context, featureIDs []string) ([]*entity.GardenFeature, error)
 
+	// Spatial validation
+	ValidateFeatureWithinGarden(ctx context.Context, gardenID, featureGeometryGeoJSON string) error
+
 	// For shade calculations (Part 4 dependency)
 	FindFeaturesWithHeight(ctx context.Context, gardenID string) ([]*entity.GardenFeature, error)
 	FindTreesInGarden(ctx context.Context, gardenID string) ([]*entity.GardenFeature, error)
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"twigger-backend/backend/garden-service/domain/entity"
+)
+
+// GardenPlantEventRepository defines the interface for the append-only garden plant event log
+type GardenPlantEventRepository interface {
+	// Append operations (events are never updated or deleted)
+	Create(ctx context.Context, event *entity.GardenPlantEvent) error
+	BulkCreate(ctx context.Context, events []*entity.GardenPlantEvent) error
+
+	// Query operations (newest first)
+	FindByGardenPlantID(ctx context.Context, gardenPlantID string, filter *GardenPlantEventFilter) ([]*entity.GardenPlantEvent, error)
+	CountByGardenPlantID(ctx context.Context, gardenPlantID string, filter *GardenPlantEventFilter) (int, error)
+
+	// FindZonePlantings summarizes which plants were in a zone since the given time,
+	// including plants that have since been removed or moved elsewhere
+	FindZonePlantings(ctx context.Context, zoneID string, since time.Time) ([]*ZonePlanting, error)
+}
+
+// GardenPlantEventFilter defines filters for reading a plant's history
+type GardenPlantEventFilter struct {
+	EventTypes []entity.GardenPlantEventType
+	Limit      int
+	Offset     int
+}
+
+// ZonePlanting is a garden plant's stay in a zone, as seen in the event log
+type ZonePlanting struct {
+	GardenPlantID string
+	PlantID       string
+	FirstSeen     time.Time // Earliest event in the zone
+	LastSeen      time.Time // Latest event in the zone, or the move out of it
+}
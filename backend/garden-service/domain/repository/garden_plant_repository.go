@@ -22,6 +22,9 @@ type GardenPlantRepository interface {
 
 	// Spatial queries
 	CheckPlantSpacing(ctx context.Context, gardenID, locationGeoJSON string, minDistanceM float64) ([]*entity.GardenPlant, error)
+	FindNearby(ctx context.Context, gardenID, locationGeoJSON string, radiusM float64) ([]*NearbyGardenPlant, error)
+	FindClosePairs(ctx context.Context, gardenID string, maxDistanceM float64) ([]*GardenPlantPair, error)
+	PairwiseDistances(ctx context.Context, locationsGeoJSON []string, maxDistanceM float64) ([]*LocationPair, error)
 	FindInZone(ctx context.Context, zoneID string) ([]*entity.GardenPlant, error)
 	ValidatePlantLocation(ctx context.Context, gardenID, locationGeoJSON string) error
 
@@ -33,3 +36,25 @@ type GardenPlantRepository interface {
 	CountByGardenID(ctx context.Context, gardenID string, includeRemoved bool) (int, error)
 	CountByPlantID(ctx context.Context, plantID string) (int, error)
 }
+
+// NearbyGardenPlant is an active garden plant with its distance from a queried location
+type NearbyGardenPlant struct {
+	GardenPlant *entity.GardenPlant
+	DistanceM   float64
+}
+
+// GardenPlantPair is two active plants in a garden and the distance between them
+type GardenPlantPair struct {
+	GardenPlantAID string
+	PlantAID       string
+	GardenPlantBID string
+	PlantBID       string
+	DistanceM      float64
+}
+
+// LocationPair is the distance between two locations, identified by their index in the queried list
+type LocationPair struct {
+	I         int
+	J         int
+	DistanceM float64
+}
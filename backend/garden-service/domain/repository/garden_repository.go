@@ -11,6 +11,8 @@ type GardenRepository interface {
 	Create(ctx context.Context, garden *entity.Garden) error
 	FindByID(ctx context.Context, gardenID string) (*entity.Garden, error)
 	FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*entity.Garden, error)
+	FindByWorkspaceMember(ctx context.Context, userID string, limit, offset int) ([]*entity.Garden, error)
+	FindByWorkspaceID(ctx context.Context, workspaceID string, limit, offset int) ([]*entity.Garden, error)
 	Update(ctx context.Context, garden *entity.Garden) error
 	Delete(ctx context.Context, gardenID string) error
 
@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// TransactionManager runs several repository writes as one unit
+type TransactionManager interface {
+	// WithinTransaction runs fn in a transaction, committing when it returns nil and rolling
+	// back otherwise. Repository calls take part when made with the context passed to fn.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/garden-service/domain/repository"
+)
+
+// GardenFeatureService defines the business logic for managing garden features
+type GardenFeatureService interface {
+	// CreateFeature creates a new feature with validation that it's within garden bounds
+	CreateFeature(ctx context.Context, feature *entity.GardenFeature) (*entity.GardenFeature, error)
+
+	// GetFeature retrieves a feature by ID
+	GetFeature(ctx context.Context, featureID string) (*entity.GardenFeature, error)
+
+	// ListGardenFeatures retrieves all features for a garden, optionally filtered by type
+	ListGardenFeatures(ctx context.Context, gardenID string, featureType *entity.FeatureType) ([]*entity.GardenFeature, error)
+
+	// UpdateFeature updates an existing feature with validation
+	UpdateFeature(ctx context.Context, feature *entity.GardenFeature) (*entity.GardenFeature, error)
+
+	// DeleteFeature deletes a feature
+	DeleteFeature(ctx context.Context, featureID string) error
+}
+
+// gardenFeatureService implements GardenFeatureService
+type gardenFeatureService struct {
+	featureRepo repository.GardenFeatureRepository
+	gardenRepo  repository.GardenRepository
+}
+
+// NewGardenFeatureService creates a new garden feature service instance
+func NewGardenFeatureService(
+	featureRepo repository.GardenFeatureRepository,
+	gardenRepo repository.GardenRepository,
+) GardenFeatureService {
+	return &gardenFeatureService{
+		featureRepo: featureRepo,
+		gardenRepo:  gardenRepo,
+	}
+}
+
+// CreateFeature creates a new feature with validation that it's within garden bounds
+func (s *gardenFeatureService) CreateFeature(ctx context.Context, feature *entity.GardenFeature) (*entity.GardenFeature, error) {
+	// Validate entity
+	if err := feature.Validate(); err != nil {
+		return nil, entity.NewValidationError("garden_feature", err.Error())
+	}
+
+	// Check if garden exists
+	_, err := s.gardenRepo.FindByID(ctx, feature.GardenID)
+	if err != nil {
+		return nil, fmt.Errorf("garden not found: %w", err)
+	}
+
+	// Validate feature is within garden boundary
+	if err := s.featureRepo.ValidateFeatureWithinGarden(ctx, feature.GardenID, feature.GeometryGeoJSON); err != nil {
+		return nil, fmt.Errorf("feature must be within garden boundary: %w", err)
+	}
+
+	// Generate ID if not provided
+	if feature.FeatureID == "" {
+		feature.FeatureID = uuid.New().String()
+	}
+
+	// Set timestamp
+	feature.CreatedAt = time.Now()
+
+	// Create feature
+	if err := s.featureRepo.Create(ctx, feature); err != nil {
+		return nil, fmt.Errorf("failed to create feature: %w", err)
+	}
+
+	return feature, nil
+}
+
+// GetFeature retrieves a feature by ID
+func (s *gardenFeatureService) GetFeature(ctx context.Context, featureID string) (*entity.GardenFeature, error) {
+	if featureID == "" {
+		return nil, entity.NewInvalidInputError("feature_id", "feature ID cannot be empty")
+	}
+
+	feature, err := s.featureRepo.FindByID(ctx, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature: %w", err)
+	}
+
+	return feature, nil
+}
+
+// ListGardenFeatures retrieves all features for a garden, optionally filtered by type
+func (s *gardenFeatureService) ListGardenFeatures(ctx context.Context, gardenID string, featureType *entity.FeatureType) ([]*entity.GardenFeature, error) {
+	if gardenID == "" {
+		return nil, entity.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	// Check if garden exists
+	_, err := s.gardenRepo.FindByID(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("garden not found: %w", err)
+	}
+
+	var features []*entity.GardenFeature
+	if featureType != nil {
+		features, err = s.featureRepo.FindByType(ctx, gardenID, *featureType)
+	} else {
+		features, err = s.featureRepo.FindByGardenID(ctx, gardenID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list features: %w", err)
+	}
+
+	return features, nil
+}
+
+// UpdateFeature updates an existing feature with validation
+func (s *gardenFeatureService) UpdateFeature(ctx context.Context, feature *entity.GardenFeature) (*entity.GardenFeature, error) {
+	// Validate entity
+	if err := feature.Validate(); err != nil {
+		return nil, entity.NewValidationError("garden_feature", err.Error())
+	}
+
+	// Check if feature exists
+	existing, err := s.featureRepo.FindByID(ctx, feature.FeatureID)
+	if err != nil {
+		return nil, fmt.Errorf("feature not found: %w", err)
+	}
+
+	// Features cannot be moved between gardens
+	feature.GardenID = existing.GardenID
+
+	// Validate feature is within garden boundary if geometry changed
+	if feature.GeometryGeoJSON != existing.GeometryGeoJSON {
+		if err := s.featureRepo.ValidateFeatureWithinGarden(ctx, feature.GardenID, feature.GeometryGeoJSON); err != nil {
+			return nil, fmt.Errorf("feature must be within garden boundary: %w", err)
+		}
+	}
+
+	// Preserve created_at
+	feature.CreatedAt = existing.CreatedAt
+
+	if err := s.featureRepo.Update(ctx, feature); err != nil {
+		return nil, fmt.Errorf("failed to update feature: %w", err)
+	}
+
+	return feature, nil
+}
+
+// DeleteFeature deletes a feature
+func (s *gardenFeatureService) DeleteFeature(ctx context.Context, featureID string) error {
+	if featureID == "" {
+		return entity.NewInvalidInputError("feature_id", "feature ID cannot be empty")
+	}
+
+	// Check if feature exists
+	_, err := s.featureRepo.FindByID(ctx, featureID)
+	if err != nil {
+		return fmt.Errorf("feature not found: %w", err)
+	}
+
+	// Delete feature
+	if err := s.featureRepo.Delete(ctx, featureID); err != nil {
+		return fmt.Errorf("failed to delete feature: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"twigger-backend/backend/garden-service/domain/entity"
+)
+
+// MockGardenFeatureRepository is a mock implementation of repository.GardenFeatureRepository
+type MockGardenFeatureRepository struct {
+	mock.Mock
+}
+
+func (m *MockGardenFeatureRepository) Create(ctx context.Context, feature *entity.GardenFeature) error {
+	args := m.Called(ctx, feature)
+	return args.Error(0)
+}
+
+func (m *MockGardenFeatureRepository) FindByID(ctx context.Context, featureID string) (*entity.GardenFeature, error) {
+	args := m.Called(ctx, featureID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.GardenFeature), args.Error(1)
+}
+
+func (m *MockGardenFeatureRepository) FindByGardenID(ctx context.Context, gardenID string) ([]*entity.GardenFeature, error) {
+	args := m.Called(ctx, gardenID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.GardenFeature), args.Error(1)
+}
+
+func (m *MockGardenFeatureRepository) FindByType(ctx context.Context, gardenID string, featureType entity.FeatureType) ([]*entity.GardenFeature, error) {
+	args := m.Called(ctx, gardenID, featureType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.GardenFeature), args.Error(1)
+}
+
+func (m *MockGardenFeatureRepository) Update(ctx context.Context, feature *entity.GardenFeature) error {
+	args := m.Called(ctx, feature)
+	return args.Error(0)
+}
+
+func (m *MockGardenFeatureRepository) Delete(ctx context.Context, featureID string) error {
+	args := m.Called(ctx, featureID)
+	return args.Error(0)
+}
+
+func (m *MockGardenFeatureRepository) FindByIDs(ctx context.Context, featureIDs []string) ([]*entity.GardenFeature, error) {
+	args := m.Called(ctx, featureIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.GardenFeature), args.Error(1)
+}
+
+func (m *MockGardenFeatureRepository) ValidateFeatureWithinGarden(ctx context.Context, gardenID, featureGeometryGeoJSON string) error {
+	args := m.Called(ctx, gardenID, featureGeometryGeoJSON)
+	return args.Error(0)
+}
+
+func (m *MockGardenFeatureRepository) FindFeaturesWithHeight(ctx context.Context, gardenID string) ([]*entity.GardenFeature, error) {
+	args := m.Called(ctx, gardenID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.GardenFeature), args.Error(1)
+}
+
+func (m *MockGardenFeatureRepository) FindTreesInGarden(ctx context.Context, gardenID string) ([]*entity.GardenFeature, error) {
+	args := m.Called(ctx, gardenID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.GardenFeature), args.Error(1)
+}
+
+func (m *MockGardenFeatureRepository) CountByGardenID(ctx context.Context, gardenID string) (int, error) {
+	args := m.Called(ctx, gardenID)
+	return args.Get(0).(int), args.Error(1)
+}
+
+// Test CreateFeature
+func TestGardenFeatureService_CreateFeature_Success(t *testing.T) {
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	mockGardenRepo := new(MockGardenRepository)
+	service := NewGardenFeatureService(mockFeatureRepo, mockGardenRepo)
+
+	ctx := context.Background()
+	gardenID := "garden-123"
+	geometry := `{"type":"Point","coordinates":[-122.495,37.705]}`
+	height := 8.0
+	deciduous := true
+
+	feature := &entity.GardenFeature{
+		GardenID:        gardenID,
+		FeatureType:     entity.FeatureTypeTree,
+		GeometryGeoJSON: geometry,
+		HeightM:         &height,
+		Deciduous:       &deciduous,
+	}
+
+	mockGardenRepo.On("FindByID", ctx, gardenID).Return(&entity.Garden{GardenID: gardenID}, nil)
+	mockFeatureRepo.On("ValidateFeatureWithinGarden", ctx, gardenID, geometry).Return(nil)
+	mockFeatureRepo.On("Create", ctx, mock.AnythingOfType("*entity.GardenFeature")).Return(nil)
+
+	result, err := service.CreateFeature(ctx, feature)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.NotEmpty(t, result.FeatureID)
+	assert.False(t, result.CreatedAt.IsZero())
+	mockFeatureRepo.AssertExpectations(t)
+	mockGardenRepo.AssertExpectations(t)
+}
+
+func TestGardenFeatureService_CreateFeature_ValidationError(t *testing.T) {
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	mockGardenRepo := new(MockGardenRepository)
+	service := NewGardenFeatureService(mockFeatureRepo, mockGardenRepo)
+
+	// Trees require the deciduous flag
+	feature := &entity.GardenFeature{
+		GardenID:        "garden-123",
+		FeatureType:     entity.FeatureTypeTree,
+		GeometryGeoJSON: `{"type":"Point","coordinates":[-122.495,37.705]}`,
+	}
+
+	_, err := service.CreateFeature(context.Background(), feature)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "deciduous")
+	mockGardenRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	mockFeatureRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestGardenFeatureService_CreateFeature_NotWithinBoundary(t *testing.T) {
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	mockGardenRepo := new(MockGardenRepository)
+	service := NewGardenFeatureService(mockFeatureRepo, mockGardenRepo)
+
+	ctx := context.Background()
+	gardenID := "garden-123"
+	geometry := `{"type":"Point","coordinates":[0,0]}`
+
+	feature := &entity.GardenFeature{
+		GardenID:        gardenID,
+		FeatureType:     entity.FeatureTypeShed,
+		GeometryGeoJSON: geometry,
+	}
+
+	mockGardenRepo.On("FindByID", ctx, gardenID).Return(&entity.Garden{GardenID: gardenID}, nil)
+	mockFeatureRepo.On("ValidateFeatureWithinGarden", ctx, gardenID, geometry).
+		Return(entity.NewSpatialError("feature_validation", "feature geometry is not within garden boundary"))
+
+	_, err := service.CreateFeature(ctx, feature)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "within garden boundary")
+	mockFeatureRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+// Test ListGardenFeatures
+func TestGardenFeatureService_ListGardenFeatures_FilterByType(t *testing.T) {
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	mockGardenRepo := new(MockGardenRepository)
+	service := NewGardenFeatureService(mockFeatureRepo, mockGardenRepo)
+
+	ctx := context.Background()
+	gardenID := "garden-123"
+	featureType := entity.FeatureTypeWall
+
+	expected := []*entity.GardenFeature{
+		{FeatureID: "feature-1", GardenID: gardenID, FeatureType: featureType},
+	}
+
+	mockGardenRepo.On("FindByID", ctx, gardenID).Return(&entity.Garden{GardenID: gardenID}, nil)
+	mockFeatureRepo.On("FindByType", ctx, gardenID, featureType).Return(expected, nil)
+
+	result, err := service.ListGardenFeatures(ctx, gardenID, &featureType)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	mockFeatureRepo.AssertNotCalled(t, "FindByGardenID", mock.Anything, mock.Anything)
+}
+
+// Test UpdateFeature
+func TestGardenFeatureService_UpdateFeature_GeometryChanged(t *testing.T) {
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	mockGardenRepo := new(MockGardenRepository)
+	service := NewGardenFeatureService(mockFeatureRepo, mockGardenRepo)
+
+	ctx := context.Background()
+	createdAt := time.Now().Add(-24 * time.Hour)
+	newGeometry := `{"type":"Point","coordinates":[-122.496,37.706]}`
+
+	existing := &entity.GardenFeature{
+		FeatureID:       "feature-1",
+		GardenID:        "garden-123",
+		FeatureType:     entity.FeatureTypeShed,
+		GeometryGeoJSON: `{"type":"Point","coordinates":[-122.495,37.705]}`,
+		CreatedAt:       createdAt,
+	}
+
+	updated := &entity.GardenFeature{
+		FeatureID:       "feature-1",
+		GardenID:        "garden-123",
+		FeatureType:     entity.FeatureTypeShed,
+		GeometryGeoJSON: newGeometry,
+	}
+
+	mockFeatureRepo.On("FindByID", ctx, "feature-1").Return(existing, nil)
+	mockFeatureRepo.On("ValidateFeatureWithinGarden", ctx, "garden-123", newGeometry).Return(nil)
+	mockFeatureRepo.On("Update", ctx, updated).Return(nil)
+
+	result, err := service.UpdateFeature(ctx, updated)
+
+	assert.NoError(t, err)
+	assert.Equal(t, createdAt, result.CreatedAt)
+	mockFeatureRepo.AssertExpectations(t)
+}
+
+// Test DeleteFeature
+func TestGardenFeatureService_DeleteFeature_NotFound(t *testing.T) {
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	mockGardenRepo := new(MockGardenRepository)
+	service := NewGardenFeatureService(mockFeatureRepo, mockGardenRepo)
+
+	ctx := context.Background()
+
+	mockFeatureRepo.On("FindByID", ctx, "missing").Return(nil, entity.NewNotFoundError("garden_feature", "missing"))
+
+	err := service.DeleteFeature(ctx, "missing")
+
+	assert.Error(t, err)
+	mockFeatureRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
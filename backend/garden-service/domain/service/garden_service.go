@@ -21,6 +21,12 @@ type GardenService interface {
 	// ListUserGardens retrieves all gardens for a user with pagination
 	ListUserGardens(ctx context.Context, userID string, limit, offset int) ([]*entity.Garden, error)
 
+	// ListAccessibleGardens retrieves gardens the user owns or shares through a workspace
+	ListAccessibleGardens(ctx context.Context, userID string, limit, offset int) ([]*entity.Garden, error)
+
+	// ListWorkspaceGardens retrieves all gardens in a workspace with pagination
+	ListWorkspaceGardens(ctx context.Context, workspaceID string, limit, offset int) ([]*entity.Garden, error)
+
 	// UpdateGarden updates an existing garden with validation
 	UpdateGarden(ctx context.Context, garden *entity.Garden) (*entity.Garden, error)
 
@@ -146,6 +152,55 @@ func (s *gardenService) ListUserGardens(ctx context.Context, userID string, limi
 	return gardens, nil
 }
 
+// ListAccessibleGardens retrieves gardens the user owns or shares through a workspace
+func (s *gardenService) ListAccessibleGardens(ctx context.Context, userID string, limit, offset int) ([]*entity.Garden, error) {
+	if userID == "" {
+		return nil, entity.NewInvalidInputError("user_id", "user ID cannot be empty")
+	}
+
+	limit, offset = normalizePagination(limit, offset)
+
+	gardens, err := s.gardenRepo.FindByWorkspaceMember(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accessible gardens: %w", err)
+	}
+
+	return gardens, nil
+}
+
+// ListWorkspaceGardens retrieves all gardens in a workspace with pagination
+func (s *gardenService) ListWorkspaceGardens(ctx context.Context, workspaceID string, limit, offset int) ([]*entity.Garden, error) {
+	if workspaceID == "" {
+		return nil, entity.NewInvalidInputError("workspace_id", "workspace ID cannot be empty")
+	}
+
+	limit, offset = normalizePagination(limit, offset)
+
+	gardens, err := s.gardenRepo.FindByWorkspaceID(ctx, workspaceID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace gardens: %w", err)
+	}
+
+	return gardens, nil
+}
+
+// normalizePagination applies the default and maximum page size used by list endpoints
+func normalizePagination(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = 10 // Default limit
+	}
+
+	if limit > 100 {
+		limit = 100 // Maximum limit
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+
+	return limit, offset
+}
+
 // UpdateGarden updates an existing garden with validation
 func (s *gardenService) UpdateGarden(ctx context.Context, garden *entity.Garden) (*entity.Garden, error) {
 	// Validate entity
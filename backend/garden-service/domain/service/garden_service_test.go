@@ -37,6 +37,22 @@ func (m *MockGardenRepository) FindByUserID(ctx context.Context, userID string,
 	return args.Get(0).([]*entity.Garden), args.Error(1)
 }
 
+func (m *MockGardenRepository) FindByWorkspaceMember(ctx context.Context, userID string, limit, offset int) ([]*entity.Garden, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Garden), args.Error(1)
+}
+
+func (m *MockGardenRepository) FindByWorkspaceID(ctx context.Context, workspaceID string, limit, offset int) ([]*entity.Garden, error) {
+	args := m.Called(ctx, workspaceID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Garden), args.Error(1)
+}
+
 func (m *MockGardenRepository) FindByIDs(ctx context.Context, gardenIDs []string) ([]*entity.Garden, error) {
 	args := m.Called(ctx, gardenIDs)
 	if args.Get(0) == nil {
@@ -267,6 +283,45 @@ func TestGardenService_ListUserGardens_MaxLimit(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestGardenService_ListAccessibleGardens(t *testing.T) {
+	mockRepo := new(MockGardenRepository)
+	service := NewGardenService(mockRepo)
+
+	ctx := context.Background()
+	userID := "user-123"
+	workspaceID := "workspace-1"
+	expectedGardens := []*entity.Garden{
+		{GardenID: "garden-1", UserID: userID, GardenName: "My Garden"},
+		{GardenID: "garden-2", UserID: "user-456", GardenName: "Shared Garden", WorkspaceID: &workspaceID},
+	}
+
+	mockRepo.On("FindByWorkspaceMember", ctx, userID, 10, 0).Return(expectedGardens, nil)
+
+	result, err := service.ListAccessibleGardens(ctx, userID, 0, -5)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGardenService_ListWorkspaceGardens(t *testing.T) {
+	mockRepo := new(MockGardenRepository)
+	service := NewGardenService(mockRepo)
+
+	ctx := context.Background()
+	workspaceID := "workspace-1"
+
+	mockRepo.On("FindByWorkspaceID", ctx, workspaceID, 100, 20).Return([]*entity.Garden{}, nil)
+
+	_, err := service.ListWorkspaceGardens(ctx, workspaceID, 500, 20)
+	assert.NoError(t, err)
+
+	_, err = service.ListWorkspaceGardens(ctx, "", 10, 0)
+	assert.Error(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
 // Test UpdateGarden
 func TestGardenService_UpdateGarden_Success(t *testing.T) {
 	mockRepo := new(MockGardenRepository)
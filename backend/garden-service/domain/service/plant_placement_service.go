@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -41,6 +43,17 @@ type PlantPlacementService interface {
 
 	// GetPlantingStats retrieves planting statistics for a garden
 	GetPlantingStats(ctx context.Context, gardenID string) (*PlantingStats, error)
+
+	// RecordPlantEvent logs a care event (watered, harvested) against a plant
+	RecordPlantEvent(ctx context.Context, gardenPlantID string, input *PlantEventInput) (*entity.GardenPlantEvent, error)
+
+	// GetPlantHistory retrieves a plant's event timeline, newest first.
+	// History remains available after the plant is removed.
+	GetPlantHistory(ctx context.Context, gardenPlantID string, filter *repository.GardenPlantEventFilter) (*PlantHistory, error)
+
+	// ResolvePlantGarden returns the garden a plant belongs to, for authorization.
+	// Removed plants are resolved through their history.
+	ResolvePlantGarden(ctx context.Context, gardenPlantID string) (string, error)
 }
 
 // GardenPlantFilter defines filters for listing garden plants
@@ -57,11 +70,44 @@ type PlantingStats struct {
 	PlantsByHealth map[entity.HealthStatus]int `json:"plants_by_health"`
 }
 
+// PlantEventInput holds a manually logged plant event
+type PlantEventInput struct {
+	EventType  entity.GardenPlantEventType `json:"event_type"`
+	OccurredAt *time.Time                  `json:"occurred_at,omitempty"` // Defaults to now
+	Notes      *string                     `json:"notes,omitempty"`
+	Details    map[string]interface{}      `json:"details,omitempty"`
+}
+
+// PlantHistory holds a page of a garden plant's event timeline
+type PlantHistory struct {
+	GardenPlantID string                     `json:"garden_plant_id"`
+	GardenID      string                     `json:"garden_id"`
+	Removed       bool                       `json:"removed"`
+	Events        []*entity.GardenPlantEvent `json:"events"`
+	Total         int                        `json:"total"`
+}
+
 // plantPlacementService implements PlantPlacementService
 type plantPlacementService struct {
 	gardenPlantRepo repository.GardenPlantRepository
 	gardenRepo      repository.GardenRepository
 	zoneRepo        repository.GardenZoneRepository
+	eventRepo       repository.GardenPlantEventRepository
+	txManager       repository.TransactionManager
+	checks          []PlacementCheck
+}
+
+// PlacementCheck is a hook PlacePlant runs before saving a plant.
+// Returning an error rejects the placement; warnings are attached to the placed plant.
+type PlacementCheck interface {
+	CheckPlacement(ctx context.Context, gardenPlant *entity.GardenPlant) ([]entity.PlacementWarning, error)
+}
+
+// BulkPlacementCheck is implemented by placement checks that also compare the plants of
+// one batch with each other. BulkPlacePlants uses it instead of checking plants one by one.
+// The returned warnings are indexed like gardenPlants.
+type BulkPlacementCheck interface {
+	CheckBulkPlacement(ctx context.Context, gardenPlants []*entity.GardenPlant) ([][]entity.PlacementWarning, error)
 }
 
 // NewPlantPlacementService creates a new plant placement service instance
@@ -69,11 +115,17 @@ func NewPlantPlacementService(
 	gardenPlantRepo repository.GardenPlantRepository,
 	gardenRepo repository.GardenRepository,
 	zoneRepo repository.GardenZoneRepository,
+	eventRepo repository.GardenPlantEventRepository,
+	txManager repository.TransactionManager,
+	checks ...PlacementCheck,
 ) PlantPlacementService {
 	return &plantPlacementService{
 		gardenPlantRepo: gardenPlantRepo,
 		gardenRepo:      gardenRepo,
 		zoneRepo:        zoneRepo,
+		eventRepo:       eventRepo,
+		txManager:       txManager,
+		checks:          checks,
 	}
 }
 
@@ -106,6 +158,16 @@ func (s *plantPlacementService) PlacePlant(ctx context.Context, gardenPlant *ent
 		}
 	}
 
+	// Run placement checks (crop rotation, ...)
+	var warnings []entity.PlacementWarning
+	for _, check := range s.checks {
+		checkWarnings, err := check.CheckPlacement(ctx, gardenPlant)
+		if err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, checkWarnings...)
+	}
+
 	// Generate ID if not provided
 	if gardenPlant.GardenPlantID == "" {
 		gardenPlant.GardenPlantID = uuid.New().String()
@@ -123,11 +185,18 @@ func (s *plantPlacementService) PlacePlant(ctx context.Context, gardenPlant *ent
 		gardenPlant.HealthStatus = &healthy
 	}
 
-	// Create garden plant
-	if err := s.gardenPlantRepo.Create(ctx, gardenPlant); err != nil {
-		return nil, fmt.Errorf("failed to place plant: %w", err)
+	// Create garden plant together with its planted event
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.gardenPlantRepo.Create(ctx, gardenPlant); err != nil {
+			return fmt.Errorf("failed to place plant: %w", err)
+		}
+		return s.recordEvents(ctx, plantedEvent(gardenPlant, now))
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	gardenPlant.Warnings = warnings
 	return gardenPlant, nil
 }
 
@@ -221,8 +290,14 @@ func (s *plantPlacementService) UpdatePlantPlacement(ctx context.Context, garden
 	gardenPlant.PlantedDate = existing.PlantedDate
 	gardenPlant.UpdatedAt = time.Now()
 
-	if err := s.gardenPlantRepo.Update(ctx, gardenPlant); err != nil {
-		return nil, fmt.Errorf("failed to update plant placement: %w", err)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.gardenPlantRepo.Update(ctx, gardenPlant); err != nil {
+			return fmt.Errorf("failed to update plant placement: %w", err)
+		}
+		return s.recordEvents(ctx, placementChangeEvents(existing, gardenPlant, gardenPlant.UpdatedAt)...)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return gardenPlant, nil
@@ -235,17 +310,26 @@ func (s *plantPlacementService) RemovePlant(ctx context.Context, gardenPlantID s
 	}
 
 	// Check if garden plant exists
-	_, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
+	gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
 	if err != nil {
 		return fmt.Errorf("garden plant not found: %w", err)
 	}
 
-	// Delete garden plant
-	if err := s.gardenPlantRepo.Delete(ctx, gardenPlantID); err != nil {
-		return fmt.Errorf("failed to remove plant: %w", err)
+	// The event log keeps the plant's history after the row is gone
+	event := entity.NewGardenPlantEvent(gardenPlant, entity.EventRemoved, time.Now())
+	event.Details["location"] = rawGeoJSON(gardenPlant.LocationGeoJSON)
+	event.Details["quantity"] = gardenPlant.Quantity
+	if gardenPlant.HealthStatus != nil {
+		event.Details["health_status"] = *gardenPlant.HealthStatus
 	}
 
-	return nil
+	// Delete garden plant
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.gardenPlantRepo.Delete(ctx, gardenPlantID); err != nil {
+			return fmt.Errorf("failed to remove plant: %w", err)
+		}
+		return s.recordEvents(ctx, event)
+	})
 }
 
 // CheckPlantSpacing checks if a plant location maintains minimum spacing
@@ -346,9 +430,29 @@ func (s *plantPlacementService) BulkPlacePlants(ctx context.Context, gardenPlant
 		}
 	}
 
+	warnings, err := s.runBulkChecks(ctx, gardenPlants)
+	if err != nil {
+		return nil, err
+	}
+
 	// Bulk create
-	if err := s.gardenPlantRepo.BulkCreate(ctx, gardenPlants); err != nil {
-		return nil, fmt.Errorf("failed to bulk place plants: %w", err)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.gardenPlantRepo.BulkCreate(ctx, gardenPlants); err != nil {
+			return fmt.Errorf("failed to bulk place plants: %w", err)
+		}
+
+		events := make([]*entity.GardenPlantEvent, len(gardenPlants))
+		for i, gp := range gardenPlants {
+			events[i] = plantedEvent(gp, gp.CreatedAt)
+		}
+		return s.recordEvents(ctx, events...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, gp := range gardenPlants {
+		gp.Warnings = warnings[i]
 	}
 
 	return gardenPlants, nil
@@ -367,17 +471,26 @@ func (s *plantPlacementService) UpdatePlantHealth(ctx context.Context, gardenPla
 	}
 
 	// Update health status
+	previousStatus := gardenPlant.HealthStatus
 	gardenPlant.HealthStatus = &healthStatus
 	if notes != nil {
 		gardenPlant.Notes = notes
 	}
 	gardenPlant.UpdatedAt = time.Now()
 
-	if err := s.gardenPlantRepo.Update(ctx, gardenPlant); err != nil {
-		return fmt.Errorf("failed to update plant health: %w", err)
-	}
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.gardenPlantRepo.Update(ctx, gardenPlant); err != nil {
+			return fmt.Errorf("failed to update plant health: %w", err)
+		}
 
-	return nil
+		if !sameHealthStatus(previousStatus, &healthStatus) {
+			event := healthChangedEvent(gardenPlant, previousStatus, gardenPlant.UpdatedAt)
+			event.Notes = notes
+			return s.recordEvents(ctx, event)
+		}
+
+		return nil
+	})
 }
 
 // GetPlantingStats retrieves planting statistics for a garden
@@ -426,3 +539,240 @@ func (s *plantPlacementService) GetPlantingStats(ctx context.Context, gardenID s
 		PlantsByHealth: plantsByHealth,
 	}, nil
 }
+
+// RecordPlantEvent logs a care event (watered, harvested) against a plant
+func (s *plantPlacementService) RecordPlantEvent(ctx context.Context, gardenPlantID string, input *PlantEventInput) (*entity.GardenPlantEvent, error) {
+	if gardenPlantID == "" {
+		return nil, entity.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+
+	if input == nil {
+		return nil, entity.NewInvalidInputError("event", "event cannot be nil")
+	}
+
+	// Placement events are derived from changes to the plant, never logged directly
+	if !input.EventType.IsManual() {
+		return nil, entity.NewValidationError("event_type", "event type must be watered or harvested")
+	}
+
+	now := time.Now()
+	occurredAt := now
+	if input.OccurredAt != nil {
+		if input.OccurredAt.After(now) {
+			return nil, entity.NewValidationError("occurred_at", "occurred_at cannot be in the future")
+		}
+		occurredAt = *input.OccurredAt
+	}
+
+	gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
+	if err != nil {
+		return nil, fmt.Errorf("garden plant not found: %w", err)
+	}
+
+	if !gardenPlant.IsActive() {
+		return nil, entity.NewValidationError("garden_plant", "cannot log events for a removed plant")
+	}
+
+	event := entity.NewGardenPlantEvent(gardenPlant, input.EventType, occurredAt)
+	event.Notes = input.Notes
+	if input.Details != nil {
+		event.Details = input.Details
+	}
+
+	if err := event.Validate(); err != nil {
+		return nil, entity.NewValidationError("event", err.Error())
+	}
+
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		return nil, fmt.Errorf("failed to record plant event: %w", err)
+	}
+
+	return event, nil
+}
+
+// GetPlantHistory retrieves a plant's event timeline, newest first.
+// History remains available after the plant is removed.
+func (s *plantPlacementService) GetPlantHistory(ctx context.Context, gardenPlantID string, filter *repository.GardenPlantEventFilter) (*PlantHistory, error) {
+	if gardenPlantID == "" {
+		return nil, entity.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+
+	if filter == nil {
+		filter = &repository.GardenPlantEventFilter{}
+	}
+	for _, eventType := range filter.EventTypes {
+		if !eventType.IsValid() {
+			return nil, entity.NewValidationError("type", fmt.Sprintf("invalid event type: %s", eventType))
+		}
+	}
+
+	history := &PlantHistory{GardenPlantID: gardenPlantID}
+
+	var err error
+	history.GardenID, history.Removed, err = s.locatePlant(ctx, gardenPlantID)
+	if err != nil {
+		return nil, err
+	}
+
+	history.Events, err = s.eventRepo.FindByGardenPlantID(ctx, gardenPlantID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plant history: %w", err)
+	}
+
+	history.Total, err = s.eventRepo.CountByGardenPlantID(ctx, gardenPlantID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count plant history: %w", err)
+	}
+
+	return history, nil
+}
+
+// ResolvePlantGarden returns the garden a plant belongs to, including removed plants
+func (s *plantPlacementService) ResolvePlantGarden(ctx context.Context, gardenPlantID string) (string, error) {
+	if gardenPlantID == "" {
+		return "", entity.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+
+	gardenID, _, err := s.locatePlant(ctx, gardenPlantID)
+	return gardenID, err
+}
+
+// locatePlant returns a plant's garden and whether it has been removed
+func (s *plantPlacementService) locatePlant(ctx context.Context, gardenPlantID string) (string, bool, error) {
+	gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
+	var notFound *entity.NotFoundError
+	switch {
+	case err == nil:
+		return gardenPlant.GardenID, !gardenPlant.IsActive(), nil
+	case errors.As(err, &notFound):
+		// Removed plants are only known through their events
+		latest, err := s.eventRepo.FindByGardenPlantID(ctx, gardenPlantID, &repository.GardenPlantEventFilter{Limit: 1})
+		if err != nil {
+			return "", false, fmt.Errorf("failed to get plant history: %w", err)
+		}
+		if len(latest) == 0 {
+			return "", false, entity.NewNotFoundError("garden_plant", gardenPlantID)
+		}
+		return latest[0].GardenID, true, nil
+	default:
+		return "", false, fmt.Errorf("failed to get garden plant: %w", err)
+	}
+}
+
+// runBulkChecks runs the placement checks for a batch of plants and returns each plant's warnings
+func (s *plantPlacementService) runBulkChecks(ctx context.Context, gardenPlants []*entity.GardenPlant) ([][]entity.PlacementWarning, error) {
+	warnings := make([][]entity.PlacementWarning, len(gardenPlants))
+
+	for _, check := range s.checks {
+		if bulkCheck, ok := check.(BulkPlacementCheck); ok {
+			batchWarnings, err := bulkCheck.CheckBulkPlacement(ctx, gardenPlants)
+			if err != nil {
+				return nil, err
+			}
+			for i := range gardenPlants {
+				if i < len(batchWarnings) {
+					warnings[i] = append(warnings[i], batchWarnings[i]...)
+				}
+			}
+			continue
+		}
+
+		for i, gp := range gardenPlants {
+			checkWarnings, err := check.CheckPlacement(ctx, gp)
+			if err != nil {
+				return nil, fmt.Errorf("placement check failed for plant at index %d: %w", i, err)
+			}
+			warnings[i] = append(warnings[i], checkWarnings...)
+		}
+	}
+
+	return warnings, nil
+}
+
+// recordEvents appends events to the plant history. Callers run it in the transaction that
+// saves the change, so a failure rolls the change back and a retry cannot duplicate it.
+func (s *plantPlacementService) recordEvents(ctx context.Context, events ...*entity.GardenPlantEvent) error {
+	var err error
+	switch len(events) {
+	case 0:
+		return nil
+	case 1:
+		err = s.eventRepo.Create(ctx, events[0])
+	default:
+		err = s.eventRepo.BulkCreate(ctx, events)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to record plant history for %s: %w", events[0].GardenPlantID, err)
+	}
+	return nil
+}
+
+// plantedEvent builds the planted event for a newly placed plant
+func plantedEvent(gardenPlant *entity.GardenPlant, occurredAt time.Time) *entity.GardenPlantEvent {
+	event := entity.NewGardenPlantEvent(gardenPlant, entity.EventPlanted, occurredAt)
+	event.Details["location"] = rawGeoJSON(gardenPlant.LocationGeoJSON)
+	event.Details["quantity"] = gardenPlant.Quantity
+	if gardenPlant.PlantSource != nil {
+		event.Details["plant_source"] = *gardenPlant.PlantSource
+	}
+	return event
+}
+
+// healthChangedEvent builds a health change event from the previous status to the plant's current one
+func healthChangedEvent(gardenPlant *entity.GardenPlant, previous *entity.HealthStatus, occurredAt time.Time) *entity.GardenPlantEvent {
+	event := entity.NewGardenPlantEvent(gardenPlant, entity.EventHealthChanged, occurredAt)
+	event.Details["from"] = previous
+	event.Details["to"] = gardenPlant.HealthStatus
+	return event
+}
+
+// placementChangeEvents compares a plant before and after an update and returns
+// the moved, health changed and removed events that describe the change
+func placementChangeEvents(before, after *entity.GardenPlant, occurredAt time.Time) []*entity.GardenPlantEvent {
+	var events []*entity.GardenPlantEvent
+
+	zoneChanged := !sameOptionalString(before.ZoneID, after.ZoneID)
+	if before.LocationGeoJSON != after.LocationGeoJSON || zoneChanged {
+		event := entity.NewGardenPlantEvent(after, entity.EventMoved, occurredAt)
+		event.Details["from_location"] = rawGeoJSON(before.LocationGeoJSON)
+		event.Details["to_location"] = rawGeoJSON(after.LocationGeoJSON)
+		if zoneChanged {
+			event.Details["from_zone_id"] = before.ZoneID
+			event.Details["to_zone_id"] = after.ZoneID
+		}
+		events = append(events, event)
+	}
+
+	if !sameHealthStatus(before.HealthStatus, after.HealthStatus) {
+		events = append(events, healthChangedEvent(after, before.HealthStatus, occurredAt))
+	}
+
+	if before.IsActive() && !after.IsActive() {
+		events = append(events, entity.NewGardenPlantEvent(after, entity.EventRemoved, *after.RemovedDate))
+	}
+
+	return events
+}
+
+// rawGeoJSON embeds a GeoJSON string as a JSON object in event details
+func rawGeoJSON(geoJSON string) interface{} {
+	if json.Valid([]byte(geoJSON)) {
+		return json.RawMessage(geoJSON)
+	}
+	return geoJSON
+}
+
+func sameOptionalString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameHealthStatus(a, b *entity.HealthStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
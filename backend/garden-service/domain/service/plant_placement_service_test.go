@@ -2,14 +2,106 @@ package service
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/shared/mocks"
 )
 
+// memoryEventRepository records garden plant events in memory
+type memoryEventRepository struct {
+	events []*entity.GardenPlantEvent
+	err    error // Returned by writes when set
+}
+
+func newMemoryEventRepository() *memoryEventRepository {
+	return &memoryEventRepository{}
+}
+
+func (m *memoryEventRepository) Create(ctx context.Context, event *entity.GardenPlantEvent) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *memoryEventRepository) BulkCreate(ctx context.Context, events []*entity.GardenPlantEvent) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.events = append(m.events, events...)
+	return nil
+}
+
+func (m *memoryEventRepository) FindByGardenPlantID(ctx context.Context, gardenPlantID string, filter *repository.GardenPlantEventFilter) ([]*entity.GardenPlantEvent, error) {
+	var result []*entity.GardenPlantEvent
+	for i := len(m.events) - 1; i >= 0; i-- {
+		if m.events[i].GardenPlantID == gardenPlantID && matchesEventFilter(m.events[i], filter) {
+			result = append(result, m.events[i])
+		}
+	}
+	if filter != nil && filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+	return result, nil
+}
+
+func (m *memoryEventRepository) CountByGardenPlantID(ctx context.Context, gardenPlantID string, filter *repository.GardenPlantEventFilter) (int, error) {
+	count := 0
+	for _, event := range m.events {
+		if event.GardenPlantID == gardenPlantID && matchesEventFilter(event, filter) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *memoryEventRepository) FindZonePlantings(ctx context.Context, zoneID string, since time.Time) ([]*repository.ZonePlanting, error) {
+	byPlant := make(map[string]*repository.ZonePlanting)
+	var result []*repository.ZonePlanting
+	for _, event := range m.events {
+		if event.ZoneID == nil || *event.ZoneID != zoneID || event.OccurredAt.Before(since) {
+			continue
+		}
+		planting, ok := byPlant[event.GardenPlantID]
+		if !ok {
+			planting = &repository.ZonePlanting{GardenPlantID: event.GardenPlantID, PlantID: event.PlantID, FirstSeen: event.OccurredAt}
+			byPlant[event.GardenPlantID] = planting
+			result = append(result, planting)
+		}
+		planting.LastSeen = event.OccurredAt
+	}
+	return result, nil
+}
+
+func matchesEventFilter(event *entity.GardenPlantEvent, filter *repository.GardenPlantEventFilter) bool {
+	if filter == nil || len(filter.EventTypes) == 0 {
+		return true
+	}
+	for _, eventType := range filter.EventTypes {
+		if event.EventType == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// eventTypes lists the types of recorded events in order
+func (m *memoryEventRepository) eventTypes() []entity.GardenPlantEventType {
+	types := make([]entity.GardenPlantEventType, len(m.events))
+	for i, event := range m.events {
+		types[i] = event.EventType
+	}
+	return types
+}
+
 // MockGardenPlantRepository is a mock implementation of repository.GardenPlantRepository
 type MockGardenPlantRepository struct {
 	mock.Mock
@@ -62,6 +154,30 @@ func (m *MockGardenPlantRepository) CheckPlantSpacing(ctx context.Context, garde
 	return args.Get(0).([]*entity.GardenPlant), args.Error(1)
 }
 
+func (m *MockGardenPlantRepository) FindNearby(ctx context.Context, gardenID, locationGeoJSON string, radiusM float64) ([]*repository.NearbyGardenPlant, error) {
+	args := m.Called(ctx, gardenID, locationGeoJSON, radiusM)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.NearbyGardenPlant), args.Error(1)
+}
+
+func (m *MockGardenPlantRepository) FindClosePairs(ctx context.Context, gardenID string, maxDistanceM float64) ([]*repository.GardenPlantPair, error) {
+	args := m.Called(ctx, gardenID, maxDistanceM)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.GardenPlantPair), args.Error(1)
+}
+
+func (m *MockGardenPlantRepository) PairwiseDistances(ctx context.Context, locationsGeoJSON []string, maxDistanceM float64) ([]*repository.LocationPair, error) {
+	args := m.Called(ctx, locationsGeoJSON, maxDistanceM)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.LocationPair), args.Error(1)
+}
+
 func (m *MockGardenPlantRepository) FindInZone(ctx context.Context, zoneID string) ([]*entity.GardenPlant, error) {
 	args := m.Called(ctx, zoneID)
 	if args.Get(0) == nil {
@@ -135,7 +251,7 @@ func TestPlantPlacementService_PlacePlant_Success(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -175,7 +291,7 @@ func TestPlantPlacementService_PlacePlant_WithZone(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -220,7 +336,7 @@ func TestPlantPlacementService_PlacePlant_ZoneNotInGarden(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -266,7 +382,7 @@ func TestPlantPlacementService_GetGardenPlant_Success(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenPlantID := "gp-123"
@@ -294,7 +410,7 @@ func TestPlantPlacementService_ListGardenPlants_NoFilters(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -324,7 +440,7 @@ func TestPlantPlacementService_ListGardenPlants_FilterByZone(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -356,7 +472,7 @@ func TestPlantPlacementService_ListGardenPlants_FilterByHealthStatus(t *testing.
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -388,7 +504,7 @@ func TestPlantPlacementService_ListGardenPlants_FilterActiveOnly(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -420,7 +536,7 @@ func TestPlantPlacementService_UpdatePlantPlacement_Success(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenPlantID := "gp-123"
@@ -470,7 +586,7 @@ func TestPlantPlacementService_RemovePlant_Success(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenPlantID := "gp-123"
@@ -498,7 +614,7 @@ func TestPlantPlacementService_CheckPlantSpacing_Success(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -530,7 +646,7 @@ func TestPlantPlacementService_BulkPlacePlants_Success(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -568,7 +684,7 @@ func TestPlantPlacementService_BulkPlacePlants_DifferentGardens(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	location := `{"type":"Point","coordinates":[-122.4194,37.7749]}`
@@ -590,7 +706,7 @@ func TestPlantPlacementService_UpdatePlantHealth_Success(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenPlantID := "gp-123"
@@ -620,7 +736,7 @@ func TestPlantPlacementService_GetPlantingStats_Success(t *testing.T) {
 	mockPlantRepo := new(MockGardenPlantRepository)
 	mockGardenRepo := new(MockGardenRepository)
 	mockZoneRepo := new(MockGardenZoneRepository)
-	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo)
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, mockZoneRepo, newMemoryEventRepository(), &mocks.StubTransactionManager{})
 
 	ctx := context.Background()
 	gardenID := "garden-123"
@@ -655,3 +771,372 @@ func TestPlantPlacementService_GetPlantingStats_Success(t *testing.T) {
 	mockPlantRepo.AssertExpectations(t)
 	mockGardenRepo.AssertExpectations(t)
 }
+
+// Test plant history
+func TestPlantPlacementService_PlacePlant_RecordsPlantedEvent(t *testing.T) {
+	mockPlantRepo := new(MockGardenPlantRepository)
+	mockGardenRepo := new(MockGardenRepository)
+	eventRepo := newMemoryEventRepository()
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, new(MockGardenZoneRepository), eventRepo, &mocks.StubTransactionManager{})
+
+	ctx := context.Background()
+	location := `{"type":"Point","coordinates":[-122.4194,37.7749]}`
+	gardenPlant := &entity.GardenPlant{
+		GardenID:        "garden-123",
+		PlantID:         "plant-123",
+		LocationGeoJSON: location,
+		Quantity:        3,
+	}
+
+	mockGardenRepo.On("FindByID", ctx, "garden-123").Return(&entity.Garden{GardenID: "garden-123"}, nil)
+	mockPlantRepo.On("ValidatePlantLocation", ctx, "garden-123", location).Return(nil)
+	mockPlantRepo.On("Create", ctx, mock.AnythingOfType("*entity.GardenPlant")).Return(nil)
+
+	result, err := service.PlacePlant(ctx, gardenPlant)
+
+	require.NoError(t, err)
+	require.Len(t, eventRepo.events, 1)
+	event := eventRepo.events[0]
+	assert.Equal(t, entity.EventPlanted, event.EventType)
+	assert.Equal(t, result.GardenPlantID, event.GardenPlantID)
+	assert.Equal(t, "garden-123", event.GardenID)
+	assert.Equal(t, "plant-123", event.PlantID)
+	assert.Equal(t, 3, event.Details["quantity"])
+}
+
+func TestPlantPlacementService_PlacePlant_EventFailureRollsBack(t *testing.T) {
+	mockPlantRepo := new(MockGardenPlantRepository)
+	mockGardenRepo := new(MockGardenRepository)
+	eventRepo := newMemoryEventRepository()
+	eventRepo.err = errors.New("connection reset")
+	txManager := &mocks.StubTransactionManager{}
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, new(MockGardenZoneRepository), eventRepo, txManager)
+
+	ctx := context.Background()
+	location := `{"type":"Point","coordinates":[-122.4194,37.7749]}`
+	gardenPlant := &entity.GardenPlant{
+		GardenID:        "garden-123",
+		PlantID:         "plant-123",
+		LocationGeoJSON: location,
+		Quantity:        1,
+	}
+
+	mockGardenRepo.On("FindByID", ctx, "garden-123").Return(&entity.Garden{GardenID: "garden-123"}, nil)
+	mockPlantRepo.On("ValidatePlantLocation", ctx, "garden-123", location).Return(nil)
+	mockPlantRepo.On("Create", ctx, mock.AnythingOfType("*entity.GardenPlant")).Return(nil)
+
+	_, err := service.PlacePlant(ctx, gardenPlant)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to record plant history")
+	assert.True(t, txManager.RolledBack, "the plant must not be saved without its planted event")
+}
+
+func TestPlantPlacementService_UpdatePlantPlacement_RecordsMoveAndHealthChange(t *testing.T) {
+	mockPlantRepo := new(MockGardenPlantRepository)
+	mockZoneRepo := new(MockGardenZoneRepository)
+	eventRepo := newMemoryEventRepository()
+	service := NewPlantPlacementService(mockPlantRepo, new(MockGardenRepository), mockZoneRepo, eventRepo, &mocks.StubTransactionManager{})
+
+	ctx := context.Background()
+	oldLocation := `{"type":"Point","coordinates":[-122.4194,37.7749]}`
+	newLocation := `{"type":"Point","coordinates":[-122.4195,37.7750]}`
+	oldZone, newZone := "zone-a", "zone-b"
+	healthy, diseased := entity.HealthStatusHealthy, entity.HealthStatusDiseased
+
+	existing := &entity.GardenPlant{
+		GardenPlantID:   "gp-123",
+		GardenID:        "garden-123",
+		PlantID:         "plant-123",
+		ZoneID:          &oldZone,
+		LocationGeoJSON: oldLocation,
+		Quantity:        1,
+		HealthStatus:    &healthy,
+	}
+	updated := &entity.GardenPlant{
+		GardenPlantID:   "gp-123",
+		GardenID:        "garden-123",
+		PlantID:         "plant-123",
+		ZoneID:          &newZone,
+		LocationGeoJSON: newLocation,
+		Quantity:        1,
+		HealthStatus:    &diseased,
+	}
+
+	mockPlantRepo.On("FindByID", ctx, "gp-123").Return(existing, nil)
+	mockPlantRepo.On("ValidatePlantLocation", ctx, "garden-123", newLocation).Return(nil)
+	mockZoneRepo.On("FindByID", ctx, newZone).Return(&entity.GardenZone{ZoneID: newZone, GardenID: "garden-123"}, nil)
+	mockPlantRepo.On("Update", ctx, updated).Return(nil)
+
+	_, err := service.UpdatePlantPlacement(ctx, updated)
+
+	require.NoError(t, err)
+	assert.Equal(t, []entity.GardenPlantEventType{entity.EventMoved, entity.EventHealthChanged}, eventRepo.eventTypes())
+
+	moved := eventRepo.events[0]
+	assert.Equal(t, &newZone, moved.ZoneID)
+	assert.Equal(t, &oldZone, moved.Details["from_zone_id"])
+	assert.Equal(t, &newZone, moved.Details["to_zone_id"])
+
+	healthChanged := eventRepo.events[1]
+	assert.Equal(t, &healthy, healthChanged.Details["from"])
+	assert.Equal(t, &diseased, healthChanged.Details["to"])
+}
+
+func TestPlantPlacementService_UpdatePlantPlacement_NoChangeRecordsNothing(t *testing.T) {
+	mockPlantRepo := new(MockGardenPlantRepository)
+	eventRepo := newMemoryEventRepository()
+	service := NewPlantPlacementService(mockPlantRepo, new(MockGardenRepository), new(MockGardenZoneRepository), eventRepo, &mocks.StubTransactionManager{})
+
+	ctx := context.Background()
+	healthy := entity.HealthStatusHealthy
+	notes := "Mulched"
+	existing := &entity.GardenPlant{
+		GardenPlantID:   "gp-123",
+		GardenID:        "garden-123",
+		PlantID:         "plant-123",
+		LocationGeoJSON: `{"type":"Point","coordinates":[0,0]}`,
+		Quantity:        1,
+		HealthStatus:    &healthy,
+	}
+	updated := *existing
+	updated.Notes = &notes
+
+	mockPlantRepo.On("FindByID", ctx, "gp-123").Return(existing, nil)
+	mockPlantRepo.On("Update", ctx, &updated).Return(nil)
+
+	_, err := service.UpdatePlantPlacement(ctx, &updated)
+
+	require.NoError(t, err)
+	assert.Empty(t, eventRepo.events)
+}
+
+func TestPlantPlacementService_UpdatePlantHealth_RecordsHealthChange(t *testing.T) {
+	mockPlantRepo := new(MockGardenPlantRepository)
+	eventRepo := newMemoryEventRepository()
+	service := NewPlantPlacementService(mockPlantRepo, new(MockGardenRepository), new(MockGardenZoneRepository), eventRepo, &mocks.StubTransactionManager{})
+
+	ctx := context.Background()
+	healthy := entity.HealthStatusHealthy
+	notes := "Aphids on new growth"
+	existing := &entity.GardenPlant{
+		GardenPlantID:   "gp-123",
+		GardenID:        "garden-123",
+		PlantID:         "plant-123",
+		LocationGeoJSON: "{}",
+		HealthStatus:    &healthy,
+	}
+
+	mockPlantRepo.On("FindByID", ctx, "gp-123").Return(existing, nil)
+	mockPlantRepo.On("Update", ctx, existing).Return(nil)
+
+	// Unchanged status: nothing recorded
+	require.NoError(t, service.UpdatePlantHealth(ctx, "gp-123", entity.HealthStatusHealthy, nil))
+	assert.Empty(t, eventRepo.events)
+
+	require.NoError(t, service.UpdatePlantHealth(ctx, "gp-123", entity.HealthStatusStruggling, &notes))
+	require.Len(t, eventRepo.events, 1)
+	assert.Equal(t, entity.EventHealthChanged, eventRepo.events[0].EventType)
+	assert.Equal(t, &notes, eventRepo.events[0].Notes)
+}
+
+func TestPlantPlacementService_RemovePlant_HistorySurvives(t *testing.T) {
+	mockPlantRepo := new(MockGardenPlantRepository)
+	eventRepo := newMemoryEventRepository()
+	service := NewPlantPlacementService(mockPlantRepo, new(MockGardenRepository), new(MockGardenZoneRepository), eventRepo, &mocks.StubTransactionManager{})
+
+	ctx := context.Background()
+	existing := &entity.GardenPlant{
+		GardenPlantID:   "gp-123",
+		GardenID:        "garden-123",
+		PlantID:         "plant-123",
+		LocationGeoJSON: `{"type":"Point","coordinates":[0,0]}`,
+		Quantity:        1,
+	}
+
+	mockPlantRepo.On("FindByID", ctx, "gp-123").Return(existing, nil).Once()
+	mockPlantRepo.On("Delete", ctx, "gp-123").Return(nil)
+
+	require.NoError(t, service.RemovePlant(ctx, "gp-123"))
+
+	// The plant row is gone but its history is still readable
+	mockPlantRepo.On("FindByID", ctx, "gp-123").Return(nil, entity.NewNotFoundError("garden_plant", "gp-123"))
+
+	history, err := service.GetPlantHistory(ctx, "gp-123", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "garden-123", history.GardenID)
+	assert.True(t, history.Removed)
+	assert.Equal(t, 1, history.Total)
+	require.Len(t, history.Events, 1)
+	assert.Equal(t, entity.EventRemoved, history.Events[0].EventType)
+
+	gardenID, err := service.ResolvePlantGarden(ctx, "gp-123")
+	require.NoError(t, err)
+	assert.Equal(t, "garden-123", gardenID)
+}
+
+func TestPlantPlacementService_GetPlantHistory_UnknownPlant(t *testing.T) {
+	mockPlantRepo := new(MockGardenPlantRepository)
+	service := NewPlantPlacementService(mockPlantRepo, new(MockGardenRepository), new(MockGardenZoneRepository), newMemoryEventRepository(), &mocks.StubTransactionManager{})
+
+	ctx := context.Background()
+	mockPlantRepo.On("FindByID", ctx, "gp-missing").Return(nil, entity.NewNotFoundError("garden_plant", "gp-missing"))
+
+	_, err := service.GetPlantHistory(ctx, "gp-missing", nil)
+
+	assert.Error(t, err)
+	assert.IsType(t, &entity.NotFoundError{}, err)
+}
+
+func TestPlantPlacementService_RecordPlantEvent(t *testing.T) {
+	ctx := context.Background()
+	notes := "Deep soak"
+	future := time.Now().Add(time.Hour)
+	yesterday := time.Now().Add(-24 * time.Hour)
+
+	activePlant := &entity.GardenPlant{
+		GardenPlantID:   "gp-123",
+		GardenID:        "garden-123",
+		PlantID:         "plant-123",
+		LocationGeoJSON: "{}",
+	}
+
+	tests := []struct {
+		name    string
+		input   *PlantEventInput
+		wantErr string
+	}{
+		{
+			name:  "watered",
+			input: &PlantEventInput{EventType: entity.EventWatered, Notes: &notes},
+		},
+		{
+			name:  "harvested in the past",
+			input: &PlantEventInput{EventType: entity.EventHarvested, OccurredAt: &yesterday},
+		},
+		{
+			name:    "placement events cannot be logged directly",
+			input:   &PlantEventInput{EventType: entity.EventMoved},
+			wantErr: "must be watered or harvested",
+		},
+		{
+			name:    "future timestamp",
+			input:   &PlantEventInput{EventType: entity.EventWatered, OccurredAt: &future},
+			wantErr: "cannot be in the future",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlantRepo := new(MockGardenPlantRepository)
+			eventRepo := newMemoryEventRepository()
+			service := NewPlantPlacementService(mockPlantRepo, new(MockGardenRepository), new(MockGardenZoneRepository), eventRepo, &mocks.StubTransactionManager{})
+			mockPlantRepo.On("FindByID", ctx, "gp-123").Return(activePlant, nil).Maybe()
+
+			event, err := service.RecordPlantEvent(ctx, "gp-123", tt.input)
+
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.IsType(t, &entity.ValidationError{}, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Empty(t, eventRepo.events)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.input.EventType, event.EventType)
+			assert.Equal(t, "garden-123", event.GardenID)
+			if tt.input.OccurredAt != nil {
+				assert.Equal(t, *tt.input.OccurredAt, event.OccurredAt)
+			}
+			assert.Len(t, eventRepo.events, 1)
+		})
+	}
+}
+
+// stubPlacementCheck returns canned warnings or an error
+type stubPlacementCheck struct {
+	warnings []entity.PlacementWarning
+	err      error
+}
+
+func (s *stubPlacementCheck) CheckPlacement(ctx context.Context, gardenPlant *entity.GardenPlant) ([]entity.PlacementWarning, error) {
+	return s.warnings, s.err
+}
+
+func TestPlantPlacementService_PlacePlant_PlacementChecks(t *testing.T) {
+	ctx := context.Background()
+	location := `{"type":"Point","coordinates":[-122.4194,37.7749]}`
+
+	t.Run("warnings are returned with the placement", func(t *testing.T) {
+		mockPlantRepo := new(MockGardenPlantRepository)
+		mockGardenRepo := new(MockGardenRepository)
+		check := &stubPlacementCheck{warnings: []entity.PlacementWarning{{Code: "crop_rotation", Message: "Solanaceae grew here last year"}}}
+		service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, new(MockGardenZoneRepository), newMemoryEventRepository(), &mocks.StubTransactionManager{}, check)
+
+		mockGardenRepo.On("FindByID", ctx, "garden-123").Return(&entity.Garden{GardenID: "garden-123"}, nil)
+		mockPlantRepo.On("ValidatePlantLocation", ctx, "garden-123", location).Return(nil)
+		mockPlantRepo.On("Create", ctx, mock.AnythingOfType("*entity.GardenPlant")).Return(nil)
+
+		result, err := service.PlacePlant(ctx, &entity.GardenPlant{GardenID: "garden-123", PlantID: "plant-123", LocationGeoJSON: location, Quantity: 1})
+
+		require.NoError(t, err)
+		require.Len(t, result.Warnings, 1)
+		assert.Equal(t, "crop_rotation", result.Warnings[0].Code)
+	})
+
+	t.Run("check error rejects the placement", func(t *testing.T) {
+		mockPlantRepo := new(MockGardenPlantRepository)
+		mockGardenRepo := new(MockGardenRepository)
+		check := &stubPlacementCheck{err: entity.NewValidationError("plant_id", "placement rejected")}
+		service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, new(MockGardenZoneRepository), newMemoryEventRepository(), &mocks.StubTransactionManager{}, check)
+
+		mockGardenRepo.On("FindByID", ctx, "garden-123").Return(&entity.Garden{GardenID: "garden-123"}, nil)
+		mockPlantRepo.On("ValidatePlantLocation", ctx, "garden-123", location).Return(nil)
+
+		_, err := service.PlacePlant(ctx, &entity.GardenPlant{GardenID: "garden-123", PlantID: "plant-123", LocationGeoJSON: location, Quantity: 1})
+
+		require.Error(t, err)
+		mockPlantRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+// stubBulkPlacementCheck warns every plant in a batch about the plant after it
+type stubBulkPlacementCheck struct {
+	stubPlacementCheck
+}
+
+func (s *stubBulkPlacementCheck) CheckBulkPlacement(ctx context.Context, gardenPlants []*entity.GardenPlant) ([][]entity.PlacementWarning, error) {
+	warnings := make([][]entity.PlacementWarning, len(gardenPlants))
+	for i := 0; i+1 < len(gardenPlants); i++ {
+		warnings[i] = []entity.PlacementWarning{{Code: "spacing_conflict", Details: map[string]interface{}{"garden_plant_id": gardenPlants[i+1].GardenPlantID}}}
+	}
+	return warnings, nil
+}
+
+func TestPlantPlacementService_BulkPlacePlants_PlacementChecks(t *testing.T) {
+	ctx := context.Background()
+	location := `{"type":"Point","coordinates":[-122.4194,37.7749]}`
+
+	mockPlantRepo := new(MockGardenPlantRepository)
+	mockGardenRepo := new(MockGardenRepository)
+	perPlant := &stubPlacementCheck{warnings: []entity.PlacementWarning{{Code: "crop_rotation"}}}
+	service := NewPlantPlacementService(mockPlantRepo, mockGardenRepo, new(MockGardenZoneRepository), newMemoryEventRepository(), &mocks.StubTransactionManager{}, perPlant, &stubBulkPlacementCheck{})
+
+	mockGardenRepo.On("FindByID", ctx, "garden-123").Return(&entity.Garden{GardenID: "garden-123"}, nil)
+	mockPlantRepo.On("ValidatePlantLocation", ctx, "garden-123", location).Return(nil)
+	mockPlantRepo.On("BulkCreate", ctx, mock.AnythingOfType("[]*entity.GardenPlant")).Return(nil)
+
+	result, err := service.BulkPlacePlants(ctx, []*entity.GardenPlant{
+		{GardenID: "garden-123", PlantID: "plant-123", LocationGeoJSON: location, Quantity: 1},
+		{GardenID: "garden-123", PlantID: "plant-123", LocationGeoJSON: location, Quantity: 1},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Len(t, result[0].Warnings, 2)
+	assert.Equal(t, "crop_rotation", result[0].Warnings[0].Code)
+	assert.Equal(t, result[1].GardenPlantID, result[0].Warnings[1].Details["garden_plant_id"])
+	require.Len(t, result[1].Warnings, 1)
+}
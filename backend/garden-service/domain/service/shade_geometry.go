@@ -0,0 +1,276 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"twigger-backend/backend/shared/geometry"
+)
+
+// Geometry helpers for shade simulation.
+// All shadow math is done in a local planar frame (meters east/north of the
+// garden origin), which is accurate enough at garden scale.
+
+const (
+	// circleSegments controls how finely tree canopies are approximated
+	circleSegments = 16
+
+	// defaultFootprintRadiusM is used for point features without a canopy diameter (posts, trunks)
+	defaultFootprintRadiusM = 0.25
+)
+
+// solarPosition returns the sun's altitude and azimuth (degrees, azimuth clockwise from north)
+// using the NOAA general solar position equations
+func solarPosition(lat, lng float64, t time.Time) (altitudeDeg, azimuthDeg float64) {
+	t = t.UTC()
+	hour := float64(t.Hour()) + float64(t.Minute())/60 + float64(t.Second())/3600
+	gamma := 2 * math.Pi / 365 * (float64(t.YearDay()-1) + (hour-12)/24)
+
+	eqTime := 229.18 * (0.000075 + 0.001868*math.Cos(gamma) - 0.032077*math.Sin(gamma) -
+		0.014615*math.Cos(2*gamma) - 0.040849*math.Sin(2*gamma))
+	decl := 0.006918 - 0.399912*math.Cos(gamma) + 0.070257*math.Sin(gamma) -
+		0.006758*math.Cos(2*gamma) + 0.000907*math.Sin(2*gamma) -
+		0.002697*math.Cos(3*gamma) + 0.00148*math.Sin(3*gamma)
+
+	trueSolarMinutes := hour*60 + eqTime + 4*lng
+	hourAngle := (trueSolarMinutes/4 - 180) * math.Pi / 180
+	phi := lat * math.Pi / 180
+
+	cosZenith := math.Sin(phi)*math.Sin(decl) + math.Cos(phi)*math.Cos(decl)*math.Cos(hourAngle)
+	cosZenith = math.Max(-1, math.Min(1, cosZenith))
+	altitudeDeg = 90 - math.Acos(cosZenith)*180/math.Pi
+
+	azimuth := math.Atan2(math.Sin(hourAngle), math.Cos(hourAngle)*math.Sin(phi)-math.Tan(decl)*math.Cos(phi))
+	azimuthDeg = math.Mod(azimuth*180/math.Pi+180, 360)
+
+	return altitudeDeg, azimuthDeg
+}
+
+// shadowOffset returns the ground displacement of the shadow cast by the top of an
+// object of the given height. Shadows point away from the sun.
+func shadowOffset(heightM, altitudeDeg, azimuthDeg float64) geometry.Point {
+	length := heightM / math.Tan(altitudeDeg*math.Pi/180)
+	az := azimuthDeg * math.Pi / 180
+	return geometry.Point{X: -length * math.Sin(az), Y: -length * math.Cos(az)}
+}
+
+// parseGeometryRings extracts the outer rings (or vertex lists) of a GeoJSON geometry.
+// Points return a single one-vertex ring; LineStrings return their vertices.
+func parseGeometryRings(geojsonStr string, proj *geometry.LocalProjection) ([][]geometry.Point, error) {
+	var geom struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	if err := json.Unmarshal([]byte(geojsonStr), &geom); err != nil {
+		return nil, fmt.Errorf("invalid geojson: %w", err)
+	}
+
+	toRing := func(coords [][]float64) []geometry.Point {
+		ring := make([]geometry.Point, 0, len(coords))
+		for _, c := range coords {
+			if len(c) >= 2 {
+				ring = append(ring, proj.ToLocal(c[0], c[1]))
+			}
+		}
+		return ring
+	}
+
+	switch geom.Type {
+	case "Point":
+		var c []float64
+		if err := json.Unmarshal(geom.Coordinates, &c); err != nil || len(c) < 2 {
+			return nil, fmt.Errorf("invalid point coordinates")
+		}
+		return [][]geometry.Point{{proj.ToLocal(c[0], c[1])}}, nil
+	case "LineString", "MultiPoint":
+		var coords [][]float64
+		if err := json.Unmarshal(geom.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("invalid %s coordinates", strings.ToLower(geom.Type))
+		}
+		return [][]geometry.Point{toRing(coords)}, nil
+	case "Polygon":
+		var coords [][][]float64
+		if err := json.Unmarshal(geom.Coordinates, &coords); err != nil || len(coords) == 0 {
+			return nil, fmt.Errorf("invalid polygon coordinates")
+		}
+		return [][]geometry.Point{toRing(coords[0])}, nil
+	case "MultiPolygon":
+		var coords [][][][]float64
+		if err := json.Unmarshal(geom.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("invalid multipolygon coordinates")
+		}
+		var rings [][]geometry.Point
+		for _, polygon := range coords {
+			if len(polygon) > 0 {
+				rings = append(rings, toRing(polygon[0]))
+			}
+		}
+		return rings, nil
+	default:
+		return nil, fmt.Errorf("unsupported geometry type for shade calculation: %s", geom.Type)
+	}
+}
+
+// parsePointLngLat extracts lng/lat from a GeoJSON Point
+func parsePointLngLat(geojsonStr string) (lng, lat float64, err error) {
+	var geom struct {
+		Type        string    `json:"type"`
+		Coordinates []float64 `json:"coordinates"`
+	}
+	if err := json.Unmarshal([]byte(geojsonStr), &geom); err != nil {
+		return 0, 0, fmt.Errorf("invalid geojson: %w", err)
+	}
+	if geom.Type != "Point" || len(geom.Coordinates) < 2 {
+		return 0, 0, fmt.Errorf("location must be a GeoJSON Point")
+	}
+	return geom.Coordinates[0], geom.Coordinates[1], nil
+}
+
+// circlePolygon approximates a circle as a polygon
+func circlePolygon(center geometry.Point, radius float64) []geometry.Point {
+	pts := make([]geometry.Point, circleSegments)
+	for i := 0; i < circleSegments; i++ {
+		angle := 2 * math.Pi * float64(i) / circleSegments
+		pts[i] = geometry.Point{X: center.X + radius*math.Cos(angle), Y: center.Y + radius*math.Sin(angle)}
+	}
+	return pts
+}
+
+// convexHull returns the convex hull of a point set in counter-clockwise order (monotone chain)
+func convexHull(points []geometry.Point) []geometry.Point {
+	if len(points) < 3 {
+		return points
+	}
+
+	pts := make([]geometry.Point, len(points))
+	copy(pts, points)
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i].X == pts[j].X {
+			return pts[i].Y < pts[j].Y
+		}
+		return pts[i].X < pts[j].X
+	})
+
+	cross := func(o, a, b geometry.Point) float64 {
+		return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+	}
+
+	hull := make([]geometry.Point, 0, 2*len(pts))
+	for _, p := range pts {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	lower := len(hull) + 1
+	for i := len(pts) - 2; i >= 0; i-- {
+		p := pts[i]
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	return hull[:len(hull)-1]
+}
+
+// samplePolygon returns a regular grid of points inside the rings, targeting maxSamples points
+func samplePolygon(rings [][]geometry.Point, maxSamples int) []geometry.Point {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, ring := range rings {
+		for _, p := range ring {
+			minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+			minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+		}
+	}
+	if math.IsInf(minX, 0) {
+		return nil
+	}
+
+	width, height := maxX-minX, maxY-minY
+	step := math.Sqrt(width * height / float64(maxSamples))
+	if step <= 0 {
+		step = 0.1
+	}
+
+	var samples []geometry.Point
+	for y := minY + step/2; y < maxY; y += step {
+		for x := minX + step/2; x < maxX; x += step {
+			pt := geometry.Point{X: x, Y: y}
+			for _, ring := range rings {
+				if geometry.PointInRing(pt, ring) {
+					samples = append(samples, pt)
+					break
+				}
+			}
+		}
+	}
+
+	// Degenerate or very thin zones: fall back to the vertex centroid
+	if len(samples) == 0 {
+		var sum geometry.Point
+		count := 0
+		for _, ring := range rings {
+			for _, p := range ring {
+				sum.X += p.X
+				sum.Y += p.Y
+				count++
+			}
+		}
+		samples = append(samples, geometry.Point{X: sum.X / float64(count), Y: sum.Y / float64(count)})
+	}
+
+	return samples
+}
+
+// ringToGeoJSON converts a local ring back to a closed GeoJSON Polygon
+func ringToGeoJSON(ring []geometry.Point, proj *geometry.LocalProjection) string {
+	data, _ := json.Marshal(struct {
+		Type        string        `json:"type"`
+		Coordinates [][][]float64 `json:"coordinates"`
+	}{
+		Type:        "Polygon",
+		Coordinates: [][][]float64{closedLngLatRing(ring, proj)},
+	})
+	return string(data)
+}
+
+// ringsToGeoJSON converts local rings to a GeoJSON Polygon, or a MultiPolygon when there
+// are several disjoint parts
+func ringsToGeoJSON(rings [][]geometry.Point, proj *geometry.LocalProjection) string {
+	if len(rings) == 1 {
+		return ringToGeoJSON(rings[0], proj)
+	}
+
+	polygons := make([][][][]float64, 0, len(rings))
+	for _, ring := range rings {
+		polygons = append(polygons, [][][]float64{closedLngLatRing(ring, proj)})
+	}
+
+	data, _ := json.Marshal(struct {
+		Type        string          `json:"type"`
+		Coordinates [][][][]float64 `json:"coordinates"`
+	}{
+		Type:        "MultiPolygon",
+		Coordinates: polygons,
+	})
+	return string(data)
+}
+
+// closedLngLatRing converts a local ring to lng/lat coordinates, repeating the first vertex
+func closedLngLatRing(ring []geometry.Point, proj *geometry.LocalProjection) [][]float64 {
+	coords := make([][]float64, 0, len(ring)+1)
+	for _, p := range ring {
+		lng, lat := proj.ToLngLat(p)
+		coords = append(coords, []float64{lng, lat})
+	}
+	if len(coords) > 0 {
+		coords = append(coords, coords[0])
+	}
+	return coords
+}
@@ -0,0 +1,391 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/shared/geometry"
+)
+
+const (
+	// Fraction of direct sunlight blocked by each kind of obstacle
+	solidShadeOpacity   = 1.0
+	leafOnShadeOpacity  = 0.9 // foliage lets some light through
+	leafOffShadeOpacity = 0.4 // bare branches of deciduous trees in winter
+
+	// zoneSampleCount is the target number of sample points per zone
+	zoneSampleCount = 200
+)
+
+// ShadeService defines the business logic for simulating shade cast by garden features
+type ShadeService interface {
+	// CalculateShadows computes hourly shadow polygons cast by garden features on a date
+	CalculateShadows(ctx context.Context, gardenID string, date time.Time) ([]*HourlyShadows, error)
+
+	// CalculateZoneSunHours computes summer and winter solstice sun hours for every zone
+	CalculateZoneSunHours(ctx context.Context, gardenID string) (*ShadeAnalysis, error)
+
+	// RecalculateZoneSunHours computes solstice sun hours and persists them on each zone
+	RecalculateZoneSunHours(ctx context.Context, gardenID string) (*ShadeAnalysis, error)
+}
+
+// ShadowPolygon is the ground shadow of a single feature at a point in time
+type ShadowPolygon struct {
+	FeatureID       string             `json:"feature_id"`
+	FeatureType     entity.FeatureType `json:"feature_type"`
+	GeometryGeoJSON string             `json:"geometry"`
+	Opacity         float64            `json:"opacity"` // 0-1, fraction of sunlight blocked
+}
+
+// HourlyShadows holds the sun position and all feature shadows for one daylight hour
+type HourlyShadows struct {
+	SolarHour      int             `json:"solar_hour"` // local solar time, hour starting
+	Time           time.Time       `json:"time"`       // UTC instant of the sample (mid-hour)
+	SunAltitudeDeg float64         `json:"sun_altitude_deg"`
+	SunAzimuthDeg  float64         `json:"sun_azimuth_deg"`
+	Shadows        []ShadowPolygon `json:"shadows"`
+}
+
+// ZoneSunHours holds the simulated direct sun hours for a zone
+type ZoneSunHours struct {
+	ZoneID         string  `json:"zone_id"`
+	ZoneName       *string `json:"zone_name,omitempty"`
+	SunHoursSummer int     `json:"sun_hours_summer"`
+	SunHoursWinter int     `json:"sun_hours_winter"`
+}
+
+// ShadeAnalysis holds the results of a solstice shade simulation for a garden
+type ShadeAnalysis struct {
+	GardenID       string         `json:"garden_id"`
+	Latitude       float64        `json:"latitude"`
+	Longitude      float64        `json:"longitude"`
+	SummerSolstice time.Time      `json:"summer_solstice"`
+	WinterSolstice time.Time      `json:"winter_solstice"`
+	Zones          []ZoneSunHours `json:"zones"`
+	CalculatedAt   time.Time      `json:"calculated_at"`
+}
+
+// shadeCaster is a feature prepared for shadow projection in the local frame.
+// Each part of a multi-part footprint casts its own shadow.
+type shadeCaster struct {
+	feature   *entity.GardenFeature
+	footprint [][]geometry.Point
+	heightM   float64
+}
+
+// shadeService implements ShadeService
+type shadeService struct {
+	gardenRepo  repository.GardenRepository
+	zoneRepo    repository.GardenZoneRepository
+	featureRepo repository.GardenFeatureRepository
+}
+
+// NewShadeService creates a new shade service instance
+func NewShadeService(
+	gardenRepo repository.GardenRepository,
+	zoneRepo repository.GardenZoneRepository,
+	featureRepo repository.GardenFeatureRepository,
+) ShadeService {
+	return &shadeService{
+		gardenRepo:  gardenRepo,
+		zoneRepo:    zoneRepo,
+		featureRepo: featureRepo,
+	}
+}
+
+// CalculateShadows computes hourly shadow polygons cast by garden features on a date
+func (s *shadeService) CalculateShadows(ctx context.Context, gardenID string, date time.Time) ([]*HourlyShadows, error) {
+	if gardenID == "" {
+		return nil, entity.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	lat, lng, err := s.gardenLocation(ctx, gardenID)
+	if err != nil {
+		return nil, err
+	}
+
+	proj := geometry.NewLocalProjection(lat, lng)
+	casters, err := s.loadShadeCasters(ctx, gardenID, proj)
+	if err != nil {
+		return nil, err
+	}
+
+	leafOff := isLeafOffPeriod(date, lat)
+	var hours []*HourlyShadows
+	for hour := 0; hour < 24; hour++ {
+		t := solarHourToUTC(date, lng, hour)
+		altitude, azimuth := solarPosition(lat, lng, t)
+		if altitude <= 0 {
+			continue
+		}
+
+		hourly := &HourlyShadows{
+			SolarHour:      hour,
+			Time:           t,
+			SunAltitudeDeg: altitude,
+			SunAzimuthDeg:  azimuth,
+			Shadows:        []ShadowPolygon{},
+		}
+		for _, c := range casters {
+			hourly.Shadows = append(hourly.Shadows, ShadowPolygon{
+				FeatureID:       c.feature.FeatureID,
+				FeatureType:     c.feature.FeatureType,
+				GeometryGeoJSON: ringsToGeoJSON(c.shadow(altitude, azimuth), proj),
+				Opacity:         shadeOpacity(c.feature, leafOff),
+			})
+		}
+		hours = append(hours, hourly)
+	}
+
+	return hours, nil
+}
+
+// CalculateZoneSunHours computes summer and winter solstice sun hours for every zone
+func (s *shadeService) CalculateZoneSunHours(ctx context.Context, gardenID string) (*ShadeAnalysis, error) {
+	analysis, _, err := s.simulateZones(ctx, gardenID)
+	return analysis, err
+}
+
+// RecalculateZoneSunHours computes solstice sun hours and persists them on each zone
+func (s *shadeService) RecalculateZoneSunHours(ctx context.Context, gardenID string) (*ShadeAnalysis, error) {
+	analysis, zones, err := s.simulateZones(ctx, gardenID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, zone := range zones {
+		summer := analysis.Zones[i].SunHoursSummer
+		winter := analysis.Zones[i].SunHoursWinter
+		zone.SunHoursSummer = &summer
+		zone.SunHoursWinter = &winter
+
+		if err := s.zoneRepo.Update(ctx, zone); err != nil {
+			return nil, fmt.Errorf("failed to update zone sun hours: %w", err)
+		}
+	}
+
+	return analysis, nil
+}
+
+// simulateZones runs the solstice simulation and returns results aligned with the loaded zones
+func (s *shadeService) simulateZones(ctx context.Context, gardenID string) (*ShadeAnalysis, []*entity.GardenZone, error) {
+	if gardenID == "" {
+		return nil, nil, entity.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	lat, lng, err := s.gardenLocation(ctx, gardenID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zones, err := s.zoneRepo.FindByGardenID(ctx, gardenID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list zones: %w", err)
+	}
+
+	proj := geometry.NewLocalProjection(lat, lng)
+	casters, err := s.loadShadeCasters(ctx, gardenID, proj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summer, winter := solsticeDates(time.Now().Year(), lat)
+	analysis := &ShadeAnalysis{
+		GardenID:       gardenID,
+		Latitude:       lat,
+		Longitude:      lng,
+		SummerSolstice: summer,
+		WinterSolstice: winter,
+		Zones:          make([]ZoneSunHours, 0, len(zones)),
+		CalculatedAt:   time.Now(),
+	}
+
+	for _, zone := range zones {
+		rings, err := parseGeometryRings(zone.GeometryGeoJSON, proj)
+		if err != nil {
+			return nil, nil, entity.NewSpatialError("shade_zone_geometry", err.Error())
+		}
+		samples := samplePolygon(rings, zoneSampleCount)
+
+		analysis.Zones = append(analysis.Zones, ZoneSunHours{
+			ZoneID:         zone.ZoneID,
+			ZoneName:       zone.ZoneName,
+			SunHoursSummer: sunHoursForSamples(samples, casters, lat, lng, summer),
+			SunHoursWinter: sunHoursForSamples(samples, casters, lat, lng, winter),
+		})
+	}
+
+	return analysis, zones, nil
+}
+
+// gardenLocation resolves the garden's lat/lng from its location point, falling back to the boundary centroid
+func (s *shadeService) gardenLocation(ctx context.Context, gardenID string) (lat, lng float64, err error) {
+	garden, err := s.gardenRepo.FindByID(ctx, gardenID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("garden not found: %w", err)
+	}
+
+	if garden.LocationGeoJSON != nil && *garden.LocationGeoJSON != "" {
+		lng, lat, err = parsePointLngLat(*garden.LocationGeoJSON)
+		if err != nil {
+			return 0, 0, entity.NewSpatialError("shade_garden_location", err.Error())
+		}
+		return lat, lng, nil
+	}
+
+	lat, lng, err = s.gardenRepo.GetCenterPoint(ctx, gardenID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to determine garden location: %w", err)
+	}
+
+	return lat, lng, nil
+}
+
+// loadShadeCasters loads features with height and converts them to local footprints
+func (s *shadeService) loadShadeCasters(ctx context.Context, gardenID string, proj *geometry.LocalProjection) ([]shadeCaster, error) {
+	features, err := s.featureRepo.FindFeaturesWithHeight(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load garden features: %w", err)
+	}
+
+	var casters []shadeCaster
+	for _, feature := range features {
+		if !feature.IsShadeProducing() {
+			continue
+		}
+
+		rings, err := parseGeometryRings(feature.GeometryGeoJSON, proj)
+		if err != nil {
+			return nil, entity.NewSpatialError("shade_feature_geometry", err.Error())
+		}
+
+		footprint := make([][]geometry.Point, 0, len(rings))
+		for _, ring := range rings {
+			if len(ring) == 1 {
+				// Point features: use the canopy if known, otherwise a small trunk/post
+				radius := defaultFootprintRadiusM
+				if feature.CanopyDiameterM != nil && *feature.CanopyDiameterM > 0 {
+					radius = *feature.CanopyDiameterM / 2
+				}
+				footprint = append(footprint, circlePolygon(ring[0], radius))
+				continue
+			}
+			footprint = append(footprint, ring)
+		}
+
+		casters = append(casters, shadeCaster{
+			feature:   feature,
+			footprint: footprint,
+			heightM:   *feature.HeightM,
+		})
+	}
+
+	return casters, nil
+}
+
+// shadow projects each footprint part as a vertical prism and returns their ground shadows.
+// Parts are kept apart so the ground between them stays lit.
+func (c shadeCaster) shadow(altitudeDeg, azimuthDeg float64) [][]geometry.Point {
+	offset := shadowOffset(c.heightM, altitudeDeg, azimuthDeg)
+	shadows := make([][]geometry.Point, 0, len(c.footprint))
+	for _, part := range c.footprint {
+		pts := make([]geometry.Point, 0, 2*len(part))
+		for _, p := range part {
+			pts = append(pts, p, geometry.Point{X: p.X + offset.X, Y: p.Y + offset.Y})
+		}
+		shadows = append(shadows, convexHull(pts))
+	}
+	return shadows
+}
+
+// shades reports whether pt lies in any of a caster's shadow parts
+func shades(shadows [][]geometry.Point, pt geometry.Point) bool {
+	for _, shadow := range shadows {
+		if geometry.PointInRing(pt, shadow) {
+			return true
+		}
+	}
+	return false
+}
+
+// sunHoursForSamples integrates direct sunlight over a day, averaged across the sample points
+func sunHoursForSamples(samples []geometry.Point, casters []shadeCaster, lat, lng float64, date time.Time) int {
+	if len(samples) == 0 {
+		return 0
+	}
+
+	leafOff := isLeafOffPeriod(date, lat)
+	total := 0.0
+	for hour := 0; hour < 24; hour++ {
+		altitude, azimuth := solarPosition(lat, lng, solarHourToUTC(date, lng, hour))
+		if altitude <= 0 {
+			continue
+		}
+
+		shadows := make([][][]geometry.Point, len(casters))
+		for i, c := range casters {
+			shadows[i] = c.shadow(altitude, azimuth)
+		}
+
+		light := 0.0
+		for _, pt := range samples {
+			transmitted := 1.0
+			for i, c := range casters {
+				if shades(shadows[i], pt) {
+					transmitted *= 1 - shadeOpacity(c.feature, leafOff)
+				}
+			}
+			light += transmitted
+		}
+		total += light / float64(len(samples))
+	}
+
+	hours := int(math.Round(total))
+	if hours > 24 {
+		hours = 24
+	}
+	return hours
+}
+
+// shadeOpacity returns the fraction of sunlight a feature blocks
+func shadeOpacity(feature *entity.GardenFeature, leafOff bool) float64 {
+	switch feature.FeatureType {
+	case entity.FeatureTypeTree, entity.FeatureTypeShrub:
+		if leafOff && feature.Deciduous != nil && *feature.Deciduous {
+			return leafOffShadeOpacity
+		}
+		return leafOnShadeOpacity
+	default:
+		return solidShadeOpacity
+	}
+}
+
+// isLeafOffPeriod reports whether deciduous plants are bare on the given date (Nov-Mar north, May-Sep south)
+func isLeafOffPeriod(date time.Time, lat float64) bool {
+	month := date.Month()
+	if lat < 0 {
+		return month >= time.May && month <= time.September
+	}
+	return month >= time.November || month <= time.March
+}
+
+// solsticeDates returns the summer and winter solstice for the hemisphere of the given latitude
+func solsticeDates(year int, lat float64) (summer, winter time.Time) {
+	june := time.Date(year, time.June, 21, 0, 0, 0, 0, time.UTC)
+	december := time.Date(year, time.December, 21, 0, 0, 0, 0, time.UTC)
+	if lat < 0 {
+		return december, june
+	}
+	return june, december
+}
+
+// solarHourToUTC converts a local solar hour on a date to the UTC instant at mid-hour
+func solarHourToUTC(date time.Time, lng float64, solarHour int) time.Time {
+	midnight := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	offsetHours := float64(solarHour) + 0.5 - lng/15
+	return midnight.Add(time.Duration(offsetHours * float64(time.Hour)))
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/shared/geometry"
+)
+
+const (
+	shadeTestLat = 51.5
+	shadeTestLng = 0.0
+)
+
+// localRectGeoJSON builds a GeoJSON polygon from a rectangle in meters around the test origin
+func localRectGeoJSON(minX, minY, maxX, maxY float64) string {
+	proj := geometry.NewLocalProjection(shadeTestLat, shadeTestLng)
+	return ringToGeoJSON([]geometry.Point{
+		{X: minX, Y: minY}, {X: maxX, Y: minY}, {X: maxX, Y: maxY}, {X: minX, Y: maxY},
+	}, proj)
+}
+
+func shadeTestGarden(gardenID string) *entity.Garden {
+	location := `{"type":"Point","coordinates":[0.0,51.5]}`
+	return &entity.Garden{GardenID: gardenID, GardenName: "Test Garden", LocationGeoJSON: &location}
+}
+
+func TestSolarPosition_SolarNoonSummerSolstice(t *testing.T) {
+	noon := time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC)
+
+	altitude, azimuth := solarPosition(shadeTestLat, shadeTestLng, noon)
+
+	// 90 - latitude + declination (~23.44)
+	assert.InDelta(t, 61.9, altitude, 0.5)
+	assert.InDelta(t, 180.0, azimuth, 2.0)
+}
+
+func TestShadowOffset_PointsAwayFromSun(t *testing.T) {
+	// Sun due south at 45 degrees: a 2m object casts a 2m shadow due north
+	offset := shadowOffset(2.0, 45, 180)
+
+	assert.InDelta(t, 0.0, offset.X, 1e-9)
+	assert.InDelta(t, 2.0, offset.Y, 1e-9)
+}
+
+func TestShadeService_CalculateZoneSunHours_OpenZone(t *testing.T) {
+	mockGardenRepo := new(MockGardenRepository)
+	mockZoneRepo := new(MockGardenZoneRepository)
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	service := NewShadeService(mockGardenRepo, mockZoneRepo, mockFeatureRepo)
+
+	ctx := context.Background()
+	gardenID := "garden-123"
+	zones := []*entity.GardenZone{
+		{ZoneID: "zone-1", GardenID: gardenID, GeometryGeoJSON: localRectGeoJSON(-1, 0.5, 1, 2.5)},
+	}
+
+	mockGardenRepo.On("FindByID", ctx, gardenID).Return(shadeTestGarden(gardenID), nil)
+	mockZoneRepo.On("FindByGardenID", ctx, gardenID).Return(zones, nil)
+	mockFeatureRepo.On("FindFeaturesWithHeight", ctx, gardenID).Return([]*entity.GardenFeature{}, nil)
+
+	analysis, err := service.CalculateZoneSunHours(ctx, gardenID)
+
+	assert.NoError(t, err)
+	assert.Len(t, analysis.Zones, 1)
+	// London: ~16.5h of daylight in June, ~8h in December
+	assert.InDelta(t, 16, analysis.Zones[0].SunHoursSummer, 1)
+	assert.InDelta(t, 8, analysis.Zones[0].SunHoursWinter, 1)
+	assert.Equal(t, time.June, analysis.SummerSolstice.Month())
+}
+
+func TestShadeService_CalculateZoneSunHours_WallShadesZone(t *testing.T) {
+	mockGardenRepo := new(MockGardenRepository)
+	mockZoneRepo := new(MockGardenZoneRepository)
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	service := NewShadeService(mockGardenRepo, mockZoneRepo, mockFeatureRepo)
+
+	ctx := context.Background()
+	gardenID := "garden-123"
+	height := 3.0
+
+	// 3m wall directly south of the zone
+	wall := &entity.GardenFeature{
+		FeatureID:       "wall-1",
+		GardenID:        gardenID,
+		FeatureType:     entity.FeatureTypeWall,
+		GeometryGeoJSON: localRectGeoJSON(-5, -0.2, 5, 0),
+		HeightM:         &height,
+	}
+	zones := []*entity.GardenZone{
+		{ZoneID: "zone-1", GardenID: gardenID, GeometryGeoJSON: localRectGeoJSON(-1, 0.5, 1, 2.5)},
+	}
+
+	mockGardenRepo.On("FindByID", ctx, gardenID).Return(shadeTestGarden(gardenID), nil)
+	mockZoneRepo.On("FindByGardenID", ctx, gardenID).Return(zones, nil)
+	mockFeatureRepo.On("FindFeaturesWithHeight", ctx, gardenID).Return([]*entity.GardenFeature{wall}, nil)
+
+	analysis, err := service.CalculateZoneSunHours(ctx, gardenID)
+
+	assert.NoError(t, err)
+	// Low winter sun leaves the bed in shade all day; summer loses the midday hours
+	assert.LessOrEqual(t, analysis.Zones[0].SunHoursWinter, 1)
+	assert.Less(t, analysis.Zones[0].SunHoursSummer, 16)
+}
+
+func TestShadeService_CalculateZoneSunHours_DeciduousLeafOff(t *testing.T) {
+	ctx := context.Background()
+	gardenID := "garden-123"
+	height := 8.0
+	canopy := 4.0
+	proj := geometry.NewLocalProjection(shadeTestLat, shadeTestLng)
+	lng, lat := proj.ToLngLat(geometry.Point{X: 0, Y: -3})
+	treeLocation := fmt.Sprintf(`{"type":"Point","coordinates":[%f,%f]}`, lng, lat)
+
+	winterHours := func(deciduous bool) int {
+		mockGardenRepo := new(MockGardenRepository)
+		mockZoneRepo := new(MockGardenZoneRepository)
+		mockFeatureRepo := new(MockGardenFeatureRepository)
+		service := NewShadeService(mockGardenRepo, mockZoneRepo, mockFeatureRepo)
+
+		tree := &entity.GardenFeature{
+			FeatureID:       "tree-1",
+			GardenID:        gardenID,
+			FeatureType:     entity.FeatureTypeTree,
+			GeometryGeoJSON: treeLocation,
+			HeightM:         &height,
+			CanopyDiameterM: &canopy,
+			Deciduous:       &deciduous,
+		}
+		zones := []*entity.GardenZone{
+			{ZoneID: "zone-1", GardenID: gardenID, GeometryGeoJSON: localRectGeoJSON(-1, 0.5, 1, 2.5)},
+		}
+
+		mockGardenRepo.On("FindByID", ctx, gardenID).Return(shadeTestGarden(gardenID), nil)
+		mockZoneRepo.On("FindByGardenID", ctx, gardenID).Return(zones, nil)
+		mockFeatureRepo.On("FindFeaturesWithHeight", ctx, gardenID).Return([]*entity.GardenFeature{tree}, nil)
+
+		analysis, err := service.CalculateZoneSunHours(ctx, gardenID)
+		assert.NoError(t, err)
+		return analysis.Zones[0].SunHoursWinter
+	}
+
+	assert.Greater(t, winterHours(true), winterHours(false))
+}
+
+func TestShadeService_RecalculateZoneSunHours_PersistsZones(t *testing.T) {
+	mockGardenRepo := new(MockGardenRepository)
+	mockZoneRepo := new(MockGardenZoneRepository)
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	service := NewShadeService(mockGardenRepo, mockZoneRepo, mockFeatureRepo)
+
+	ctx := context.Background()
+	gardenID := "garden-123"
+	zone := &entity.GardenZone{ZoneID: "zone-1", GardenID: gardenID, GeometryGeoJSON: localRectGeoJSON(-1, 0.5, 1, 2.5)}
+
+	mockGardenRepo.On("FindByID", ctx, gardenID).Return(shadeTestGarden(gardenID), nil)
+	mockZoneRepo.On("FindByGardenID", ctx, gardenID).Return([]*entity.GardenZone{zone}, nil)
+	mockFeatureRepo.On("FindFeaturesWithHeight", ctx, gardenID).Return([]*entity.GardenFeature{}, nil)
+	mockZoneRepo.On("Update", ctx, mock.AnythingOfType("*entity.GardenZone")).Return(nil)
+
+	analysis, err := service.RecalculateZoneSunHours(ctx, gardenID)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, zone.SunHoursSummer)
+	assert.NotNil(t, zone.SunHoursWinter)
+	assert.Equal(t, analysis.Zones[0].SunHoursSummer, *zone.SunHoursSummer)
+	mockZoneRepo.AssertExpectations(t)
+}
+
+func TestShadeService_CalculateShadows_SkipsNightHours(t *testing.T) {
+	mockGardenRepo := new(MockGardenRepository)
+	mockZoneRepo := new(MockGardenZoneRepository)
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	service := NewShadeService(mockGardenRepo, mockZoneRepo, mockFeatureRepo)
+
+	ctx := context.Background()
+	gardenID := "garden-123"
+	height := 2.0
+	shed := &entity.GardenFeature{
+		FeatureID:       "shed-1",
+		GardenID:        gardenID,
+		FeatureType:     entity.FeatureTypeShed,
+		GeometryGeoJSON: localRectGeoJSON(0, 0, 2, 2),
+		HeightM:         &height,
+	}
+
+	mockGardenRepo.On("FindByID", ctx, gardenID).Return(shadeTestGarden(gardenID), nil)
+	mockFeatureRepo.On("FindFeaturesWithHeight", ctx, gardenID).Return([]*entity.GardenFeature{shed}, nil)
+
+	hours, err := service.CalculateShadows(ctx, gardenID, time.Date(2024, time.December, 21, 0, 0, 0, 0, time.UTC))
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hours)
+	assert.Less(t, len(hours), 12)
+	for _, h := range hours {
+		assert.Greater(t, h.SunAltitudeDeg, 0.0)
+		assert.Len(t, h.Shadows, 1)
+		assert.Equal(t, 1.0, h.Shadows[0].Opacity)
+	}
+}
+
+func TestShadeService_CalculateZoneSunHours_MultiPolygonKeepsPartsApart(t *testing.T) {
+	mockGardenRepo := new(MockGardenRepository)
+	mockZoneRepo := new(MockGardenZoneRepository)
+	mockFeatureRepo := new(MockGardenFeatureRepository)
+	service := NewShadeService(mockGardenRepo, mockZoneRepo, mockFeatureRepo)
+
+	ctx := context.Background()
+	gardenID := "garden-123"
+	height := 1.0
+
+	// Two low hedges well east and west of a bed in line with them; a single hull would cover the bed
+	proj := geometry.NewLocalProjection(shadeTestLat, shadeTestLng)
+	hedges := ringsToGeoJSON([][]geometry.Point{
+		{{X: -20, Y: -0.5}, {X: -18, Y: -0.5}, {X: -18, Y: 0.5}, {X: -20, Y: 0.5}},
+		{{X: 18, Y: -0.5}, {X: 20, Y: -0.5}, {X: 20, Y: 0.5}, {X: 18, Y: 0.5}},
+	}, proj)
+	feature := &entity.GardenFeature{
+		FeatureID:       "hedge-1",
+		GardenID:        gardenID,
+		FeatureType:     entity.FeatureTypeWall,
+		GeometryGeoJSON: hedges,
+		HeightM:         &height,
+	}
+	zones := []*entity.GardenZone{
+		{ZoneID: "zone-1", GardenID: gardenID, GeometryGeoJSON: localRectGeoJSON(-1, -0.5, 1, 0.5)},
+	}
+
+	mockGardenRepo.On("FindByID", ctx, gardenID).Return(shadeTestGarden(gardenID), nil)
+	mockZoneRepo.On("FindByGardenID", ctx, gardenID).Return(zones, nil)
+	mockFeatureRepo.On("FindFeaturesWithHeight", ctx, gardenID).Return([]*entity.GardenFeature{feature}, nil)
+
+	analysis, err := service.CalculateZoneSunHours(ctx, gardenID)
+
+	assert.NoError(t, err)
+	assert.Contains(t, hedges, `"MultiPolygon"`)
+	// Each hedge shades only the ground beside it, never the bed between them
+	assert.InDelta(t, 16, analysis.Zones[0].SunHoursSummer, 1)
+}
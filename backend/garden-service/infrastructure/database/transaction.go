@@ -85,6 +85,38 @@ func (tm *TxManager) WithTransactionIsolation(
 	return nil
 }
 
+// txContextKey carries the active transaction in a context
+type txContextKey struct{}
+
+// WithinTransaction executes fn within a database transaction carried by its context.
+// Repositories that query through Executor take part in the transaction. When ctx
+// already carries a transaction, fn joins it instead of starting another.
+func (tm *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	return tm.WithTransaction(ctx, func(tx *sql.Tx) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// Querier is satisfied by both *sql.DB and *sql.Tx
+type Querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// Executor returns the transaction carried by ctx, or db outside a transaction
+func Executor(ctx context.Context, db *sql.DB) Querier {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
 // TxRepository wraps repositories to work within transactions
 type TxRepository struct {
 	tx *sql.Tx
@@ -380,6 +380,36 @@ func (r *PostgresGardenFeatureRepository) FindByIDs(ctx context.Context, feature
 	return features, nil
 }
 
+// ValidateFeatureWithinGarden validates that a feature is within the garden boundary using ST_Contains
+func (r *PostgresGardenFeatureRepository) ValidateFeatureWithinGarden(ctx context.Context, gardenID, featureGeometryGeoJSON string) error {
+	// Features can be Point or Polygon, so only validate GeoJSON structure
+	if err := database.ValidateGeoJSON(featureGeometryGeoJSON); err != nil {
+		return entity.NewSpatialError("feature_geometry_validation", err.Error())
+	}
+
+	query := `
+		SELECT ST_Contains(g.boundary, ST_GeomFromGeoJSON($2)::geometry)
+		FROM gardens g
+		WHERE g.garden_id = $1
+	`
+
+	var isWithin sql.NullBool
+	err := r.db.QueryRowContext(ctx, query, gardenID, featureGeometryGeoJSON).Scan(&isWithin)
+
+	if err == sql.ErrNoRows {
+		return entity.NewNotFoundError("garden", gardenID)
+	}
+	if err != nil {
+		return entity.NewDatabaseError("validate_feature_within_garden", err)
+	}
+
+	if !isWithin.Valid || !isWithin.Bool {
+		return entity.NewSpatialError("feature_validation", "feature geometry is not within garden boundary")
+	}
+
+	return nil
+}
+
 // FindFeaturesWithHeight finds features that have height data (for shade calculations)
 func (r *PostgresGardenFeatureRepository) FindFeaturesWithHeight(ctx context.Context, gardenID string) ([]*entity.GardenFeature, error) {
 	query := `
@@ -0,0 +1,259 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/garden-service/infrastructure/database"
+)
+
+// PostgresGardenPlantEventRepository implements repository.GardenPlantEventRepository using PostgreSQL
+type PostgresGardenPlantEventRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresGardenPlantEventRepository creates a new PostgreSQL garden plant event repository
+func NewPostgresGardenPlantEventRepository(db *sql.DB) repository.GardenPlantEventRepository {
+	return &PostgresGardenPlantEventRepository{db: db}
+}
+
+const insertGardenPlantEventQuery = `
+	INSERT INTO garden_plant_events (
+		event_id, garden_plant_id, garden_id, plant_id, zone_id,
+		event_type, occurred_at, notes, details, created_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+// Create appends an event to the log
+func (r *PostgresGardenPlantEventRepository) Create(ctx context.Context, event *entity.GardenPlantEvent) error {
+	args, err := prepareGardenPlantEvent(event, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if _, err := database.Executor(ctx, r.db).ExecContext(ctx, insertGardenPlantEventQuery, args...); err != nil {
+		return entity.NewDatabaseError("garden_plant_event_create", err)
+	}
+
+	return nil
+}
+
+// BulkCreate appends several events in one transaction, joining the caller's
+// transaction when ctx carries one
+func (r *PostgresGardenPlantEventRepository) BulkCreate(ctx context.Context, events []*entity.GardenPlantEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return database.NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		stmt, err := database.Executor(ctx, r.db).PrepareContext(ctx, insertGardenPlantEventQuery)
+		if err != nil {
+			return entity.NewDatabaseError("garden_plant_event_bulk_prepare", err)
+		}
+		defer stmt.Close()
+
+		now := time.Now()
+		for _, event := range events {
+			args, err := prepareGardenPlantEvent(event, now)
+			if err != nil {
+				return err
+			}
+
+			if _, err := stmt.ExecContext(ctx, args...); err != nil {
+				return entity.NewDatabaseError("garden_plant_event_bulk_exec", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// FindByGardenPlantID retrieves a plant's events, newest first
+func (r *PostgresGardenPlantEventRepository) FindByGardenPlantID(ctx context.Context, gardenPlantID string, filter *repository.GardenPlantEventFilter) ([]*entity.GardenPlantEvent, error) {
+	where, args := buildGardenPlantEventWhere(gardenPlantID, filter)
+
+	query := `
+		SELECT event_id, garden_plant_id, garden_id, plant_id, zone_id,
+			event_type, occurred_at, notes, details, created_at
+		FROM garden_plant_events
+		WHERE ` + where + `
+		ORDER BY occurred_at DESC, created_at DESC`
+
+	if filter != nil && filter.Limit > 0 {
+		args = append(args, filter.Limit, filter.Offset)
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.NewDatabaseError("garden_plant_event_find_by_garden_plant", err)
+	}
+	defer rows.Close()
+
+	events := make([]*entity.GardenPlantEvent, 0)
+	for rows.Next() {
+		event, err := scanGardenPlantEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewDatabaseError("garden_plant_event_rows_iteration", err)
+	}
+
+	return events, nil
+}
+
+// CountByGardenPlantID counts a plant's events matching the filter (ignoring limit/offset)
+func (r *PostgresGardenPlantEventRepository) CountByGardenPlantID(ctx context.Context, gardenPlantID string, filter *repository.GardenPlantEventFilter) (int, error) {
+	where, args := buildGardenPlantEventWhere(gardenPlantID, filter)
+
+	var count int
+	query := `SELECT COUNT(*) FROM garden_plant_events WHERE ` + where
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, entity.NewDatabaseError("garden_plant_event_count", err)
+	}
+
+	return count, nil
+}
+
+// FindZonePlantings summarizes which plants were in a zone since the given time.
+// Moves out of the zone are logged against the new zone, so they are matched on from_zone_id.
+func (r *PostgresGardenPlantEventRepository) FindZonePlantings(ctx context.Context, zoneID string, since time.Time) ([]*repository.ZonePlanting, error) {
+	query := `
+		SELECT garden_plant_id, plant_id, MIN(occurred_at), MAX(occurred_at)
+		FROM (
+			SELECT garden_plant_id, plant_id, occurred_at
+			FROM garden_plant_events
+			WHERE zone_id = $1 AND occurred_at >= $3
+			UNION ALL
+			SELECT garden_plant_id, plant_id, occurred_at
+			FROM garden_plant_events
+			WHERE event_type = 'moved' AND details->>'from_zone_id' = $2 AND occurred_at >= $3
+		) zone_events
+		GROUP BY garden_plant_id, plant_id
+		ORDER BY MIN(occurred_at)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, zoneID, zoneID, since)
+	if err != nil {
+		return nil, entity.NewDatabaseError("garden_plant_event_find_zone_plantings", err)
+	}
+	defer rows.Close()
+
+	plantings := make([]*repository.ZonePlanting, 0)
+	for rows.Next() {
+		var planting repository.ZonePlanting
+		if err := rows.Scan(&planting.GardenPlantID, &planting.PlantID, &planting.FirstSeen, &planting.LastSeen); err != nil {
+			return nil, entity.NewDatabaseError("garden_plant_event_zone_planting_scan", err)
+		}
+		plantings = append(plantings, &planting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewDatabaseError("garden_plant_event_rows_iteration", err)
+	}
+
+	return plantings, nil
+}
+
+// buildGardenPlantEventWhere builds the WHERE clause shared by find and count
+func buildGardenPlantEventWhere(gardenPlantID string, filter *repository.GardenPlantEventFilter) (string, []interface{}) {
+	whereClauses := []string{"garden_plant_id = $1"}
+	args := []interface{}{gardenPlantID}
+
+	if filter != nil && len(filter.EventTypes) > 0 {
+		eventTypes := make([]string, len(filter.EventTypes))
+		for i, eventType := range filter.EventTypes {
+			eventTypes[i] = string(eventType)
+		}
+		args = append(args, pq.Array(eventTypes))
+		whereClauses = append(whereClauses, fmt.Sprintf("event_type = ANY($%d)", len(args)))
+	}
+
+	return strings.Join(whereClauses, " AND "), args
+}
+
+// prepareGardenPlantEvent validates an event, fills in defaults and returns the insert arguments
+func prepareGardenPlantEvent(event *entity.GardenPlantEvent, now time.Time) ([]interface{}, error) {
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = now
+	}
+	event.CreatedAt = now
+
+	if err := event.Validate(); err != nil {
+		return nil, entity.NewValidationError("garden_plant_event", err.Error())
+	}
+
+	details := event.Details
+	if details == nil {
+		details = map[string]interface{}{}
+	}
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		return nil, entity.NewValidationError("details", fmt.Sprintf("failed to encode details: %v", err))
+	}
+
+	return []interface{}{
+		event.EventID,
+		event.GardenPlantID,
+		event.GardenID,
+		event.PlantID,
+		event.ZoneID,
+		event.EventType,
+		event.OccurredAt,
+		event.Notes,
+		detailsJSON,
+		event.CreatedAt,
+	}, nil
+}
+
+// scanGardenPlantEvent scans a row into a GardenPlantEvent
+func scanGardenPlantEvent(rows *sql.Rows) (*entity.GardenPlantEvent, error) {
+	var event entity.GardenPlantEvent
+	var zoneID, notes sql.NullString
+	var detailsJSON []byte
+
+	err := rows.Scan(
+		&event.EventID,
+		&event.GardenPlantID,
+		&event.GardenID,
+		&event.PlantID,
+		&zoneID,
+		&event.EventType,
+		&event.OccurredAt,
+		&notes,
+		&detailsJSON,
+		&event.CreatedAt,
+	)
+	if err != nil {
+		return nil, entity.NewDatabaseError("garden_plant_event_scan", err)
+	}
+
+	if zoneID.Valid {
+		event.ZoneID = &zoneID.String
+	}
+	if notes.Valid {
+		event.Notes = &notes.String
+	}
+	if len(detailsJSON) > 0 {
+		if err := json.Unmarshal(detailsJSON, &event.Details); err != nil {
+			return nil, entity.NewDatabaseError("garden_plant_event_details_unmarshal", err)
+		}
+	}
+
+	return &event, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"twigger-backend/backend/garden-service/domain/entity"
 	"twigger-backend/backend/garden-service/domain/repository"
 	"twigger-backend/backend/garden-service/infrastructure/database"
@@ -54,7 +55,7 @@ func (r *PostgresGardenPlantRepository) Create(ctx context.Context, gardenPlant
 		)
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := database.Executor(ctx, r.db).ExecContext(ctx, query,
 		gardenPlant.GardenPlantID,
 		gardenPlant.GardenID,
 		gardenPlant.ZoneID,
@@ -247,7 +248,7 @@ func (r *PostgresGardenPlantRepository) Update(ctx context.Context, gardenPlant
 		WHERE garden_plant_id = $1
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := database.Executor(ctx, r.db).ExecContext(ctx, query,
 		gardenPlant.GardenPlantID,
 		gardenPlant.ZoneID,
 		gardenPlant.LocationGeoJSON,
@@ -280,7 +281,7 @@ func (r *PostgresGardenPlantRepository) Update(ctx context.Context, gardenPlant
 func (r *PostgresGardenPlantRepository) Delete(ctx context.Context, gardenPlantID string) error {
 	query := `DELETE FROM garden_plants WHERE garden_plant_id = $1`
 
-	result, err := r.db.ExecContext(ctx, query, gardenPlantID)
+	result, err := database.Executor(ctx, r.db).ExecContext(ctx, query, gardenPlantID)
 	if err != nil {
 		return entity.NewDatabaseError("garden_plant_delete", err)
 	}
@@ -322,24 +323,13 @@ func (r *PostgresGardenPlantRepository) FindByIDs(ctx context.Context, gardenPla
 	return r.scanGardenPlants(rows)
 }
 
-// BulkCreate creates multiple garden plants in a single transaction
+// BulkCreate creates multiple garden plants in a single transaction, joining the
+// caller's transaction when ctx carries one
 func (r *PostgresGardenPlantRepository) BulkCreate(ctx context.Context, gardenPlants []*entity.GardenPlant) error {
 	if len(gardenPlants) == 0 {
 		return nil
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return entity.NewDatabaseError("bulk_create_begin_transaction", err)
-	}
-
-	defer func() {
-		if p := recover(); p != nil {
-			_ = tx.Rollback()
-			panic(p)
-		}
-	}()
-
 	query := `
 		INSERT INTO garden_plants (
 			garden_plant_id, garden_id, zone_id, plant_id, location,
@@ -351,67 +341,146 @@ func (r *PostgresGardenPlantRepository) BulkCreate(ctx context.Context, gardenPl
 		)
 	`
 
-	stmt, err := tx.PrepareContext(ctx, query)
-	if err != nil {
-		_ = tx.Rollback()
-		return entity.NewDatabaseError("bulk_create_prepare", err)
+	return database.NewTxManager(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		stmt, err := database.Executor(ctx, r.db).PrepareContext(ctx, query)
+		if err != nil {
+			return entity.NewDatabaseError("bulk_create_prepare", err)
+		}
+		defer stmt.Close()
+
+		now := time.Now()
+		for _, gardenPlant := range gardenPlants {
+			// Validate each plant
+			if err := gardenPlant.Validate(); err != nil {
+				return entity.NewValidationError("garden_plant", err.Error())
+			}
+
+			// Validate GeoJSON
+			if err := database.ValidateGeoJSON(gardenPlant.LocationGeoJSON); err != nil {
+				return entity.NewSpatialError("location_validation", err.Error())
+			}
+
+			// Generate ID if needed
+			if gardenPlant.GardenPlantID == "" {
+				gardenPlant.GardenPlantID = uuid.New().String()
+			}
+
+			// Set timestamps
+			gardenPlant.CreatedAt = now
+			gardenPlant.UpdatedAt = now
+
+			_, err := stmt.ExecContext(ctx,
+				gardenPlant.GardenPlantID,
+				gardenPlant.GardenID,
+				gardenPlant.ZoneID,
+				gardenPlant.PlantID,
+				gardenPlant.LocationGeoJSON,
+				gardenPlant.PlantedDate,
+				gardenPlant.RemovedDate,
+				gardenPlant.Quantity,
+				gardenPlant.PlantSource,
+				gardenPlant.HealthStatus,
+				gardenPlant.Notes,
+				gardenPlant.CreatedAt,
+				gardenPlant.UpdatedAt,
+			)
+			if err != nil {
+				return entity.NewDatabaseError("bulk_create_exec", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// CheckPlantSpacing finds plants within minimum distance of a location (ST_DWithin)
+func (r *PostgresGardenPlantRepository) CheckPlantSpacing(ctx context.Context, gardenID, locationGeoJSON string, minDistanceM float64) ([]*entity.GardenPlant, error) {
+	// Validate GeoJSON
+	if err := database.ValidateGeoJSON(locationGeoJSON); err != nil {
+		return nil, entity.NewSpatialError("location_validation", err.Error())
 	}
-	defer stmt.Close()
 
-	now := time.Now()
-	for _, gardenPlant := range gardenPlants {
-		// Validate each plant
-		if err := gardenPlant.Validate(); err != nil {
-			_ = tx.Rollback()
-			return entity.NewValidationError("garden_plant", err.Error())
-		}
+	query := `
+		SELECT
+			garden_plant_id, garden_id, zone_id, plant_id,
+			ST_AsGeoJSON(location) as location,
+			planted_date, removed_date, quantity, plant_source,
+			health_status, notes, created_at, updated_at,
+			ST_Distance(location::geography, ST_GeomFromGeoJSON($3)::geography) as distance_m
+		FROM garden_plants
+		WHERE garden_id = $1
+		  AND removed_date IS NULL
+		  AND ST_DWithin(
+		      location::geography,
+		      ST_GeomFromGeoJSON($3)::geography,
+		      $2
+		  )
+		ORDER BY distance_m
+	`
 
-		// Validate GeoJSON
-		if err := database.ValidateGeoJSON(gardenPlant.LocationGeoJSON); err != nil {
-			_ = tx.Rollback()
-			return entity.NewSpatialError("location_validation", err.Error())
-		}
+	rows, err := r.db.QueryContext(ctx, query, gardenID, minDistanceM, locationGeoJSON)
+	if err != nil {
+		return nil, entity.NewDatabaseError("check_plant_spacing", err)
+	}
+	defer rows.Close()
 
-		// Generate ID if needed
-		if gardenPlant.GardenPlantID == "" {
-			gardenPlant.GardenPlantID = uuid.New().String()
-		}
+	var plants []*entity.GardenPlant
+	for rows.Next() {
+		var gardenPlant entity.GardenPlant
+		var zoneID sql.NullString
+		var plantedDate, removedDate sql.NullTime
+		var plantSource, healthStatus, notes sql.NullString
+		var distanceM float64
 
-		// Set timestamps
-		gardenPlant.CreatedAt = now
-		gardenPlant.UpdatedAt = now
-
-		_, err := stmt.ExecContext(ctx,
-			gardenPlant.GardenPlantID,
-			gardenPlant.GardenID,
-			gardenPlant.ZoneID,
-			gardenPlant.PlantID,
-			gardenPlant.LocationGeoJSON,
-			gardenPlant.PlantedDate,
-			gardenPlant.RemovedDate,
-			gardenPlant.Quantity,
-			gardenPlant.PlantSource,
-			gardenPlant.HealthStatus,
-			gardenPlant.Notes,
-			gardenPlant.CreatedAt,
-			gardenPlant.UpdatedAt,
+		err := rows.Scan(
+			&gardenPlant.GardenPlantID,
+			&gardenPlant.GardenID,
+			&zoneID,
+			&gardenPlant.PlantID,
+			&gardenPlant.LocationGeoJSON,
+			&plantedDate,
+			&removedDate,
+			&gardenPlant.Quantity,
+			&plantSource,
+			&healthStatus,
+			&notes,
+			&gardenPlant.CreatedAt,
+			&gardenPlant.UpdatedAt,
+			&distanceM,
 		)
-
 		if err != nil {
-			_ = tx.Rollback()
-			return entity.NewDatabaseError("bulk_create_exec", err)
+			return nil, entity.NewDatabaseError("garden_plant_scan", err)
 		}
-	}
 
-	if err := tx.Commit(); err != nil {
-		return entity.NewDatabaseError("bulk_create_commit", err)
+		// Map nullable fields
+		if zoneID.Valid {
+			gardenPlant.ZoneID = &zoneID.String
+		}
+		if plantedDate.Valid {
+			gardenPlant.PlantedDate = &plantedDate.Time
+		}
+		if removedDate.Valid {
+			gardenPlant.RemovedDate = &removedDate.Time
+		}
+		if plantSource.Valid {
+			gardenPlant.PlantSource = &plantSource.String
+		}
+		if healthStatus.Valid {
+			statusValue := entity.HealthStatus(healthStatus.String)
+			gardenPlant.HealthStatus = &statusValue
+		}
+		if notes.Valid {
+			gardenPlant.Notes = &notes.String
+		}
+
+		plants = append(plants, &gardenPlant)
 	}
 
-	return nil
+	return plants, nil
 }
 
-// CheckPlantSpacing finds plants within minimum distance of a location (ST_DWithin)
-func (r *PostgresGardenPlantRepository) CheckPlantSpacing(ctx context.Context, gardenID, locationGeoJSON string, minDistanceM float64) ([]*entity.GardenPlant, error) {
+// FindNearby finds active plants within a radius of a location, nearest first, with their distances
+func (r *PostgresGardenPlantRepository) FindNearby(ctx context.Context, gardenID, locationGeoJSON string, radiusM float64) ([]*repository.NearbyGardenPlant, error) {
 	// Validate GeoJSON
 	if err := database.ValidateGeoJSON(locationGeoJSON); err != nil {
 		return nil, entity.NewSpatialError("location_validation", err.Error())
@@ -435,13 +504,13 @@ func (r *PostgresGardenPlantRepository) CheckPlantSpacing(ctx context.Context, g
 		ORDER BY distance_m
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, gardenID, minDistanceM, locationGeoJSON)
+	rows, err := r.db.QueryContext(ctx, query, gardenID, radiusM, locationGeoJSON)
 	if err != nil {
-		return nil, entity.NewDatabaseError("check_plant_spacing", err)
+		return nil, entity.NewDatabaseError("find_nearby", err)
 	}
 	defer rows.Close()
 
-	var plants []*entity.GardenPlant
+	var nearby []*repository.NearbyGardenPlant
 	for rows.Next() {
 		var gardenPlant entity.GardenPlant
 		var zoneID sql.NullString
@@ -490,10 +559,105 @@ func (r *PostgresGardenPlantRepository) CheckPlantSpacing(ctx context.Context, g
 			gardenPlant.Notes = &notes.String
 		}
 
-		plants = append(plants, &gardenPlant)
+		nearby = append(nearby, &repository.NearbyGardenPlant{
+			GardenPlant: &gardenPlant,
+			DistanceM:   distanceM,
+		})
 	}
 
-	return plants, nil
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewDatabaseError("find_nearby_rows_iteration", err)
+	}
+
+	return nearby, nil
+}
+
+// FindClosePairs finds every pair of active plants in a garden within a distance of each other, closest first
+func (r *PostgresGardenPlantRepository) FindClosePairs(ctx context.Context, gardenID string, maxDistanceM float64) ([]*repository.GardenPlantPair, error) {
+	query := `
+		SELECT
+			a.garden_plant_id, a.plant_id,
+			b.garden_plant_id, b.plant_id,
+			ST_Distance(a.location::geography, b.location::geography) as distance_m
+		FROM garden_plants a
+		JOIN garden_plants b
+		  ON b.garden_id = a.garden_id
+		 AND b.garden_plant_id > a.garden_plant_id
+		 AND b.removed_date IS NULL
+		 AND ST_DWithin(a.location::geography, b.location::geography, $2)
+		WHERE a.garden_id = $1
+		  AND a.removed_date IS NULL
+		ORDER BY distance_m
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, gardenID, maxDistanceM)
+	if err != nil {
+		return nil, entity.NewDatabaseError("find_close_pairs", err)
+	}
+	defer rows.Close()
+
+	var pairs []*repository.GardenPlantPair
+	for rows.Next() {
+		var pair repository.GardenPlantPair
+		if err := rows.Scan(&pair.GardenPlantAID, &pair.PlantAID, &pair.GardenPlantBID, &pair.PlantBID, &pair.DistanceM); err != nil {
+			return nil, entity.NewDatabaseError("garden_plant_pair_scan", err)
+		}
+		pairs = append(pairs, &pair)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewDatabaseError("find_close_pairs_rows_iteration", err)
+	}
+
+	return pairs, nil
+}
+
+// PairwiseDistances measures the distance between every pair of the given locations
+// within maxDistanceM of each other. Indexes refer to positions in locationsGeoJSON.
+func (r *PostgresGardenPlantRepository) PairwiseDistances(ctx context.Context, locationsGeoJSON []string, maxDistanceM float64) ([]*repository.LocationPair, error) {
+	if len(locationsGeoJSON) < 2 {
+		return nil, nil
+	}
+
+	for _, location := range locationsGeoJSON {
+		if err := database.ValidateGeoJSON(location); err != nil {
+			return nil, entity.NewSpatialError("location_validation", err.Error())
+		}
+	}
+
+	query := `
+		WITH points AS (
+			SELECT ord - 1 AS idx, ST_GeomFromGeoJSON(geojson)::geography AS location
+			FROM unnest($1::text[]) WITH ORDINALITY AS t(geojson, ord)
+		)
+		SELECT a.idx, b.idx, ST_Distance(a.location, b.location) as distance_m
+		FROM points a
+		JOIN points b
+		  ON b.idx > a.idx
+		 AND ST_DWithin(a.location, b.location, $2)
+		ORDER BY a.idx, b.idx
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(locationsGeoJSON), maxDistanceM)
+	if err != nil {
+		return nil, entity.NewDatabaseError("pairwise_distances", err)
+	}
+	defer rows.Close()
+
+	var pairs []*repository.LocationPair
+	for rows.Next() {
+		var pair repository.LocationPair
+		if err := rows.Scan(&pair.I, &pair.J, &pair.DistanceM); err != nil {
+			return nil, entity.NewDatabaseError("location_pair_scan", err)
+		}
+		pairs = append(pairs, &pair)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewDatabaseError("pairwise_distances_rows_iteration", err)
+	}
+
+	return pairs, nil
 }
 
 // FindInZone finds plants within a zone using ST_Contains
@@ -52,17 +52,27 @@ func (r *PostgresGardenRepository) Create(ctx context.Context, garden *entity.Ga
 	query := `
 		INSERT INTO gardens (
 			garden_id, user_id, garden_name, boundary, location,
-			elevation_m, slope_degrees, aspect, hardiness_zone, garden_type,
+			elevation_m, slope_degrees, aspect, hardiness_zone, garden_type, workspace_id,
 			created_at, updated_at
 		) VALUES (
 			$1, $2, $3,
 			CASE WHEN $4::text IS NOT NULL THEN ST_GeomFromGeoJSON($4) ELSE NULL END,
 			CASE WHEN $5::text IS NOT NULL THEN ST_GeomFromGeoJSON($5)::geography ELSE NULL END,
-			$6, $7, $8, $9, $10, $11, $12
+			$6, $7, $8, $9, $10,
+			-- Default to the owner's first workspace so the garden is shared with its members
+			COALESCE($11, (
+				SELECT workspace_id FROM workspaces
+				WHERE owner_id = $2
+				ORDER BY created_at ASC
+				LIMIT 1
+			)),
+			$12, $13
 		)
+		RETURNING workspace_id
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	var workspaceID sql.NullString
+	err := r.db.QueryRowContext(ctx, query,
 		garden.GardenID,
 		garden.UserID,
 		garden.GardenName,
@@ -73,14 +83,19 @@ func (r *PostgresGardenRepository) Create(ctx context.Context, garden *entity.Ga
 		garden.Aspect,
 		garden.HardinessZone,
 		garden.GardenType,
+		garden.WorkspaceID,
 		garden.CreatedAt,
 		garden.UpdatedAt,
-	)
+	).Scan(&workspaceID)
 
 	if err != nil {
 		return entity.NewDatabaseError("garden_create", err)
 	}
 
+	if workspaceID.Valid {
+		garden.WorkspaceID = &workspaceID.String
+	}
+
 	return nil
 }
 
@@ -92,7 +107,7 @@ func (r *PostgresGardenRepository) FindByID(ctx context.Context, gardenID string
 			ST_AsGeoJSON(boundary) as boundary,
 			ST_AsGeoJSON(location::geometry) as location,
 			elevation_m, slope_degrees, aspect,
-			hardiness_zone, garden_type,
+			hardiness_zone, garden_type, workspace_id,
 			created_at, updated_at
 		FROM gardens
 		WHERE garden_id = $1
@@ -101,7 +116,7 @@ func (r *PostgresGardenRepository) FindByID(ctx context.Context, gardenID string
 	var garden entity.Garden
 	var boundaryJSON, locationJSON sql.NullString
 	var elevationM, slopeDegrees sql.NullFloat64
-	var aspect, hardinessZone, gardenType sql.NullString
+	var aspect, hardinessZone, gardenType, workspaceID sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, gardenID).Scan(
 		&garden.GardenID,
@@ -114,6 +129,7 @@ func (r *PostgresGardenRepository) FindByID(ctx context.Context, gardenID string
 		&aspect,
 		&hardinessZone,
 		&gardenType,
+		&workspaceID,
 		&garden.CreatedAt,
 		&garden.UpdatedAt,
 	)
@@ -149,6 +165,9 @@ func (r *PostgresGardenRepository) FindByID(ctx context.Context, gardenID string
 		typeValue := entity.GardenType(gardenType.String)
 		garden.GardenType = &typeValue
 	}
+	if workspaceID.Valid {
+		garden.WorkspaceID = &workspaceID.String
+	}
 
 	return &garden, nil
 }
@@ -168,7 +187,7 @@ func (r *PostgresGardenRepository) FindByUserID(ctx context.Context, userID stri
 			ST_AsGeoJSON(boundary) as boundary,
 			ST_AsGeoJSON(location::geometry) as location,
 			elevation_m, slope_degrees, aspect,
-			hardiness_zone, garden_type,
+			hardiness_zone, garden_type, workspace_id,
 			created_at, updated_at
 		FROM gardens
 		WHERE user_id = $1
@@ -182,64 +201,74 @@ func (r *PostgresGardenRepository) FindByUserID(ctx context.Context, userID stri
 	}
 	defer rows.Close()
 
-	var gardens []*entity.Garden
-	for rows.Next() {
-		var garden entity.Garden
-		var boundaryJSON, locationJSON sql.NullString
-		var elevationM, slopeDegrees sql.NullFloat64
-		var aspect, hardinessZone, gardenType sql.NullString
+	return scanGardenRows(rows)
+}
 
-		err := rows.Scan(
-			&garden.GardenID,
-			&garden.UserID,
-			&garden.GardenName,
-			&boundaryJSON,
-			&locationJSON,
-			&elevationM,
-			&slopeDegrees,
-			&aspect,
-			&hardinessZone,
-			&gardenType,
-			&garden.CreatedAt,
-			&garden.UpdatedAt,
-		)
-		if err != nil {
-			return nil, entity.NewDatabaseError("garden_scan", err)
-		}
+// FindByWorkspaceMember finds gardens the user owns or that belong to any of their workspaces
+func (r *PostgresGardenRepository) FindByWorkspaceMember(ctx context.Context, userID string, limit, offset int) ([]*entity.Garden, error) {
+	if limit <= 0 {
+		limit = 100 // Default limit
+	}
+	if limit > 1000 {
+		limit = 1000 // Max limit
+	}
 
-		// Map nullable fields
-		if boundaryJSON.Valid {
-			garden.BoundaryGeoJSON = &boundaryJSON.String
-		}
-		if locationJSON.Valid {
-			garden.LocationGeoJSON = &locationJSON.String
-		}
-		if elevationM.Valid {
-			garden.ElevationM = &elevationM.Float64
-		}
-		if slopeDegrees.Valid {
-			garden.SlopeDegrees = &slopeDegrees.Float64
-		}
-		if aspect.Valid {
-			aspectValue := entity.Aspect(aspect.String)
-			garden.Aspect = &aspectValue
-		}
-		if hardinessZone.Valid {
-			garden.HardinessZone = &hardinessZone.String
-		}
-		if gardenType.Valid {
-			typeValue := entity.GardenType(gardenType.String)
-			garden.GardenType = &typeValue
-		}
+	query := `
+		SELECT
+			garden_id, user_id, garden_name,
+			ST_AsGeoJSON(boundary) as boundary,
+			ST_AsGeoJSON(location::geometry) as location,
+			elevation_m, slope_degrees, aspect,
+			hardiness_zone, garden_type, workspace_id,
+			created_at, updated_at
+		FROM gardens
+		WHERE user_id = $1
+		   OR workspace_id IN (
+				SELECT workspace_id FROM workspace_members WHERE user_id = $1
+		   )
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
 
-		gardens = append(gardens, &garden)
+	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, entity.NewDatabaseError("garden_find_by_workspace_member", err)
 	}
+	defer rows.Close()
 
-	if err = rows.Err(); err != nil {
-		return nil, entity.NewDatabaseError("garden_rows_iteration", err)
+	return scanGardenRows(rows)
+}
+
+// FindByWorkspaceID finds all gardens in a workspace with pagination
+func (r *PostgresGardenRepository) FindByWorkspaceID(ctx context.Context, workspaceID string, limit, offset int) ([]*entity.Garden, error) {
+	if limit <= 0 {
+		limit = 100 // Default limit
+	}
+	if limit > 1000 {
+		limit = 1000 // Max limit
 	}
 
-	return gardens, nil
+	query := `
+		SELECT
+			garden_id, user_id, garden_name,
+			ST_AsGeoJSON(boundary) as boundary,
+			ST_AsGeoJSON(location::geometry) as location,
+			elevation_m, slope_degrees, aspect,
+			hardiness_zone, garden_type, workspace_id,
+			created_at, updated_at
+		FROM gardens
+		WHERE workspace_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, workspaceID, limit, offset)
+	if err != nil {
+		return nil, entity.NewDatabaseError("garden_find_by_workspace_id", err)
+	}
+	defer rows.Close()
+
+	return scanGardenRows(rows)
 }
 
 // Update updates a garden
@@ -269,7 +298,8 @@ func (r *PostgresGardenRepository) Update(ctx context.Context, garden *entity.Ga
 			aspect = $7,
 			hardiness_zone = $8,
 			garden_type = $9,
-			updated_at = $10
+			workspace_id = $10,
+			updated_at = $11
 		WHERE garden_id = $1
 	`
 
@@ -283,6 +313,7 @@ func (r *PostgresGardenRepository) Update(ctx context.Context, garden *entity.Ga
 		garden.Aspect,
 		garden.HardinessZone,
 		garden.GardenType,
+		garden.WorkspaceID,
 		garden.UpdatedAt,
 	)
 
@@ -335,7 +366,7 @@ func (r *PostgresGardenRepository) FindByIDs(ctx context.Context, gardenIDs []st
 			ST_AsGeoJSON(boundary) as boundary,
 			ST_AsGeoJSON(location::geometry) as location,
 			elevation_m, slope_degrees, aspect,
-			hardiness_zone, garden_type,
+			hardiness_zone, garden_type, workspace_id,
 			created_at, updated_at
 		FROM gardens
 		WHERE garden_id = ANY($1)
@@ -352,7 +383,7 @@ func (r *PostgresGardenRepository) FindByIDs(ctx context.Context, gardenIDs []st
 		var garden entity.Garden
 		var boundaryJSON, locationJSON sql.NullString
 		var elevationM, slopeDegrees sql.NullFloat64
-		var aspect, hardinessZone, gardenType sql.NullString
+		var aspect, hardinessZone, gardenType, workspaceID sql.NullString
 
 		err := rows.Scan(
 			&garden.GardenID,
@@ -365,6 +396,7 @@ func (r *PostgresGardenRepository) FindByIDs(ctx context.Context, gardenIDs []st
 			&aspect,
 			&hardinessZone,
 			&gardenType,
+			&workspaceID,
 			&garden.CreatedAt,
 			&garden.UpdatedAt,
 		)
@@ -396,6 +428,9 @@ func (r *PostgresGardenRepository) FindByIDs(ctx context.Context, gardenIDs []st
 			typeValue := entity.GardenType(gardenType.String)
 			garden.GardenType = &typeValue
 		}
+		if workspaceID.Valid {
+			garden.WorkspaceID = &workspaceID.String
+		}
 
 		gardens = append(gardens, &garden)
 	}
@@ -418,7 +453,7 @@ func (r *PostgresGardenRepository) FindByLocation(ctx context.Context, lat, lng,
 			ST_AsGeoJSON(boundary) as boundary,
 			ST_AsGeoJSON(location::geometry) as location,
 			elevation_m, slope_degrees, aspect,
-			hardiness_zone, garden_type,
+			hardiness_zone, garden_type, workspace_id,
 			created_at, updated_at,
 			ST_Distance(location, ST_SetSRID(ST_MakePoint($1, $2), 4326)::geography) as distance_m
 		FROM gardens
@@ -441,7 +476,7 @@ func (r *PostgresGardenRepository) FindByLocation(ctx context.Context, lat, lng,
 		var garden entity.Garden
 		var boundaryJSON, locationJSON sql.NullString
 		var elevationM, slopeDegrees sql.NullFloat64
-		var aspect, hardinessZone, gardenType sql.NullString
+		var aspect, hardinessZone, gardenType, workspaceID sql.NullString
 		var distanceM float64
 
 		err := rows.Scan(
@@ -455,6 +490,7 @@ func (r *PostgresGardenRepository) FindByLocation(ctx context.Context, lat, lng,
 			&aspect,
 			&hardinessZone,
 			&gardenType,
+			&workspaceID,
 			&garden.CreatedAt,
 			&garden.UpdatedAt,
 			&distanceM,
@@ -487,6 +523,9 @@ func (r *PostgresGardenRepository) FindByLocation(ctx context.Context, lat, lng,
 			typeValue := entity.GardenType(gardenType.String)
 			garden.GardenType = &typeValue
 		}
+		if workspaceID.Valid {
+			garden.WorkspaceID = &workspaceID.String
+		}
 
 		gardens = append(gardens, &garden)
 	}
@@ -633,3 +672,69 @@ func (r *PostgresGardenRepository) GetTotalArea(ctx context.Context, userID stri
 
 	return totalArea, nil
 }
+
+// scanGardenRows scans garden rows selected with the standard column list
+func scanGardenRows(rows *sql.Rows) ([]*entity.Garden, error) {
+	var gardens []*entity.Garden
+	for rows.Next() {
+		var garden entity.Garden
+		var boundaryJSON, locationJSON sql.NullString
+		var elevationM, slopeDegrees sql.NullFloat64
+		var aspect, hardinessZone, gardenType, workspaceID sql.NullString
+
+		err := rows.Scan(
+			&garden.GardenID,
+			&garden.UserID,
+			&garden.GardenName,
+			&boundaryJSON,
+			&locationJSON,
+			&elevationM,
+			&slopeDegrees,
+			&aspect,
+			&hardinessZone,
+			&gardenType,
+			&workspaceID,
+			&garden.CreatedAt,
+			&garden.UpdatedAt,
+		)
+		if err != nil {
+			return nil, entity.NewDatabaseError("garden_scan", err)
+		}
+
+		// Map nullable fields
+		if boundaryJSON.Valid {
+			garden.BoundaryGeoJSON = &boundaryJSON.String
+		}
+		if locationJSON.Valid {
+			garden.LocationGeoJSON = &locationJSON.String
+		}
+		if elevationM.Valid {
+			garden.ElevationM = &elevationM.Float64
+		}
+		if slopeDegrees.Valid {
+			garden.SlopeDegrees = &slopeDegrees.Float64
+		}
+		if aspect.Valid {
+			aspectValue := entity.Aspect(aspect.String)
+			garden.Aspect = &aspectValue
+		}
+		if hardinessZone.Valid {
+			garden.HardinessZone = &hardinessZone.String
+		}
+		if gardenType.Valid {
+			typeValue := entity.GardenType(gardenType.String)
+			garden.GardenType = &typeValue
+		}
+		if workspaceID.Valid {
+			garden.WorkspaceID = &workspaceID.String
+		}
+
+		gardens = append(gardens, &garden)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, entity.NewDatabaseError("garden_rows_iteration", err)
+	}
+
+	return gardens, nil
+}
@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// HarvestUnit is the unit a harvest quantity is recorded in
+type HarvestUnit string
+
+const (
+	UnitKilograms HarvestUnit = "kg"
+	UnitGrams     HarvestUnit = "g"
+	UnitPounds    HarvestUnit = "lb"
+	UnitOunces    HarvestUnit = "oz"
+	UnitCount     HarvestUnit = "count" // Individual items, e.g. pumpkins or heads of lettuce
+)
+
+// HarvestQuality rates the produce from a harvest
+type HarvestQuality string
+
+const (
+	QualityExcellent HarvestQuality = "excellent"
+	QualityGood      HarvestQuality = "good"
+	QualityFair      HarvestQuality = "fair"
+	QualityPoor      HarvestQuality = "poor"
+)
+
+// MaxHarvestNotesLength limits free-text notes on a harvest
+const MaxHarvestNotesLength = 2000
+
+// kilogramsPerUnit converts weight units to kilograms
+var kilogramsPerUnit = map[HarvestUnit]float64{
+	UnitKilograms: 1,
+	UnitGrams:     0.001,
+	UnitPounds:    0.45359237,
+	UnitOunces:    0.028349523125,
+}
+
+// Harvest records the yield picked from a garden plant on one day
+type Harvest struct {
+	HarvestID     string  `json:"harvest_id"`
+	GardenPlantID string  `json:"garden_plant_id"`
+	GardenID      string  `json:"garden_id"`
+	PlantID       string  `json:"plant_id"`
+	ZoneID        *string `json:"zone_id,omitempty"` // Zone the plant was in when harvested
+
+	// Yield
+	HarvestedOn time.Time       `json:"harvested_on"`
+	Quantity    float64         `json:"quantity"`
+	Unit        HarvestUnit     `json:"unit"`
+	WeightKg    *float64        `json:"weight_kg,omitempty"` // Quantity normalized to kg; nil for counted harvests
+	Quality     *HarvestQuality `json:"quality,omitempty"`
+	Notes       *string         `json:"notes,omitempty"`
+
+	RecordedBy string    `json:"recorded_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// IsValid checks if the unit is valid
+func (u HarvestUnit) IsValid() bool {
+	return u == UnitCount || u.IsWeight()
+}
+
+// IsWeight returns true for units that measure weight rather than item count
+func (u HarvestUnit) IsWeight() bool {
+	_, ok := kilogramsPerUnit[u]
+	return ok
+}
+
+// ToKilograms converts a quantity in this unit to kilograms.
+// Returns false for count units.
+func (u HarvestUnit) ToKilograms(quantity float64) (float64, bool) {
+	factor, ok := kilogramsPerUnit[u]
+	if !ok {
+		return 0, false
+	}
+	return quantity * factor, true
+}
+
+// IsValid checks if the quality is valid
+func (q HarvestQuality) IsValid() bool {
+	switch q {
+	case QualityExcellent, QualityGood, QualityFair, QualityPoor:
+		return true
+	}
+	return false
+}
+
+// NormalizeWeight sets WeightKg from the quantity and unit
+func (h *Harvest) NormalizeWeight() {
+	h.WeightKg = nil
+	if kg, ok := h.Unit.ToKilograms(h.Quantity); ok {
+		h.WeightKg = &kg
+	}
+}
+
+// Validate validates the harvest entity
+func (h *Harvest) Validate() error {
+	if h.GardenPlantID == "" {
+		return fmt.Errorf("garden_plant_id is required")
+	}
+
+	if h.GardenID == "" {
+		return fmt.Errorf("garden_id is required")
+	}
+
+	if h.PlantID == "" {
+		return fmt.Errorf("plant_id is required")
+	}
+
+	if h.RecordedBy == "" {
+		return fmt.Errorf("recorded_by is required")
+	}
+
+	if h.HarvestedOn.IsZero() {
+		return fmt.Errorf("harvested_on is required")
+	}
+
+	if h.Quantity <= 0 {
+		return fmt.Errorf("quantity must be greater than 0")
+	}
+
+	if !h.Unit.IsValid() {
+		return fmt.Errorf("invalid unit: must be kg, g, lb, oz, or count")
+	}
+
+	if h.Quality != nil && !h.Quality.IsValid() {
+		return fmt.Errorf("invalid quality: must be excellent, good, fair, or poor")
+	}
+
+	if h.Notes != nil && len(*h.Notes) > MaxHarvestNotesLength {
+		return fmt.Errorf("notes must be %d characters or fewer", MaxHarvestNotesLength)
+	}
+
+	return nil
+}
+
+// YieldDimension is a grouping used when aggregating yield
+type YieldDimension string
+
+const (
+	YieldBySpecies YieldDimension = "species" // Per plant (species or cultivar), so varieties compare side by side
+	YieldByZone    YieldDimension = "zone"
+	YieldBySeason  YieldDimension = "season"
+)
+
+// IsValid checks if the dimension is valid
+func (d YieldDimension) IsValid() bool {
+	switch d {
+	case YieldBySpecies, YieldByZone, YieldBySeason:
+		return true
+	}
+	return false
+}
+
+// Season is a meteorological season, adjusted for the garden's hemisphere
+type Season string
+
+const (
+	SeasonSpring Season = "spring"
+	SeasonSummer Season = "summer"
+	SeasonAutumn Season = "autumn"
+	SeasonWinter Season = "winter"
+)
+
+// YieldSummary is one row of aggregated yield. Only the fields for the
+// requested dimensions are set.
+type YieldSummary struct {
+	// Species dimension
+	PlantID   *string `json:"plant_id,omitempty"`
+	PlantName *string `json:"plant_name,omitempty"`
+
+	// Zone dimension (nil zone ID groups harvests from plants outside any zone)
+	ZoneID   *string  `json:"zone_id,omitempty"`
+	ZoneName *string  `json:"zone_name,omitempty"`
+	AreaM2   *float64 `json:"area_m2,omitempty"`
+
+	// Season dimension. December counts toward the following year's season.
+	Year   *int    `json:"year,omitempty"`
+	Season *Season `json:"season,omitempty"`
+
+	// Totals
+	HarvestCount  int      `json:"harvest_count"`
+	TotalWeightKg float64  `json:"total_weight_kg"`
+	TotalItems    float64  `json:"total_items"`                // Sum of counted harvests
+	WeightKgPerM2 *float64 `json:"weight_kg_per_m2,omitempty"` // Set when grouped by a zone with a known area
+}
+
+// ComputeAreaYield sets WeightKgPerM2 when the row has a zone area
+func (y *YieldSummary) ComputeAreaYield() {
+	y.WeightKgPerM2 = nil
+	if y.AreaM2 != nil && *y.AreaM2 > 0 {
+		perM2 := y.TotalWeightKg / *y.AreaM2
+		y.WeightKgPerM2 = &perM2
+	}
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"twigger-backend/backend/harvest-service/domain/entity"
+)
+
+// HarvestRepository defines the interface for harvest persistence
+type HarvestRepository interface {
+	// CRUD operations
+	Create(ctx context.Context, harvest *entity.Harvest) error
+	FindByID(ctx context.Context, harvestID string) (*entity.Harvest, error)
+	Update(ctx context.Context, harvest *entity.Harvest) error
+	Delete(ctx context.Context, harvestID string) error
+
+	// Query operations
+	FindByGardenID(ctx context.Context, gardenID string, filter *HarvestFilter) ([]*entity.Harvest, error)
+	CountByGardenID(ctx context.Context, gardenID string, filter *HarvestFilter) (int, error)
+
+	// AggregateYield totals a garden's harvests grouped by the query's dimensions
+	AggregateYield(ctx context.Context, gardenID string, query *YieldQuery) ([]*entity.YieldSummary, error)
+}
+
+// HarvestFilter represents optional criteria for listing harvests
+type HarvestFilter struct {
+	From          *time.Time // Inclusive harvest date
+	To            *time.Time // Inclusive harvest date
+	ZoneID        *string
+	PlantID       *string
+	GardenPlantID *string
+
+	// Pagination
+	Limit  int
+	Offset int
+}
+
+// YieldQuery selects how harvests are grouped and which dates are included
+type YieldQuery struct {
+	GroupBy []entity.YieldDimension
+	From    *time.Time // Inclusive harvest date
+	To      *time.Time // Inclusive harvest date
+}
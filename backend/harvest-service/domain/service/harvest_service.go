@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/harvest-service/domain/entity"
+	"twigger-backend/backend/harvest-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// HarvestService defines the business logic for harvest logging and yield analytics
+type HarvestService interface {
+	// RecordHarvest logs a harvest against an active garden plant
+	RecordHarvest(ctx context.Context, gardenPlantID string, harvest *entity.Harvest) (*entity.Harvest, error)
+
+	// CRUD operations
+	GetHarvest(ctx context.Context, harvestID string) (*entity.Harvest, error)
+	UpdateHarvest(ctx context.Context, harvest *entity.Harvest) (*entity.Harvest, error)
+	DeleteHarvest(ctx context.Context, harvestID string) error
+
+	// ListHarvests retrieves a garden's harvests, newest first, with the total matching count
+	ListHarvests(ctx context.Context, gardenID string, filter *repository.HarvestFilter) ([]*entity.Harvest, int, error)
+
+	// GetYield aggregates a garden's harvests per species, zone and/or season
+	GetYield(ctx context.Context, gardenID string, query *repository.YieldQuery) ([]*entity.YieldSummary, error)
+
+	// ResolvePlantGarden returns the garden a garden plant belongs to, for authorization
+	ResolvePlantGarden(ctx context.Context, gardenPlantID string) (string, error)
+}
+
+// harvestService implements HarvestService
+type harvestService struct {
+	harvestRepo     repository.HarvestRepository
+	gardenPlantRepo gardenRepository.GardenPlantRepository
+	eventRepo       gardenRepository.GardenPlantEventRepository
+	txManager       gardenRepository.TransactionManager
+}
+
+// NewHarvestService creates a new harvest service instance
+func NewHarvestService(
+	harvestRepo repository.HarvestRepository,
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+	eventRepo gardenRepository.GardenPlantEventRepository,
+	txManager gardenRepository.TransactionManager,
+) HarvestService {
+	return &harvestService{
+		harvestRepo:     harvestRepo,
+		gardenPlantRepo: gardenPlantRepo,
+		eventRepo:       eventRepo,
+		txManager:       txManager,
+	}
+}
+
+// RecordHarvest logs a harvest against an active garden plant.
+// The harvest takes its garden, plant and zone from the garden plant and is
+// also added to the plant's event history.
+func (s *harvestService) RecordHarvest(ctx context.Context, gardenPlantID string, harvest *entity.Harvest) (*entity.Harvest, error) {
+	if gardenPlantID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+
+	if harvest == nil {
+		return nil, domainerrors.NewInvalidInputError("harvest", "harvest cannot be nil")
+	}
+
+	gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get garden plant: %w", err)
+	}
+
+	if !gardenPlant.IsActive() {
+		return nil, domainerrors.NewValidationError("garden_plant_id", "cannot record a harvest for a removed plant")
+	}
+
+	harvest.GardenPlantID = gardenPlant.GardenPlantID
+	harvest.GardenID = gardenPlant.GardenID
+	harvest.PlantID = gardenPlant.PlantID
+	harvest.ZoneID = gardenPlant.ZoneID
+
+	if harvest.HarvestedOn.IsZero() {
+		now := time.Now()
+		harvest.HarvestedOn = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	}
+
+	if err := validateHarvest(harvest); err != nil {
+		return nil, err
+	}
+
+	// The harvest and its event are saved together so a retry cannot record it twice
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.harvestRepo.Create(ctx, harvest); err != nil {
+			return fmt.Errorf("failed to create harvest: %w", err)
+		}
+		return s.recordHarvestEvent(ctx, gardenPlant, harvest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return harvest, nil
+}
+
+// GetHarvest retrieves a harvest by ID
+func (s *harvestService) GetHarvest(ctx context.Context, harvestID string) (*entity.Harvest, error) {
+	if harvestID == "" {
+		return nil, domainerrors.NewInvalidInputError("harvest_id", "harvest ID cannot be empty")
+	}
+
+	harvest, err := s.harvestRepo.FindByID(ctx, harvestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get harvest: %w", err)
+	}
+
+	return harvest, nil
+}
+
+// UpdateHarvest updates a harvest's date, quantity, unit, quality and notes
+func (s *harvestService) UpdateHarvest(ctx context.Context, harvest *entity.Harvest) (*entity.Harvest, error) {
+	if harvest == nil || harvest.HarvestID == "" {
+		return nil, domainerrors.NewInvalidInputError("harvest_id", "harvest ID cannot be empty")
+	}
+
+	existing, err := s.harvestRepo.FindByID(ctx, harvest.HarvestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get harvest: %w", err)
+	}
+
+	// Harvests cannot move between plants or change recorder
+	harvest.GardenPlantID = existing.GardenPlantID
+	harvest.GardenID = existing.GardenID
+	harvest.PlantID = existing.PlantID
+	harvest.ZoneID = existing.ZoneID
+	harvest.RecordedBy = existing.RecordedBy
+	harvest.CreatedAt = existing.CreatedAt
+
+	if err := validateHarvest(harvest); err != nil {
+		return nil, err
+	}
+
+	if err := s.harvestRepo.Update(ctx, harvest); err != nil {
+		return nil, fmt.Errorf("failed to update harvest: %w", err)
+	}
+
+	return harvest, nil
+}
+
+// DeleteHarvest deletes a harvest. The plant's history keeps its harvested event.
+func (s *harvestService) DeleteHarvest(ctx context.Context, harvestID string) error {
+	if harvestID == "" {
+		return domainerrors.NewInvalidInputError("harvest_id", "harvest ID cannot be empty")
+	}
+
+	if err := s.harvestRepo.Delete(ctx, harvestID); err != nil {
+		return fmt.Errorf("failed to delete harvest: %w", err)
+	}
+
+	return nil
+}
+
+// ListHarvests retrieves a garden's harvests, newest first, with the total matching count
+func (s *harvestService) ListHarvests(ctx context.Context, gardenID string, filter *repository.HarvestFilter) ([]*entity.Harvest, int, error) {
+	if gardenID == "" {
+		return nil, 0, domainerrors.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	if filter == nil {
+		filter = &repository.HarvestFilter{}
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 20 // Default limit
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100 // Maximum limit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	if err := validateDateRange(filter.From, filter.To); err != nil {
+		return nil, 0, err
+	}
+
+	harvests, err := s.harvestRepo.FindByGardenID(ctx, gardenID, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list harvests: %w", err)
+	}
+
+	total, err := s.harvestRepo.CountByGardenID(ctx, gardenID, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count harvests: %w", err)
+	}
+
+	return harvests, total, nil
+}
+
+// GetYield aggregates a garden's harvests per species, zone and/or season.
+// Rows grouped by zone include yield per m² when the zone has an area.
+func (s *harvestService) GetYield(ctx context.Context, gardenID string, query *repository.YieldQuery) ([]*entity.YieldSummary, error) {
+	if gardenID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	if query == nil || len(query.GroupBy) == 0 {
+		return nil, domainerrors.NewValidationError("group_by", "at least one of species, zone or season is required")
+	}
+
+	seen := make(map[entity.YieldDimension]bool)
+	for _, dimension := range query.GroupBy {
+		if !dimension.IsValid() {
+			return nil, domainerrors.NewValidationError("group_by", fmt.Sprintf("invalid dimension %q: must be species, zone, or season", dimension))
+		}
+		if seen[dimension] {
+			return nil, domainerrors.NewValidationError("group_by", fmt.Sprintf("dimension %q is repeated", dimension))
+		}
+		seen[dimension] = true
+	}
+
+	if err := validateDateRange(query.From, query.To); err != nil {
+		return nil, err
+	}
+
+	summaries, err := s.harvestRepo.AggregateYield(ctx, gardenID, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate yield: %w", err)
+	}
+
+	for _, summary := range summaries {
+		summary.ComputeAreaYield()
+	}
+
+	return summaries, nil
+}
+
+// ResolvePlantGarden returns the garden a garden plant belongs to, for authorization
+func (s *harvestService) ResolvePlantGarden(ctx context.Context, gardenPlantID string) (string, error) {
+	if gardenPlantID == "" {
+		return "", domainerrors.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+
+	gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get garden plant: %w", err)
+	}
+
+	return gardenPlant.GardenID, nil
+}
+
+// recordHarvestEvent adds a harvested event to the plant's history
+func (s *harvestService) recordHarvestEvent(ctx context.Context, gardenPlant *gardenEntity.GardenPlant, harvest *entity.Harvest) error {
+	event := gardenEntity.NewGardenPlantEvent(gardenPlant, gardenEntity.EventHarvested, harvest.HarvestedOn)
+	event.Notes = harvest.Notes
+	event.Details["harvest_id"] = harvest.HarvestID
+	event.Details["quantity"] = harvest.Quantity
+	event.Details["unit"] = string(harvest.Unit)
+
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		return fmt.Errorf("failed to record harvested event: %w", err)
+	}
+	return nil
+}
+
+// validateHarvest validates a harvest and rejects future dates
+func validateHarvest(harvest *entity.Harvest) error {
+	if err := harvest.Validate(); err != nil {
+		return domainerrors.NewValidationError("harvest", err.Error())
+	}
+
+	if harvest.HarvestedOn.After(time.Now()) {
+		return domainerrors.NewValidationError("harvested_on", "harvested_on cannot be in the future")
+	}
+
+	return nil
+}
+
+// validateDateRange ensures an optional date range is not reversed
+func validateDateRange(from, to *time.Time) error {
+	if from != nil && to != nil && from.After(*to) {
+		return domainerrors.NewValidationError("from", "from date must be on or before to date")
+	}
+	return nil
+}
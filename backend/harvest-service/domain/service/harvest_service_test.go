@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/harvest-service/domain/entity"
+	"twigger-backend/backend/harvest-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// MockHarvestRepository is a mock implementation of repository.HarvestRepository
+type MockHarvestRepository struct {
+	mock.Mock
+}
+
+func (m *MockHarvestRepository) Create(ctx context.Context, harvest *entity.Harvest) error {
+	args := m.Called(ctx, harvest)
+	return args.Error(0)
+}
+
+func (m *MockHarvestRepository) FindByID(ctx context.Context, harvestID string) (*entity.Harvest, error) {
+	args := m.Called(ctx, harvestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Harvest), args.Error(1)
+}
+
+func (m *MockHarvestRepository) Update(ctx context.Context, harvest *entity.Harvest) error {
+	args := m.Called(ctx, harvest)
+	return args.Error(0)
+}
+
+func (m *MockHarvestRepository) Delete(ctx context.Context, harvestID string) error {
+	args := m.Called(ctx, harvestID)
+	return args.Error(0)
+}
+
+func (m *MockHarvestRepository) FindByGardenID(ctx context.Context, gardenID string, filter *repository.HarvestFilter) ([]*entity.Harvest, error) {
+	args := m.Called(ctx, gardenID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Harvest), args.Error(1)
+}
+
+func (m *MockHarvestRepository) CountByGardenID(ctx context.Context, gardenID string, filter *repository.HarvestFilter) (int, error) {
+	args := m.Called(ctx, gardenID, filter)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockHarvestRepository) AggregateYield(ctx context.Context, gardenID string, query *repository.YieldQuery) ([]*entity.YieldSummary, error) {
+	args := m.Called(ctx, gardenID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.YieldSummary), args.Error(1)
+}
+
+// stubEventRepository collects appended events; other methods are not used by harvests
+type stubEventRepository struct {
+	gardenRepository.GardenPlantEventRepository
+	events []*gardenEntity.GardenPlantEvent
+	err    error
+}
+
+func (s *stubEventRepository) Create(ctx context.Context, event *gardenEntity.GardenPlantEvent) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func setupHarvestService() (*harvestService, *MockHarvestRepository, *stubEventRepository) {
+	zoneA := "zone-a"
+	removed := time.Now().AddDate(0, -1, 0)
+	mockRepo := new(MockHarvestRepository)
+	eventRepo := &stubEventRepository{}
+	service := &harvestService{
+		harvestRepo: mockRepo,
+		gardenPlantRepo: &mocks.StubGardenPlantRepository{Plants: []*gardenEntity.GardenPlant{
+			{GardenPlantID: "gp-tomato", GardenID: "garden-123", PlantID: "plant-tomato", ZoneID: &zoneA},
+			{GardenPlantID: "gp-removed", GardenID: "garden-123", PlantID: "plant-bean", RemovedDate: &removed},
+		}},
+		eventRepo: eventRepo,
+		txManager: &mocks.StubTransactionManager{},
+	}
+	return service, mockRepo, eventRepo
+}
+
+func TestHarvestService_RecordHarvest_CopiesPlantAndLogsEvent(t *testing.T) {
+	service, mockRepo, eventRepo := setupHarvestService()
+	ctx := context.Background()
+
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Harvest")).Return(nil)
+
+	harvest := &entity.Harvest{
+		Quantity:   2.5,
+		Unit:       entity.UnitKilograms,
+		RecordedBy: "user-123",
+	}
+
+	result, err := service.RecordHarvest(ctx, "gp-tomato", harvest)
+
+	require.NoError(t, err)
+	assert.Equal(t, "garden-123", result.GardenID)
+	assert.Equal(t, "plant-tomato", result.PlantID)
+	require.NotNil(t, result.ZoneID)
+	assert.Equal(t, "zone-a", *result.ZoneID)
+	assert.False(t, result.HarvestedOn.IsZero())
+	assert.Equal(t, 0, result.HarvestedOn.Hour())
+
+	require.Len(t, eventRepo.events, 1)
+	assert.Equal(t, gardenEntity.EventHarvested, eventRepo.events[0].EventType)
+	assert.Equal(t, 2.5, eventRepo.events[0].Details["quantity"])
+	assert.Equal(t, "kg", eventRepo.events[0].Details["unit"])
+	mockRepo.AssertExpectations(t)
+}
+
+func TestHarvestService_RecordHarvest_EventFailureRollsBack(t *testing.T) {
+	service, mockRepo, eventRepo := setupHarvestService()
+	ctx := context.Background()
+	eventRepo.err = errors.New("database unavailable")
+
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Harvest")).Return(nil)
+
+	harvest := &entity.Harvest{Quantity: 12, Unit: entity.UnitCount, RecordedBy: "user-123"}
+
+	_, err := service.RecordHarvest(ctx, "gp-tomato", harvest)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "database unavailable")
+	assert.True(t, service.txManager.(*mocks.StubTransactionManager).RolledBack, "the harvest must not be saved without its event")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestHarvestService_RecordHarvest_Rejected(t *testing.T) {
+	tests := []struct {
+		name          string
+		gardenPlantID string
+		harvest       *entity.Harvest
+		wantErr       string
+	}{
+		{
+			name:          "removed plant",
+			gardenPlantID: "gp-removed",
+			harvest:       &entity.Harvest{Quantity: 1, Unit: entity.UnitKilograms, RecordedBy: "user-123"},
+			wantErr:       "removed plant",
+		},
+		{
+			name:          "missing plant",
+			gardenPlantID: "gp-missing",
+			harvest:       &entity.Harvest{Quantity: 1, Unit: entity.UnitKilograms, RecordedBy: "user-123"},
+			wantErr:       "not found",
+		},
+		{
+			name:          "zero quantity",
+			gardenPlantID: "gp-tomato",
+			harvest:       &entity.Harvest{Quantity: 0, Unit: entity.UnitKilograms, RecordedBy: "user-123"},
+			wantErr:       "quantity must be greater than 0",
+		},
+		{
+			name:          "unknown unit",
+			gardenPlantID: "gp-tomato",
+			harvest:       &entity.Harvest{Quantity: 1, Unit: "bushel", RecordedBy: "user-123"},
+			wantErr:       "invalid unit",
+		},
+		{
+			name:          "future date",
+			gardenPlantID: "gp-tomato",
+			harvest: &entity.Harvest{
+				Quantity:    1,
+				Unit:        entity.UnitKilograms,
+				RecordedBy:  "user-123",
+				HarvestedOn: time.Now().AddDate(0, 0, 2),
+			},
+			wantErr: "cannot be in the future",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, eventRepo := setupHarvestService()
+
+			_, err := service.RecordHarvest(context.Background(), tt.gardenPlantID, tt.harvest)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			assert.Empty(t, eventRepo.events)
+		})
+	}
+}
+
+func TestHarvestService_UpdateHarvest_KeepsImmutableFields(t *testing.T) {
+	service, mockRepo, _ := setupHarvestService()
+	ctx := context.Background()
+
+	existing := &entity.Harvest{
+		HarvestID:     "harvest-123",
+		GardenPlantID: "gp-tomato",
+		GardenID:      "garden-123",
+		PlantID:       "plant-tomato",
+		RecordedBy:    "user-123",
+		HarvestedOn:   time.Now().AddDate(0, 0, -3),
+		Quantity:      1,
+		Unit:          entity.UnitKilograms,
+	}
+
+	mockRepo.On("FindByID", ctx, "harvest-123").Return(existing, nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Harvest")).Return(nil)
+
+	update := &entity.Harvest{
+		HarvestID:     "harvest-123",
+		GardenPlantID: "gp-other",
+		GardenID:      "garden-456",
+		RecordedBy:    "user-456",
+		HarvestedOn:   existing.HarvestedOn,
+		Quantity:      800,
+		Unit:          entity.UnitGrams,
+	}
+
+	result, err := service.UpdateHarvest(ctx, update)
+
+	require.NoError(t, err)
+	assert.Equal(t, "gp-tomato", result.GardenPlantID)
+	assert.Equal(t, "garden-123", result.GardenID)
+	assert.Equal(t, "plant-tomato", result.PlantID)
+	assert.Equal(t, "user-123", result.RecordedBy)
+	assert.Equal(t, 800.0, result.Quantity)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestHarvestService_ListHarvests_AppliesLimits(t *testing.T) {
+	service, mockRepo, _ := setupHarvestService()
+	ctx := context.Background()
+
+	mockRepo.On("FindByGardenID", ctx, "garden-123", mock.MatchedBy(func(f *repository.HarvestFilter) bool {
+		return f.Limit == 100 && f.Offset == 0
+	})).Return([]*entity.Harvest{}, nil)
+	mockRepo.On("CountByGardenID", ctx, "garden-123", mock.Anything).Return(0, nil)
+
+	_, total, err := service.ListHarvests(ctx, "garden-123", &repository.HarvestFilter{Limit: 500, Offset: -1})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, total)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestHarvestService_GetYield_ComputesPerSquareMetre(t *testing.T) {
+	service, mockRepo, _ := setupHarvestService()
+	ctx := context.Background()
+
+	query := &repository.YieldQuery{GroupBy: []entity.YieldDimension{entity.YieldByZone, entity.YieldBySeason}}
+	mockRepo.On("AggregateYield", ctx, "garden-123", query).Return([]*entity.YieldSummary{
+		{ZoneID: mocks.StrPtr("zone-a"), AreaM2: mocks.FloatPtr(4), HarvestCount: 3, TotalWeightKg: 10},
+		{ZoneID: mocks.StrPtr("zone-b"), AreaM2: mocks.FloatPtr(0), HarvestCount: 1, TotalWeightKg: 2},
+		{HarvestCount: 1, TotalWeightKg: 1}, // Plants outside any zone
+	}, nil)
+
+	summaries, err := service.GetYield(ctx, "garden-123", query)
+
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+	require.NotNil(t, summaries[0].WeightKgPerM2)
+	assert.Equal(t, 2.5, *summaries[0].WeightKgPerM2)
+	assert.Nil(t, summaries[1].WeightKgPerM2)
+	assert.Nil(t, summaries[2].WeightKgPerM2)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestHarvestService_GetYield_InvalidGrouping(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupBy []entity.YieldDimension
+	}{
+		{name: "empty", groupBy: nil},
+		{name: "unknown dimension", groupBy: []entity.YieldDimension{"variety"}},
+		{name: "repeated dimension", groupBy: []entity.YieldDimension{entity.YieldByZone, entity.YieldByZone}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _ := setupHarvestService()
+
+			_, err := service.GetYield(context.Background(), "garden-123", &repository.YieldQuery{GroupBy: tt.groupBy})
+
+			require.Error(t, err)
+			assert.IsType(t, &domainerrors.ValidationError{}, err)
+			mockRepo.AssertNotCalled(t, "AggregateYield", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHarvestUnit_ToKilograms(t *testing.T) {
+	kg, ok := entity.UnitPounds.ToKilograms(2)
+	require.True(t, ok)
+	assert.InDelta(t, 0.907, kg, 0.001)
+
+	_, ok = entity.UnitCount.ToKilograms(5)
+	assert.False(t, ok)
+}
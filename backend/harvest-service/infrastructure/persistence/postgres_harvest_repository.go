@@ -0,0 +1,434 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"twigger-backend/backend/garden-service/infrastructure/database"
+	"twigger-backend/backend/harvest-service/domain/entity"
+	"twigger-backend/backend/harvest-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// PostgresHarvestRepository implements repository.HarvestRepository using PostgreSQL
+type PostgresHarvestRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresHarvestRepository creates a new PostgreSQL harvest repository
+func NewPostgresHarvestRepository(db *sql.DB) repository.HarvestRepository {
+	return &PostgresHarvestRepository{db: db}
+}
+
+const harvestColumns = `
+	h.harvest_id, h.garden_plant_id, h.garden_id, h.plant_id, h.zone_id,
+	h.harvested_on, h.quantity, h.unit, h.weight_kg, h.quality, h.notes,
+	h.recorded_by, h.created_at, h.updated_at
+`
+
+// Meteorological season of a harvest, shifted by two seasons for gardens in the
+// southern hemisphere. Months map to 0=Dec-Feb, 1=Mar-May, 2=Jun-Aug, 3=Sep-Nov.
+const harvestSeasonExpr = `(ARRAY['winter', 'spring', 'summer', 'autumn'])[
+	((EXTRACT(MONTH FROM h.harvested_on)::int % 12) / 3
+		+ CASE WHEN COALESCE(ST_Y(g.location::geometry) < 0, false) THEN 2 ELSE 0 END) % 4 + 1]`
+
+// Season year: December belongs to the season that continues into the next year
+const harvestSeasonYearExpr = `EXTRACT(YEAR FROM h.harvested_on + INTERVAL '1 month')::int`
+
+// Create creates a new harvest
+func (r *PostgresHarvestRepository) Create(ctx context.Context, harvest *entity.Harvest) error {
+	harvest.NormalizeWeight()
+	if err := harvest.Validate(); err != nil {
+		return domainerrors.NewValidationError("harvest", err.Error())
+	}
+
+	if harvest.HarvestID == "" {
+		harvest.HarvestID = uuid.New().String()
+	}
+
+	now := time.Now()
+	harvest.CreatedAt = now
+	harvest.UpdatedAt = now
+
+	query := `
+		INSERT INTO harvests (
+			harvest_id, garden_plant_id, garden_id, plant_id, zone_id,
+			harvested_on, quantity, unit, weight_kg, quality, notes,
+			recorded_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+		)
+	`
+
+	_, err := database.Executor(ctx, r.db).ExecContext(ctx, query,
+		harvest.HarvestID,
+		harvest.GardenPlantID,
+		harvest.GardenID,
+		harvest.PlantID,
+		harvest.ZoneID,
+		harvest.HarvestedOn,
+		harvest.Quantity,
+		harvest.Unit,
+		harvest.WeightKg,
+		harvest.Quality,
+		harvest.Notes,
+		harvest.RecordedBy,
+		harvest.CreatedAt,
+		harvest.UpdatedAt,
+	)
+	if err != nil {
+		return domainerrors.NewDatabaseError("harvest_create", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a harvest by ID
+func (r *PostgresHarvestRepository) FindByID(ctx context.Context, harvestID string) (*entity.Harvest, error) {
+	query := `SELECT ` + harvestColumns + ` FROM harvests h WHERE h.harvest_id = $1`
+
+	harvest, err := scanHarvest(r.db.QueryRowContext(ctx, query, harvestID))
+	if err == sql.ErrNoRows {
+		return nil, domainerrors.NewNotFoundError("harvest", harvestID)
+	}
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("harvest_find_by_id", err)
+	}
+
+	return harvest, nil
+}
+
+// Update updates a harvest's yield details
+func (r *PostgresHarvestRepository) Update(ctx context.Context, harvest *entity.Harvest) error {
+	harvest.NormalizeWeight()
+	if err := harvest.Validate(); err != nil {
+		return domainerrors.NewValidationError("harvest", err.Error())
+	}
+
+	harvest.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE harvests SET
+			harvested_on = $2,
+			quantity = $3,
+			unit = $4,
+			weight_kg = $5,
+			quality = $6,
+			notes = $7,
+			updated_at = $8
+		WHERE harvest_id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		harvest.HarvestID,
+		harvest.HarvestedOn,
+		harvest.Quantity,
+		harvest.Unit,
+		harvest.WeightKg,
+		harvest.Quality,
+		harvest.Notes,
+		harvest.UpdatedAt,
+	)
+	if err != nil {
+		return domainerrors.NewDatabaseError("harvest_update", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainerrors.NewDatabaseError("harvest_update_rows_affected", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainerrors.NewNotFoundError("harvest", harvest.HarvestID)
+	}
+
+	return nil
+}
+
+// Delete deletes a harvest
+func (r *PostgresHarvestRepository) Delete(ctx context.Context, harvestID string) error {
+	query := `DELETE FROM harvests WHERE harvest_id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, harvestID)
+	if err != nil {
+		return domainerrors.NewDatabaseError("harvest_delete", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainerrors.NewDatabaseError("harvest_delete_rows_affected", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainerrors.NewNotFoundError("harvest", harvestID)
+	}
+
+	return nil
+}
+
+// FindByGardenID finds a garden's harvests, newest first
+func (r *PostgresHarvestRepository) FindByGardenID(ctx context.Context, gardenID string, filter *repository.HarvestFilter) ([]*entity.Harvest, error) {
+	if filter == nil {
+		filter = &repository.HarvestFilter{}
+	}
+
+	whereSQL, args := buildHarvestWhere(gardenID, filter.From, filter.To)
+	whereSQL, args = appendHarvestLinkFilters(whereSQL, args, filter)
+
+	query := `SELECT ` + harvestColumns + ` FROM harvests h WHERE ` + whereSQL +
+		` ORDER BY h.harvested_on DESC, h.created_at DESC`
+
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit, filter.Offset)
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("harvest_find_by_garden", err)
+	}
+	defer rows.Close()
+
+	harvests := []*entity.Harvest{}
+	for rows.Next() {
+		harvest, err := scanHarvest(rows)
+		if err != nil {
+			return nil, domainerrors.NewDatabaseError("harvest_scan", err)
+		}
+		harvests = append(harvests, harvest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domainerrors.NewDatabaseError("harvest_rows_iteration", err)
+	}
+
+	return harvests, nil
+}
+
+// CountByGardenID counts a garden's harvests matching the filter, ignoring pagination
+func (r *PostgresHarvestRepository) CountByGardenID(ctx context.Context, gardenID string, filter *repository.HarvestFilter) (int, error) {
+	if filter == nil {
+		filter = &repository.HarvestFilter{}
+	}
+
+	whereSQL, args := buildHarvestWhere(gardenID, filter.From, filter.To)
+	whereSQL, args = appendHarvestLinkFilters(whereSQL, args, filter)
+
+	var count int
+	query := `SELECT COUNT(*) FROM harvests h WHERE ` + whereSQL
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, domainerrors.NewDatabaseError("harvest_count", err)
+	}
+
+	return count, nil
+}
+
+// AggregateYield totals a garden's harvests grouped by the query's dimensions.
+// Rows are ordered by dimension, with seasons oldest first.
+func (r *PostgresHarvestRepository) AggregateYield(ctx context.Context, gardenID string, query *repository.YieldQuery) ([]*entity.YieldSummary, error) {
+	if query == nil {
+		query = &repository.YieldQuery{}
+	}
+
+	var selectCols, groupCols, orderCols []string
+	joins := []string{"JOIN gardens g ON g.garden_id = h.garden_id"}
+
+	for _, dimension := range query.GroupBy {
+		switch dimension {
+		case entity.YieldBySpecies:
+			joins = append(joins, "JOIN plants p ON p.plant_id = h.plant_id")
+			selectCols = append(selectCols, "h.plant_id", "p.full_botanical_name")
+			groupCols = append(groupCols, "h.plant_id", "p.full_botanical_name")
+			orderCols = append(orderCols, "p.full_botanical_name")
+		case entity.YieldByZone:
+			joins = append(joins, "LEFT JOIN garden_zones z ON z.zone_id = h.zone_id")
+			selectCols = append(selectCols, "h.zone_id", "z.zone_name", "z.area_m2")
+			groupCols = append(groupCols, "h.zone_id", "z.zone_name", "z.area_m2")
+			orderCols = append(orderCols, "z.zone_name")
+		case entity.YieldBySeason:
+			selectCols = append(selectCols, harvestSeasonYearExpr+" AS season_year", harvestSeasonExpr+" AS season")
+			groupCols = append(groupCols, harvestSeasonYearExpr, harvestSeasonExpr)
+			orderCols = append(orderCols, "season_year", "MIN(h.harvested_on)")
+		default:
+			return nil, domainerrors.NewValidationError("group_by", fmt.Sprintf("invalid dimension: %s", dimension))
+		}
+	}
+
+	whereSQL, args := buildHarvestWhere(gardenID, query.From, query.To)
+
+	selectCols = append(selectCols,
+		"COUNT(*)",
+		"COALESCE(SUM(h.weight_kg), 0)",
+		"COALESCE(SUM(h.quantity) FILTER (WHERE h.unit = 'count'), 0)",
+	)
+
+	sqlQuery := `SELECT ` + strings.Join(selectCols, ", ") +
+		` FROM harvests h ` + strings.Join(joins, " ") +
+		` WHERE ` + whereSQL
+	if len(groupCols) > 0 {
+		sqlQuery += ` GROUP BY ` + strings.Join(groupCols, ", ") +
+			` ORDER BY ` + strings.Join(orderCols, ", ")
+	}
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("harvest_aggregate_yield", err)
+	}
+	defer rows.Close()
+
+	summaries := []*entity.YieldSummary{}
+	for rows.Next() {
+		summary, err := scanYieldSummary(rows, query.GroupBy)
+		if err != nil {
+			return nil, domainerrors.NewDatabaseError("harvest_yield_scan", err)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domainerrors.NewDatabaseError("harvest_yield_rows_iteration", err)
+	}
+
+	return summaries, nil
+}
+
+// buildHarvestWhere builds the garden and date range conditions shared by all queries
+func buildHarvestWhere(gardenID string, from, to *time.Time) (string, []interface{}) {
+	whereClauses := []string{"h.garden_id = $1"}
+	args := []interface{}{gardenID}
+
+	if from != nil {
+		args = append(args, *from)
+		whereClauses = append(whereClauses, fmt.Sprintf("h.harvested_on >= $%d", len(args)))
+	}
+	if to != nil {
+		args = append(args, *to)
+		whereClauses = append(whereClauses, fmt.Sprintf("h.harvested_on <= $%d", len(args)))
+	}
+
+	return strings.Join(whereClauses, " AND "), args
+}
+
+// appendHarvestLinkFilters adds the zone and plant conditions of a list filter
+func appendHarvestLinkFilters(whereSQL string, args []interface{}, filter *repository.HarvestFilter) (string, []interface{}) {
+	whereClauses := []string{whereSQL}
+
+	if filter.ZoneID != nil {
+		args = append(args, *filter.ZoneID)
+		whereClauses = append(whereClauses, fmt.Sprintf("h.zone_id = $%d", len(args)))
+	}
+	if filter.PlantID != nil {
+		args = append(args, *filter.PlantID)
+		whereClauses = append(whereClauses, fmt.Sprintf("h.plant_id = $%d", len(args)))
+	}
+	if filter.GardenPlantID != nil {
+		args = append(args, *filter.GardenPlantID)
+		whereClauses = append(whereClauses, fmt.Sprintf("h.garden_plant_id = $%d", len(args)))
+	}
+
+	return strings.Join(whereClauses, " AND "), args
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanHarvest scans a single harvest row, mapping nullable columns
+func scanHarvest(row rowScanner) (*entity.Harvest, error) {
+	var harvest entity.Harvest
+	var zoneID, quality, notes sql.NullString
+	var weightKg sql.NullFloat64
+
+	err := row.Scan(
+		&harvest.HarvestID,
+		&harvest.GardenPlantID,
+		&harvest.GardenID,
+		&harvest.PlantID,
+		&zoneID,
+		&harvest.HarvestedOn,
+		&harvest.Quantity,
+		&harvest.Unit,
+		&weightKg,
+		&quality,
+		&notes,
+		&harvest.RecordedBy,
+		&harvest.CreatedAt,
+		&harvest.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map nullable fields
+	if zoneID.Valid {
+		harvest.ZoneID = &zoneID.String
+	}
+	if weightKg.Valid {
+		harvest.WeightKg = &weightKg.Float64
+	}
+	if quality.Valid {
+		q := entity.HarvestQuality(quality.String)
+		harvest.Quality = &q
+	}
+	if notes.Valid {
+		harvest.Notes = &notes.String
+	}
+
+	return &harvest, nil
+}
+
+// scanYieldSummary scans an aggregate row whose leading columns follow the grouping order
+func scanYieldSummary(rows *sql.Rows, groupBy []entity.YieldDimension) (*entity.YieldSummary, error) {
+	var summary entity.YieldSummary
+	var plantID, plantName, zoneID, zoneName, season sql.NullString
+	var areaM2 sql.NullFloat64
+	var year sql.NullInt64
+
+	var dest []interface{}
+	for _, dimension := range groupBy {
+		switch dimension {
+		case entity.YieldBySpecies:
+			dest = append(dest, &plantID, &plantName)
+		case entity.YieldByZone:
+			dest = append(dest, &zoneID, &zoneName, &areaM2)
+		case entity.YieldBySeason:
+			dest = append(dest, &year, &season)
+		}
+	}
+	dest = append(dest, &summary.HarvestCount, &summary.TotalWeightKg, &summary.TotalItems)
+
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	if plantID.Valid {
+		summary.PlantID = &plantID.String
+	}
+	if plantName.Valid {
+		summary.PlantName = &plantName.String
+	}
+	if zoneID.Valid {
+		summary.ZoneID = &zoneID.String
+	}
+	if zoneName.Valid {
+		summary.ZoneName = &zoneName.String
+	}
+	if areaM2.Valid {
+		summary.AreaM2 = &areaM2.Float64
+	}
+	if year.Valid {
+		y := int(year.Int64)
+		summary.Year = &y
+	}
+	if season.Valid {
+		s := entity.Season(season.String)
+		summary.Season = &s
+	}
+
+	return &summary, nil
+}
@@ -0,0 +1,358 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// MaxObservedSymptoms bounds how many symptoms a single diagnosis request may list
+const MaxObservedSymptoms = 20
+
+// DefaultMaxResults is how many candidate problems a diagnosis returns by default
+const DefaultMaxResults = 5
+
+// Common observed symptoms. Requests may use these codes or describe symptoms in free text.
+const (
+	SymptomLeafYellowing  = "leaf_yellowing"
+	SymptomSpots          = "spots"
+	SymptomWilting        = "wilting"
+	SymptomHoles          = "holes"
+	SymptomStickyResidue  = "sticky_residue"
+	SymptomLeafCurl       = "leaf_curl"
+	SymptomPowderyCoating = "powdery_coating"
+	SymptomWebbing        = "webbing"
+	SymptomBrowning       = "browning"
+	SymptomStuntedGrowth  = "stunted_growth"
+	SymptomRot            = "rot"
+	SymptomLeafDrop       = "leaf_drop"
+	SymptomVisibleInsects = "visible_insects"
+)
+
+// symptomKeywords are the words that identify each symptom code in catalogue symptom descriptions
+var symptomKeywords = map[string][]string{
+	SymptomLeafYellowing:  {"yellow", "chlorosis", "chlorotic", "pale"},
+	SymptomSpots:          {"spot", "lesion", "blotch", "speck"},
+	SymptomWilting:        {"wilt", "droop", "limp", "collapse"},
+	SymptomHoles:          {"hole", "chewed", "ragged", "skeleton", "defoliat"},
+	SymptomStickyResidue:  {"sticky", "honeydew", "sooty"},
+	SymptomLeafCurl:       {"curl", "distort", "pucker", "twist"},
+	SymptomPowderyCoating: {"powder", "white coating", "mildew", "fuzzy", "mould", "mold"},
+	SymptomWebbing:        {"web", "silk"},
+	SymptomBrowning:       {"brown", "scorch", "necro", "blight", "dieback"},
+	SymptomStuntedGrowth:  {"stunt", "poor growth", "slow growth", "dwarf"},
+	SymptomRot:            {"rot", "mushy", "soft", "ooz", "canker"},
+	SymptomLeafDrop:       {"drop", "shed", "fall"},
+	SymptomVisibleInsects: {"insect", "aphid", "larva", "caterpillar", "beetle", "mite", "fly", "bug", "worm", "grub"},
+}
+
+// IsKnownSymptom reports whether symptom is one of the common symptom codes
+func IsKnownSymptom(symptom string) bool {
+	_, ok := symptomKeywords[symptom]
+	return ok
+}
+
+// symptomStopWords are free-text words too generic to identify a problem
+var symptomStopWords = map[string]bool{
+	"the": true, "and": true, "are": true, "has": true, "have": true, "with": true,
+	"on": true, "of": true, "in": true, "some": true, "very": true, "leaf": true,
+	"leaves": true, "plant": true, "plants": true, "stem": true, "stems": true,
+	"from": true, "into": true, "that": true, "this": true, "its": true,
+}
+
+// SymptomKeywords returns the words that identify an observed symptom: the keywords of a
+// symptom code, or the significant words of a free-text description
+func SymptomKeywords(symptom string) []string {
+	symptom = strings.ToLower(strings.TrimSpace(symptom))
+	if keywords, ok := symptomKeywords[symptom]; ok {
+		return keywords
+	}
+
+	var keywords []string
+	for _, word := range strings.FieldsFunc(symptom, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z') && r < 0x80
+	}) {
+		if len(word) >= 3 && !symptomStopWords[word] {
+			keywords = append(keywords, word)
+		}
+	}
+	return keywords
+}
+
+// Relation is how closely the plant a catalogue problem is recorded against relates
+// to the diagnosed plant
+type Relation string
+
+const (
+	RelationPlant   Relation = "plant"   // Recorded against the diagnosed plant itself
+	RelationSpecies Relation = "species" // Recorded against another cultivar of the same species
+	RelationGenus   Relation = "genus"   // Recorded against another species in the same genus
+)
+
+// relationWeights discount problems recorded against more distant relatives
+var relationWeights = map[Relation]float64{
+	RelationPlant:   1.0,
+	RelationSpecies: 0.9,
+	RelationGenus:   0.75,
+}
+
+// ConfidenceLevel buckets a candidate's confidence score
+type ConfidenceLevel string
+
+const (
+	ConfidenceHigh   ConfidenceLevel = "high"   // 70 and above
+	ConfidenceMedium ConfidenceLevel = "medium" // 40 to 69
+	ConfidenceLow    ConfidenceLevel = "low"    // Below 40
+)
+
+// ConfidenceLevelFor buckets a 0-100 confidence score
+func ConfidenceLevelFor(confidence int) ConfidenceLevel {
+	switch {
+	case confidence >= 70:
+		return ConfidenceHigh
+	case confidence >= 40:
+		return ConfidenceMedium
+	default:
+		return ConfidenceLow
+	}
+}
+
+// DiagnosisRequest describes what a gardener observed on a plant
+type DiagnosisRequest struct {
+	Symptoms      []string `json:"symptoms"`                 // Symptom codes or free-text descriptions
+	AffectedParts []string `json:"affected_parts,omitempty"` // Plant parts showing the symptoms
+	Month         *int     `json:"month,omitempty"`          // 1-12, defaults to the current month
+	MaxResults    int      `json:"max_results,omitempty"`
+}
+
+// Validate validates the diagnosis request and applies defaults
+func (r *DiagnosisRequest) Validate() error {
+	var symptoms []string
+	for _, symptom := range r.Symptoms {
+		if symptom = strings.TrimSpace(symptom); symptom != "" {
+			symptoms = append(symptoms, symptom)
+		}
+	}
+	if len(symptoms) == 0 {
+		return domainerrors.NewValidationError("symptoms", "at least one symptom is required")
+	}
+	if len(symptoms) > MaxObservedSymptoms {
+		return domainerrors.NewValidationError("symptoms", fmt.Sprintf("at most %d symptoms can be diagnosed at once", MaxObservedSymptoms))
+	}
+	r.Symptoms = symptoms
+
+	for _, part := range r.AffectedParts {
+		if !plantEntity.IsValidPlantPart(part) {
+			return domainerrors.NewValidationError("affected_parts", fmt.Sprintf("unknown plant part: %s", part))
+		}
+	}
+
+	if r.Month != nil && (*r.Month < 1 || *r.Month > 12) {
+		return domainerrors.NewValidationError("month", "must be between 1 and 12")
+	}
+
+	if r.MaxResults <= 0 || r.MaxResults > 20 {
+		r.MaxResults = DefaultMaxResults
+	}
+
+	return nil
+}
+
+// DiagnosisSite is the garden context a diagnosis is ranked in
+type DiagnosisSite struct {
+	Month              int     `json:"month"` // Month the symptoms were observed, 1-12
+	HardinessZone      *string `json:"hardiness_zone,omitempty"`
+	SouthernHemisphere bool    `json:"southern_hemisphere"`
+}
+
+// CatalogueMonth returns the observation month on the northern-hemisphere calendar
+// the problem catalogue's active months are recorded in
+func (s *DiagnosisSite) CatalogueMonth() int {
+	if !s.SouthernHemisphere {
+		return s.Month
+	}
+	return (s.Month+5)%12 + 1
+}
+
+// FrostFree reports whether the site's hardiness zone is warm enough (10 or above)
+// for pests and diseases to stay active through winter
+func (s *DiagnosisSite) FrostFree() bool {
+	if s.HardinessZone == nil {
+		return false
+	}
+	zone := strings.TrimRight(strings.ToLower(strings.TrimSpace(*s.HardinessZone)), "ab")
+	n, err := strconv.Atoi(zone)
+	return err == nil && n >= 10
+}
+
+// DiagnosisCandidate is a catalogue problem that may explain the observed symptoms
+type DiagnosisCandidate struct {
+	Problem         *plantEntity.PlantProblem `json:"problem"`
+	Confidence      int                       `json:"confidence"` // 0-100
+	ConfidenceLevel ConfidenceLevel           `json:"confidence_level"`
+	Relation        Relation                  `json:"relation"`
+	MatchedSymptoms []string                  `json:"matched_symptoms"` // Observed symptoms the problem explains
+	InSeason        bool                      `json:"in_season"`
+	Reasons         []string                  `json:"reasons"`
+
+	// RecommendedTreatments lists organic treatments first, then general measures,
+	// then chemical treatments as a last resort
+	RecommendedTreatments []string `json:"recommended_treatments"`
+}
+
+// Diagnosis ranks the catalogue problems that may explain a garden plant's symptoms
+type Diagnosis struct {
+	GardenPlantID string                `json:"garden_plant_id"`
+	PlantID       string                `json:"plant_id"`
+	Symptoms      []string              `json:"symptoms"`
+	Site          DiagnosisSite         `json:"site"`
+	Candidates    []*DiagnosisCandidate `json:"candidates"`
+}
+
+// Score factors for season and plant part mismatches
+const (
+	outOfSeasonFactor          = 0.6
+	outOfSeasonFrostFreeFactor = 0.85
+	partMismatchFactor         = 0.8
+)
+
+// ScoreCandidate scores how well a catalogue problem explains the observed symptoms.
+// It returns nil when the problem explains none of them.
+//
+// Symptom fit weighs the share of observed symptoms the problem explains (70%) against the
+// share of the problem's own symptoms that were observed (30%), then is discounted for
+// more distant relatives, problems out of season at the site and unaffected plant parts.
+func ScoreCandidate(problem *plantEntity.PlantProblem, relation Relation, request *DiagnosisRequest, site *DiagnosisSite) *DiagnosisCandidate {
+	descriptions := make([]string, 0, len(problem.Symptoms)+1)
+	for _, symptom := range problem.Symptoms {
+		descriptions = append(descriptions, strings.ToLower(symptom))
+	}
+	if len(descriptions) == 0 {
+		descriptions = append(descriptions, strings.ToLower(problem.ProblemName))
+	}
+
+	explained := make([]bool, len(descriptions))
+	var matched []string
+	for _, observed := range request.Symptoms {
+		keywords := SymptomKeywords(observed)
+		hit := false
+		for i, description := range descriptions {
+			if containsAny(description, keywords) {
+				explained[i] = true
+				hit = true
+			}
+		}
+		if hit {
+			matched = append(matched, observed)
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	explainedCount := 0
+	for _, e := range explained {
+		if e {
+			explainedCount++
+		}
+	}
+
+	fit := 0.7*float64(len(matched))/float64(len(request.Symptoms)) +
+		0.3*float64(explainedCount)/float64(len(descriptions))
+
+	candidate := &DiagnosisCandidate{
+		Problem:         problem,
+		Relation:        relation,
+		MatchedSymptoms: matched,
+		InSeason:        problem.IsActiveIn(site.CatalogueMonth()),
+		Reasons: []string{
+			fmt.Sprintf("explains %d of %d observed symptoms", len(matched), len(request.Symptoms)),
+		},
+	}
+
+	score := fit * relationWeights[relation]
+	switch relation {
+	case RelationSpecies:
+		candidate.Reasons = append(candidate.Reasons, "recorded on another cultivar of this species")
+	case RelationGenus:
+		candidate.Reasons = append(candidate.Reasons, "recorded on a related species in the same genus")
+	}
+
+	switch {
+	case len(problem.ActiveMonths) == 0:
+	case candidate.InSeason:
+		candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("typically active in month %d", site.Month))
+	case site.FrostFree():
+		score *= outOfSeasonFrostFreeFactor
+		candidate.Reasons = append(candidate.Reasons, "usually out of season, but can persist year-round in frost-free zones")
+	default:
+		score *= outOfSeasonFactor
+		candidate.Reasons = append(candidate.Reasons, "usually out of season")
+	}
+
+	if len(request.AffectedParts) > 0 && len(problem.AffectedParts) > 0 && !overlaps(request.AffectedParts, problem.AffectedParts) {
+		score *= partMismatchFactor
+		candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("usually affects %s", strings.Join(problem.AffectedParts, ", ")))
+	}
+
+	candidate.Confidence = int(math.Round(score * 100))
+	candidate.ConfidenceLevel = ConfidenceLevelFor(candidate.Confidence)
+	candidate.RecommendedTreatments = recommendedTreatments(problem)
+
+	return candidate
+}
+
+// RankCandidates orders candidates by confidence, then by severity, and keeps the top n
+func RankCandidates(candidates []*DiagnosisCandidate, n int) []*DiagnosisCandidate {
+	severityRank := map[string]int{
+		plantEntity.ProblemSeverityHigh:   0,
+		plantEntity.ProblemSeverityMedium: 1,
+		plantEntity.ProblemSeverityLow:    2,
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Confidence != candidates[j].Confidence {
+			return candidates[i].Confidence > candidates[j].Confidence
+		}
+		return severityRank[candidates[i].Problem.Severity] < severityRank[candidates[j].Problem.Severity]
+	})
+
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	return candidates
+}
+
+// recommendedTreatments lists the least intrusive treatments first
+func recommendedTreatments(problem *plantEntity.PlantProblem) []string {
+	treatments := make([]string, 0, len(problem.OrganicTreatments)+len(problem.Treatments)+len(problem.ChemicalTreatments))
+	treatments = append(treatments, problem.OrganicTreatments...)
+	treatments = append(treatments, problem.Treatments...)
+	treatments = append(treatments, problem.ChemicalTreatments...)
+	return treatments
+}
+
+func containsAny(text string, keywords []string) bool {
+	for _, keyword := range keywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func overlaps(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y || y == plantEntity.PlantPartWholePlant {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// OutbreakSearchRadiusM bounds the distance measured between affected and at-risk plants.
+// Susceptible plants further away are still flagged, at the lowest risk.
+const OutbreakSearchRadiusM = 100.0
+
+// riskSpreadM is the distance from an affected plant at which risk halves
+const riskSpreadM = 5.0
+
+// ProblemReport links a catalogue problem to a diseased garden plant
+type ProblemReport struct {
+	ReportID      string `json:"report_id"`
+	GardenPlantID string `json:"garden_plant_id"`
+	GardenID      string `json:"garden_id"`
+	PlantID       string `json:"plant_id"` // From the garden plant (read-only)
+	ProblemID     string `json:"problem_id"`
+
+	// From the problem catalogue (read-only)
+	ProblemName string `json:"problem_name"`
+	ProblemType string `json:"problem_type"`
+	Severity    string `json:"severity"`
+
+	Notes      *string `json:"notes,omitempty"`
+	ReportedBy string  `json:"reported_by"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate validates the problem report
+func (r *ProblemReport) Validate() error {
+	if r.GardenPlantID == "" {
+		return fmt.Errorf("garden_plant_id is required")
+	}
+	if r.GardenID == "" {
+		return fmt.Errorf("garden_id is required")
+	}
+	if r.ProblemID == "" {
+		return fmt.Errorf("problem_id is required")
+	}
+	if r.ReportedBy == "" {
+		return fmt.Errorf("reported_by is required")
+	}
+	if r.Notes != nil && len(*r.Notes) > 2000 {
+		return fmt.Errorf("notes must be at most 2000 characters")
+	}
+	return nil
+}
+
+// OutbreakKey identifies the problem a report belongs to across plants. Catalogue problems
+// are recorded per plant, so the same pest or disease is matched by type and name.
+func (r *ProblemReport) OutbreakKey() string {
+	return r.ProblemType + "|" + strings.ToLower(strings.TrimSpace(r.ProblemName))
+}
+
+// RiskLevel buckets how likely an at-risk plant is to be affected next
+type RiskLevel string
+
+const (
+	RiskHigh   RiskLevel = "high"   // 60 and above, within about 3m
+	RiskMedium RiskLevel = "medium" // 30 to 59, within about 12m
+	RiskLow    RiskLevel = "low"    // Below 30
+	RiskNone   RiskLevel = "none"   // Outbreak alert level when nothing is at risk
+)
+
+// RiskFromDistance scores the risk to a susceptible plant from its distance to the nearest
+// affected plant. Risk halves every riskSpreadM; an unknown distance is treated as the
+// edge of the search radius.
+func RiskFromDistance(distanceM *float64) (int, RiskLevel) {
+	d := OutbreakSearchRadiusM
+	if distanceM != nil {
+		d = math.Max(*distanceM, 0)
+	}
+
+	score := int(math.Round(100 * riskSpreadM / (riskSpreadM + d)))
+	switch {
+	case score >= 60:
+		return score, RiskHigh
+	case score >= 30:
+		return score, RiskMedium
+	default:
+		return score, RiskLow
+	}
+}
+
+// AtRiskPlant is an active garden plant susceptible to an outbreak's problem
+type AtRiskPlant struct {
+	GardenPlantID string  `json:"garden_plant_id"`
+	PlantID       string  `json:"plant_id"`
+	ZoneID        *string `json:"zone_id,omitempty"`
+
+	// Distance to the nearest affected plant; nil beyond OutbreakSearchRadiusM
+	DistanceM         *float64 `json:"distance_m,omitempty"`
+	NearestAffectedID *string  `json:"nearest_affected_id,omitempty"`
+
+	RiskScore int       `json:"risk_score"` // 0-100
+	RiskLevel RiskLevel `json:"risk_level"`
+}
+
+// Outbreak is a problem reported on one or more diseased plants in a garden, with the
+// susceptible plants around them
+type Outbreak struct {
+	GardenID    string `json:"garden_id"`
+	ProblemID   string `json:"problem_id"` // Catalogue problem of the first report
+	ProblemName string `json:"problem_name"`
+	ProblemType string `json:"problem_type"`
+	Severity    string `json:"severity"`
+
+	// AlertLevel is the highest risk level of any at-risk plant
+	AlertLevel RiskLevel        `json:"alert_level"`
+	Reports    []*ProblemReport `json:"reports"`
+	AtRisk     []*AtRiskPlant   `json:"at_risk"`
+}
+
+// UpdateAlertLevel sets the alert level from the at-risk plants
+func (o *Outbreak) UpdateAlertLevel() {
+	rank := map[RiskLevel]int{RiskNone: 0, RiskLow: 1, RiskMedium: 2, RiskHigh: 3}
+
+	o.AlertLevel = RiskNone
+	for _, plant := range o.AtRisk {
+		if rank[plant.RiskLevel] > rank[o.AlertLevel] {
+			o.AlertLevel = plant.RiskLevel
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"twigger-backend/backend/health-service/domain/entity"
+)
+
+// ProblemReportRepository defines the interface for problem report persistence.
+// Reports are returned with their garden plant's plant and the catalogue problem's
+// name, type and severity.
+type ProblemReportRepository interface {
+	// Save creates a report, or updates the notes of an existing report of the same
+	// problem on the same garden plant
+	Save(ctx context.Context, report *entity.ProblemReport) error
+	FindByID(ctx context.Context, reportID string) (*entity.ProblemReport, error)
+	Delete(ctx context.Context, reportID string) error
+
+	// FindByGardenPlant retrieves a garden plant's reports, newest first
+	FindByGardenPlant(ctx context.Context, gardenPlantID string) ([]*entity.ProblemReport, error)
+
+	// FindOpenInGarden retrieves reports on a garden's active plants that are still
+	// marked diseased, oldest first
+	FindOpenInGarden(ctx context.Context, gardenID string) ([]*entity.ProblemReport, error)
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/health-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/constants"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// Bounds on the catalogue lookups made for a single diagnosis
+const (
+	maxRelatedPlants    = 50
+	maxProblemsPerPlant = 100
+)
+
+// DiagnosisService defines the business logic for diagnosing plant problems from symptoms
+type DiagnosisService interface {
+	// Diagnose ranks the catalogue problems recorded for a garden plant's species and genus
+	// by how well they explain the observed symptoms, in season at the garden
+	Diagnose(ctx context.Context, gardenPlantID string, request *entity.DiagnosisRequest) (*entity.Diagnosis, error)
+
+	// ResolvePlantGarden returns the garden a garden plant belongs to, for authorization
+	ResolvePlantGarden(ctx context.Context, gardenPlantID string) (string, error)
+}
+
+// diagnosisService implements DiagnosisService
+type diagnosisService struct {
+	gardenPlantRepo gardenRepository.GardenPlantRepository
+	gardenRepo      gardenRepository.GardenRepository
+	plantRepo       plantRepository.PlantRepository
+	problemRepo     plantRepository.PlantProblemRepository
+	climateZoneRepo plantRepository.ClimateZoneRepository
+}
+
+// NewDiagnosisService creates a new diagnosis service instance
+func NewDiagnosisService(
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+	gardenRepo gardenRepository.GardenRepository,
+	plantRepo plantRepository.PlantRepository,
+	problemRepo plantRepository.PlantProblemRepository,
+	climateZoneRepo plantRepository.ClimateZoneRepository,
+) DiagnosisService {
+	return &diagnosisService{
+		gardenPlantRepo: gardenPlantRepo,
+		gardenRepo:      gardenRepo,
+		plantRepo:       plantRepo,
+		problemRepo:     problemRepo,
+		climateZoneRepo: climateZoneRepo,
+	}
+}
+
+// Diagnose ranks candidate problems for a garden plant's symptoms
+func (s *diagnosisService) Diagnose(ctx context.Context, gardenPlantID string, request *entity.DiagnosisRequest) (*entity.Diagnosis, error) {
+	if gardenPlantID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+	if request == nil {
+		return nil, domainerrors.NewInvalidInputError("request", "diagnosis request cannot be nil")
+	}
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get garden plant: %w", err)
+	}
+
+	plant, err := s.plantRepo.FindByID(ctx, gardenPlant.PlantID, constants.EnglishLanguageID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plant: %w", err)
+	}
+
+	site, err := s.diagnosisSite(ctx, gardenPlant.GardenID, request.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	problems, err := s.candidateProblems(ctx, plant)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*entity.DiagnosisCandidate, 0, len(problems))
+	for _, related := range problems {
+		if candidate := entity.ScoreCandidate(related.problem, related.relation, request, site); candidate != nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return &entity.Diagnosis{
+		GardenPlantID: gardenPlant.GardenPlantID,
+		PlantID:       gardenPlant.PlantID,
+		Symptoms:      request.Symptoms,
+		Site:          *site,
+		Candidates:    entity.RankCandidates(candidates, request.MaxResults),
+	}, nil
+}
+
+// ResolvePlantGarden returns the garden a garden plant belongs to, for authorization
+func (s *diagnosisService) ResolvePlantGarden(ctx context.Context, gardenPlantID string) (string, error) {
+	if gardenPlantID == "" {
+		return "", domainerrors.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+
+	gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get garden plant: %w", err)
+	}
+
+	return gardenPlant.GardenID, nil
+}
+
+// relatedProblem is a catalogue problem with how closely its plant relates to the diagnosed one
+type relatedProblem struct {
+	problem  *plantEntity.PlantProblem
+	relation entity.Relation
+}
+
+// plantRelation is a catalogue plant and how closely it relates to the diagnosed one
+type plantRelation struct {
+	plantID  string
+	relation entity.Relation
+}
+
+// candidateProblems collects the problems recorded for the plant and its relatives.
+// A problem recorded against several relatives is kept once, from the closest relative.
+func (s *diagnosisService) candidateProblems(ctx context.Context, plant *plantEntity.Plant) ([]relatedProblem, error) {
+	relatives, err := s.plantRepo.FindByGenus(ctx, plant.GenusName, constants.EnglishLanguageID, nil, maxRelatedPlants, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related plants: %w", err)
+	}
+
+	relations := []plantRelation{{plant.PlantID, entity.RelationPlant}}
+	for _, relative := range relatives {
+		if relative.PlantID == plant.PlantID {
+			continue
+		}
+		relation := entity.RelationGenus
+		if relative.SpeciesID == plant.SpeciesID {
+			relation = entity.RelationSpecies
+		}
+		relations = append(relations, plantRelation{relative.PlantID, relation})
+	}
+
+	seen := make(map[string]int)
+	var problems []relatedProblem
+	for _, r := range relations {
+		// Symptom keywords are English, so match against the English catalogue text
+		found, err := s.problemRepo.FindByPlant(ctx, r.plantID, constants.EnglishLanguageID, nil, maxProblemsPerPlant, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get problems for plant %s: %w", r.plantID, err)
+		}
+
+		for _, problem := range found {
+			key := problem.ProblemType + "|" + problem.ProblemName
+			if i, ok := seen[key]; ok {
+				if relationCloser(r.relation, problems[i].relation) {
+					problems[i] = relatedProblem{problem: problem, relation: r.relation}
+				}
+				continue
+			}
+			seen[key] = len(problems)
+			problems = append(problems, relatedProblem{problem: problem, relation: r.relation})
+		}
+	}
+
+	return problems, nil
+}
+
+// diagnosisSite determines the observation month, hardiness zone and hemisphere of a garden.
+// A garden with no location is ranked on the northern calendar; failed lookups are returned
+// rather than treated as unknown.
+func (s *diagnosisService) diagnosisSite(ctx context.Context, gardenID string, month *int) (*entity.DiagnosisSite, error) {
+	site := &entity.DiagnosisSite{Month: int(time.Now().Month())}
+	if month != nil {
+		site.Month = *month
+	}
+
+	garden, err := s.gardenRepo.FindByID(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get garden: %w", err)
+	}
+	site.HardinessZone = garden.HardinessZone
+	if garden.BoundaryGeoJSON == nil {
+		return site, nil
+	}
+
+	lat, lng, err := s.gardenRepo.GetCenterPoint(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate garden: %w", err)
+	}
+	site.SouthernHemisphere = lat < 0
+
+	if site.HardinessZone == nil {
+		climate, err := s.climateZoneRepo.FindByPoint(ctx, lat, lng, constants.ClimateSystemUSDA)
+		if err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("failed to find climate zone: %w", err)
+		}
+		if err == nil && climate != nil {
+			site.HardinessZone = &climate.ZoneCode
+		}
+	}
+
+	return site, nil
+}
+
+// isNotFound reports whether a catalogue lookup found nothing
+func isNotFound(err error) bool {
+	var notFound *plantEntity.NotFoundError
+	return errors.As(err, &notFound)
+}
+
+// relationCloser reports whether relation a is closer to the diagnosed plant than b
+func relationCloser(a, b entity.Relation) bool {
+	order := map[entity.Relation]int{entity.RelationPlant: 0, entity.RelationSpecies: 1, entity.RelationGenus: 2}
+	return order[a] < order[b]
+}
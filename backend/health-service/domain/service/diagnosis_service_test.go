@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/health-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// stubProblemRepository serves problems by plant from memory
+type stubProblemRepository struct {
+	plantRepository.PlantProblemRepository
+	problems []*plantEntity.PlantProblem
+}
+
+func (s *stubProblemRepository) FindByPlant(ctx context.Context, plantID, languageID string, countryID *string, limit, offset int) ([]*plantEntity.PlantProblem, error) {
+	var result []*plantEntity.PlantProblem
+	for _, problem := range s.problems {
+		if problem.PlantID == plantID {
+			result = append(result, problem)
+		}
+	}
+	return result, nil
+}
+
+// stubClimateZoneRepository has no climate zones
+type stubClimateZoneRepository struct {
+	plantRepository.ClimateZoneRepository
+	err error // Returned instead of not found when set
+}
+
+func (s *stubClimateZoneRepository) FindByPoint(ctx context.Context, latitude, longitude float64, zoneSystem string) (*plantEntity.ClimateZone, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return nil, plantEntity.NewNotFoundError("climate_zone", "point")
+}
+
+// testGarden serves garden-123 with an optional hardiness zone and centre (lat, lng)
+func testGarden(hardinessZone *string, center []float64) *mocks.StubGardenRepository {
+	return mocks.NewStubGardenRepository(&gardenEntity.Garden{GardenID: "garden-123", HardinessZone: hardinessZone}, center)
+}
+
+// setupDiagnosisService builds a garden tomato whose genus also holds a cherry tomato
+// cultivar and potatoes, each with problems recorded in the catalogue
+func setupDiagnosisService(garden *mocks.StubGardenRepository, climateZones *stubClimateZoneRepository) DiagnosisService {
+	plants := &mocks.StubPlantRepository{Plants: []*plantEntity.Plant{
+		{PlantID: "plant-tomato", SpeciesID: "species-lycopersicum", GenusName: "Solanum"},
+		{PlantID: "plant-cherry", SpeciesID: "species-lycopersicum", GenusName: "Solanum"},
+		{PlantID: "plant-potato", SpeciesID: "species-tuberosum", GenusName: "Solanum"},
+		{PlantID: "plant-rose", SpeciesID: "species-rosa", GenusName: "Rosa"},
+	}}
+
+	problems := &stubProblemRepository{problems: []*plantEntity.PlantProblem{
+		{
+			ProblemID: "problem-blight-tomato", PlantID: "plant-tomato", ProblemType: plantEntity.ProblemTypeDisease,
+			Severity: plantEntity.ProblemSeverityHigh, ProblemName: "Late blight",
+			Symptoms:          []string{"Brown greasy lesions on leaves", "Rapid wilting and collapse"},
+			AffectedParts:     []string{plantEntity.PlantPartLeaves, plantEntity.PlantPartFruit},
+			ActiveMonths:      []int{7, 8, 9},
+			Treatments:        []string{"Remove infected plants"},
+			OrganicTreatments: []string{"Copper spray"},
+		},
+		{
+			// Also recorded on the potato; the tomato's own record wins
+			ProblemID: "problem-blight-potato", PlantID: "plant-potato", ProblemType: plantEntity.ProblemTypeDisease,
+			Severity: plantEntity.ProblemSeverityHigh, ProblemName: "Late blight",
+			Symptoms: []string{"Brown lesions on leaves"},
+		},
+		{
+			ProblemID: "problem-aphid", PlantID: "plant-cherry", ProblemType: plantEntity.ProblemTypePest,
+			Severity: plantEntity.ProblemSeverityMedium, ProblemName: "Aphids",
+			Symptoms:           []string{"Sticky honeydew on leaves", "Curled new growth"},
+			ActiveMonths:       []int{4, 5, 6},
+			ChemicalTreatments: []string{"Systemic insecticide"},
+			OrganicTreatments:  []string{"Insecticidal soap"},
+		},
+		{
+			ProblemID: "problem-beetle", PlantID: "plant-potato", ProblemType: plantEntity.ProblemTypePest,
+			Severity: plantEntity.ProblemSeverityHigh, ProblemName: "Colorado potato beetle",
+			Symptoms:      []string{"Chewed holes in foliage", "Striped beetles on stems"},
+			AffectedParts: []string{plantEntity.PlantPartLeaves},
+		},
+		{
+			ProblemID: "problem-blackspot", PlantID: "plant-rose", ProblemType: plantEntity.ProblemTypeDisease,
+			Severity: plantEntity.ProblemSeverityMedium, ProblemName: "Black spot",
+			Symptoms: []string{"Black spots with yellowing"},
+		},
+	}}
+
+	return NewDiagnosisService(
+		&mocks.StubGardenPlantRepository{Plants: []*gardenEntity.GardenPlant{
+			{GardenPlantID: "gp-tomato", GardenID: "garden-123", PlantID: "plant-tomato"},
+		}},
+		garden,
+		plants,
+		problems,
+		climateZones,
+	)
+}
+
+func TestDiagnose_RanksBySymptomOverlap(t *testing.T) {
+	service := setupDiagnosisService(testGarden(nil, nil), &stubClimateZoneRepository{})
+
+	diagnosis, err := service.Diagnose(context.Background(), "gp-tomato", &entity.DiagnosisRequest{
+		Symptoms: []string{entity.SymptomBrowning, entity.SymptomWilting},
+		Month:    mocks.IntPtr(8),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, diagnosis.Candidates)
+
+	top := diagnosis.Candidates[0]
+	assert.Equal(t, "problem-blight-tomato", top.Problem.ProblemID)
+	assert.Equal(t, entity.RelationPlant, top.Relation)
+	assert.True(t, top.InSeason)
+	assert.Equal(t, 100, top.Confidence)
+	assert.Equal(t, entity.ConfidenceHigh, top.ConfidenceLevel)
+	assert.Equal(t, []string{"Copper spray", "Remove infected plants"}, top.RecommendedTreatments)
+
+	for _, candidate := range diagnosis.Candidates {
+		assert.NotEqual(t, "problem-blight-potato", candidate.Problem.ProblemID, "duplicate from a more distant relative")
+		assert.NotEqual(t, "problem-blackspot", candidate.Problem.ProblemID, "problem from another genus")
+	}
+}
+
+func TestDiagnose_RelationsAndTreatmentOrder(t *testing.T) {
+	service := setupDiagnosisService(testGarden(nil, nil), &stubClimateZoneRepository{})
+
+	diagnosis, err := service.Diagnose(context.Background(), "gp-tomato", &entity.DiagnosisRequest{
+		Symptoms: []string{entity.SymptomStickyResidue, "chewed holes"},
+		Month:    mocks.IntPtr(5),
+	})
+	require.NoError(t, err)
+
+	byID := make(map[string]*entity.DiagnosisCandidate)
+	for _, candidate := range diagnosis.Candidates {
+		byID[candidate.Problem.ProblemID] = candidate
+	}
+
+	require.Contains(t, byID, "problem-aphid")
+	assert.Equal(t, entity.RelationSpecies, byID["problem-aphid"].Relation)
+	assert.Equal(t, []string{entity.SymptomStickyResidue}, byID["problem-aphid"].MatchedSymptoms)
+	assert.Equal(t, []string{"Insecticidal soap", "Systemic insecticide"}, byID["problem-aphid"].RecommendedTreatments)
+
+	require.Contains(t, byID, "problem-beetle")
+	assert.Equal(t, entity.RelationGenus, byID["problem-beetle"].Relation)
+	assert.Less(t, byID["problem-beetle"].Confidence, byID["problem-aphid"].Confidence)
+}
+
+func TestDiagnose_Seasonality(t *testing.T) {
+	request := func(month int) *entity.DiagnosisRequest {
+		return &entity.DiagnosisRequest{Symptoms: []string{entity.SymptomBrowning, entity.SymptomWilting}, Month: mocks.IntPtr(month)}
+	}
+
+	tests := []struct {
+		name         string
+		garden       *mocks.StubGardenRepository
+		month        int
+		wantInSeason bool
+		wantScore    int
+	}{
+		{"in season", testGarden(nil, nil), 8, true, 100},
+		{"out of season", testGarden(nil, nil), 1, false, 60},
+		{"out of season in a frost-free zone", testGarden(mocks.StrPtr("10b"), nil), 1, false, 85},
+		{"southern hemisphere summer", testGarden(nil, []float64{-37.8, 145.0}), 2, true, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setupDiagnosisService(tt.garden, &stubClimateZoneRepository{})
+
+			diagnosis, err := service.Diagnose(context.Background(), "gp-tomato", request(tt.month))
+			require.NoError(t, err)
+			require.NotEmpty(t, diagnosis.Candidates)
+
+			top := diagnosis.Candidates[0]
+			assert.Equal(t, "problem-blight-tomato", top.Problem.ProblemID)
+			assert.Equal(t, tt.wantInSeason, top.InSeason)
+			assert.Equal(t, tt.wantScore, top.Confidence)
+		})
+	}
+}
+
+func TestDiagnose_Validation(t *testing.T) {
+	service := setupDiagnosisService(testGarden(nil, nil), &stubClimateZoneRepository{})
+
+	tests := []struct {
+		name    string
+		request *entity.DiagnosisRequest
+		wantErr string
+	}{
+		{"no symptoms", &entity.DiagnosisRequest{Symptoms: []string{"  "}}, "symptoms"},
+		{"unknown part", &entity.DiagnosisRequest{Symptoms: []string{entity.SymptomRot}, AffectedParts: []string{"tentacles"}}, "affected_parts"},
+		{"month out of range", &entity.DiagnosisRequest{Symptoms: []string{entity.SymptomRot}, Month: mocks.IntPtr(13)}, "month"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Diagnose(context.Background(), "gp-tomato", tt.request)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "validation")
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	t.Run("unknown garden plant", func(t *testing.T) {
+		_, err := service.Diagnose(context.Background(), "gp-missing", &entity.DiagnosisRequest{Symptoms: []string{entity.SymptomRot}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+}
+
+func TestDiagnose_SiteLookupErrors(t *testing.T) {
+	lookupErr := errors.New("connection refused")
+
+	tests := []struct {
+		name         string
+		garden       *mocks.StubGardenRepository
+		climateZones *stubClimateZoneRepository
+	}{
+		{"garden lookup", &mocks.StubGardenRepository{Err: lookupErr}, &stubClimateZoneRepository{}},
+		{"climate zone lookup", testGarden(nil, []float64{37.77, -122.42}), &stubClimateZoneRepository{err: lookupErr}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setupDiagnosisService(tt.garden, tt.climateZones)
+
+			_, err := service.Diagnose(context.Background(), "gp-tomato", &entity.DiagnosisRequest{Symptoms: []string{entity.SymptomBrowning}})
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, lookupErr)
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/health-service/domain/entity"
+	"twigger-backend/backend/health-service/domain/repository"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// OutbreakService defines the business logic for tracking pest and disease outbreaks in a garden
+type OutbreakService interface {
+	// ReportProblem links a catalogue problem to a garden plant marked diseased and returns
+	// the garden's outbreak of that problem, with the susceptible plants at risk
+	ReportProblem(ctx context.Context, gardenPlantID string, report *entity.ProblemReport) (*entity.Outbreak, error)
+
+	// ListReports retrieves the problems reported on a garden plant, newest first
+	ListReports(ctx context.Context, gardenPlantID string) ([]*entity.ProblemReport, error)
+
+	// DeleteReport removes a problem report from a garden plant
+	DeleteReport(ctx context.Context, gardenPlantID, reportID string) error
+
+	// GetGardenOutbreaks groups the open reports in a garden by problem and flags the
+	// susceptible plants around them, most urgent outbreak first
+	GetGardenOutbreaks(ctx context.Context, gardenID string) ([]*entity.Outbreak, error)
+
+	// ResolvePlantGarden returns the garden a garden plant belongs to, for authorization
+	ResolvePlantGarden(ctx context.Context, gardenPlantID string) (string, error)
+}
+
+// outbreakService implements OutbreakService
+type outbreakService struct {
+	reportRepo      repository.ProblemReportRepository
+	gardenPlantRepo gardenRepository.GardenPlantRepository
+	problemRepo     plantRepository.PlantProblemRepository
+}
+
+// NewOutbreakService creates a new outbreak service instance
+func NewOutbreakService(
+	reportRepo repository.ProblemReportRepository,
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+	problemRepo plantRepository.PlantProblemRepository,
+) OutbreakService {
+	return &outbreakService{
+		reportRepo:      reportRepo,
+		gardenPlantRepo: gardenPlantRepo,
+		problemRepo:     problemRepo,
+	}
+}
+
+// ReportProblem links a problem to a diseased garden plant
+func (s *outbreakService) ReportProblem(ctx context.Context, gardenPlantID string, report *entity.ProblemReport) (*entity.Outbreak, error) {
+	if gardenPlantID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+	if report == nil {
+		return nil, domainerrors.NewInvalidInputError("report", "problem report cannot be nil")
+	}
+	if report.ProblemID == "" {
+		return nil, domainerrors.NewValidationError("problem_id", "problem ID is required")
+	}
+
+	gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get garden plant: %w", err)
+	}
+	if !gardenPlant.IsActive() {
+		return nil, domainerrors.NewValidationError("garden_plant_id", "cannot report a problem on a removed plant")
+	}
+	if gardenPlant.HealthStatus == nil || *gardenPlant.HealthStatus != gardenEntity.HealthStatusDiseased {
+		return nil, domainerrors.NewValidationError("health_status", "mark the plant as diseased before reporting a problem")
+	}
+
+	problem, err := s.problemRepo.FindByID(ctx, report.ProblemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plant problem: %w", err)
+	}
+
+	report.GardenPlantID = gardenPlant.GardenPlantID
+	report.GardenID = gardenPlant.GardenID
+	report.PlantID = gardenPlant.PlantID
+	report.ProblemName = problem.ProblemName
+	report.ProblemType = problem.ProblemType
+	report.Severity = problem.Severity
+
+	if err := s.reportRepo.Save(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to save problem report: %w", err)
+	}
+
+	open, err := s.reportRepo.FindOpenInGarden(ctx, gardenPlant.GardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open problem reports: %w", err)
+	}
+
+	// Only this problem's outbreak is needed
+	var related []*entity.ProblemReport
+	for _, r := range open {
+		if r.OutbreakKey() == report.OutbreakKey() {
+			related = append(related, r)
+		}
+	}
+
+	outbreaks, err := s.buildOutbreaks(ctx, gardenPlant.GardenID, related)
+	if err != nil {
+		return nil, err
+	}
+	if len(outbreaks) == 0 {
+		return nil, fmt.Errorf("reported problem %s missing from open reports", report.ReportID)
+	}
+
+	return outbreaks[0], nil
+}
+
+// ListReports retrieves a garden plant's problem reports
+func (s *outbreakService) ListReports(ctx context.Context, gardenPlantID string) ([]*entity.ProblemReport, error) {
+	if gardenPlantID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+
+	reports, err := s.reportRepo.FindByGardenPlant(ctx, gardenPlantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list problem reports: %w", err)
+	}
+
+	return reports, nil
+}
+
+// DeleteReport removes a problem report, checking it belongs to the garden plant
+func (s *outbreakService) DeleteReport(ctx context.Context, gardenPlantID, reportID string) error {
+	if reportID == "" {
+		return domainerrors.NewInvalidInputError("report_id", "report ID cannot be empty")
+	}
+
+	report, err := s.reportRepo.FindByID(ctx, reportID)
+	if err != nil {
+		return fmt.Errorf("failed to get problem report: %w", err)
+	}
+	if report.GardenPlantID != gardenPlantID {
+		return domainerrors.NewNotFoundError("problem_report", reportID)
+	}
+
+	if err := s.reportRepo.Delete(ctx, reportID); err != nil {
+		return fmt.Errorf("failed to delete problem report: %w", err)
+	}
+
+	return nil
+}
+
+// GetGardenOutbreaks builds the garden's current outbreaks
+func (s *outbreakService) GetGardenOutbreaks(ctx context.Context, gardenID string) ([]*entity.Outbreak, error) {
+	if gardenID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	open, err := s.reportRepo.FindOpenInGarden(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open problem reports: %w", err)
+	}
+
+	return s.buildOutbreaks(ctx, gardenID, open)
+}
+
+// ResolvePlantGarden returns the garden a garden plant belongs to, for authorization
+func (s *outbreakService) ResolvePlantGarden(ctx context.Context, gardenPlantID string) (string, error) {
+	if gardenPlantID == "" {
+		return "", domainerrors.NewInvalidInputError("garden_plant_id", "garden plant ID cannot be empty")
+	}
+
+	gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, gardenPlantID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get garden plant: %w", err)
+	}
+
+	return gardenPlant.GardenID, nil
+}
+
+// buildOutbreaks groups open reports by problem and flags the susceptible active plants
+// around each group, most urgent first
+func (s *outbreakService) buildOutbreaks(ctx context.Context, gardenID string, reports []*entity.ProblemReport) ([]*entity.Outbreak, error) {
+	outbreaks := []*entity.Outbreak{}
+	if len(reports) == 0 {
+		return outbreaks, nil
+	}
+
+	active, err := s.gardenPlantRepo.FindActiveInGarden(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get garden plants: %w", err)
+	}
+
+	byKey := make(map[string]*entity.Outbreak)
+	for _, report := range reports {
+		outbreak, ok := byKey[report.OutbreakKey()]
+		if !ok {
+			outbreak = &entity.Outbreak{
+				GardenID:    gardenID,
+				ProblemID:   report.ProblemID,
+				ProblemName: report.ProblemName,
+				ProblemType: report.ProblemType,
+				Severity:    report.Severity,
+				AtRisk:      []*entity.AtRiskPlant{},
+			}
+			byKey[report.OutbreakKey()] = outbreak
+			outbreaks = append(outbreaks, outbreak)
+		}
+		outbreak.Reports = append(outbreak.Reports, report)
+	}
+
+	for _, outbreak := range outbreaks {
+		atRisk, err := s.findAtRisk(ctx, outbreak, active)
+		if err != nil {
+			return nil, err
+		}
+		outbreak.AtRisk = atRisk
+		outbreak.UpdateAlertLevel()
+	}
+
+	sort.SliceStable(outbreaks, func(i, j int) bool {
+		return alertRank(outbreaks[i]) > alertRank(outbreaks[j])
+	})
+
+	return outbreaks, nil
+}
+
+// findAtRisk flags the active plants susceptible to an outbreak's problem, scored by
+// distance to the nearest affected plant, highest risk first. Plants of the same species
+// as an affected plant are susceptible even when the catalogue doesn't record it.
+func (s *outbreakService) findAtRisk(ctx context.Context, outbreak *entity.Outbreak, active []*gardenEntity.GardenPlant) ([]*entity.AtRiskPlant, error) {
+	affectedIDs := make(map[string]bool)
+	affectedPlantIDs := make(map[string]bool)
+	for _, report := range outbreak.Reports {
+		affectedIDs[report.GardenPlantID] = true
+		affectedPlantIDs[report.PlantID] = true
+	}
+
+	var affected, candidates []*gardenEntity.GardenPlant
+	var candidatePlantIDs []string
+	seenPlantIDs := make(map[string]bool)
+	for _, gardenPlant := range active {
+		if affectedIDs[gardenPlant.GardenPlantID] {
+			affected = append(affected, gardenPlant)
+			continue
+		}
+		if gardenPlant.HealthStatus != nil && *gardenPlant.HealthStatus == gardenEntity.HealthStatusDead {
+			continue
+		}
+		candidates = append(candidates, gardenPlant)
+		if !seenPlantIDs[gardenPlant.PlantID] {
+			seenPlantIDs[gardenPlant.PlantID] = true
+			candidatePlantIDs = append(candidatePlantIDs, gardenPlant.PlantID)
+		}
+	}
+	if len(candidates) == 0 {
+		return []*entity.AtRiskPlant{}, nil
+	}
+
+	susceptibleIDs, err := s.problemRepo.FindSusceptiblePlants(ctx, outbreak.ProblemID, candidatePlantIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find susceptible plants: %w", err)
+	}
+	susceptible := make(map[string]bool)
+	for _, plantID := range susceptibleIDs {
+		susceptible[plantID] = true
+	}
+
+	var atRiskPlants []*gardenEntity.GardenPlant
+	for _, candidate := range candidates {
+		if susceptible[candidate.PlantID] || affectedPlantIDs[candidate.PlantID] {
+			atRiskPlants = append(atRiskPlants, candidate)
+		}
+	}
+	if len(atRiskPlants) == 0 {
+		return []*entity.AtRiskPlant{}, nil
+	}
+
+	// Affected plants take the first indexes; pairs are measured once with I < J
+	locations := make([]string, 0, len(affected)+len(atRiskPlants))
+	for _, gardenPlant := range affected {
+		locations = append(locations, gardenPlant.LocationGeoJSON)
+	}
+	for _, gardenPlant := range atRiskPlants {
+		locations = append(locations, gardenPlant.LocationGeoJSON)
+	}
+
+	pairs, err := s.gardenPlantRepo.PairwiseDistances(ctx, locations, entity.OutbreakSearchRadiusM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to measure distances to affected plants: %w", err)
+	}
+
+	result := make([]*entity.AtRiskPlant, len(atRiskPlants))
+	for i, gardenPlant := range atRiskPlants {
+		result[i] = &entity.AtRiskPlant{
+			GardenPlantID: gardenPlant.GardenPlantID,
+			PlantID:       gardenPlant.PlantID,
+			ZoneID:        gardenPlant.ZoneID,
+		}
+	}
+
+	for _, pair := range pairs {
+		if pair.I >= len(affected) || pair.J < len(affected) {
+			continue // Both affected, or both at risk
+		}
+		plant := result[pair.J-len(affected)]
+		if plant.DistanceM == nil || pair.DistanceM < *plant.DistanceM {
+			distance := pair.DistanceM
+			plant.DistanceM = &distance
+			plant.NearestAffectedID = &affected[pair.I].GardenPlantID
+		}
+	}
+
+	for _, plant := range result {
+		plant.RiskScore, plant.RiskLevel = entity.RiskFromDistance(plant.DistanceM)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].RiskScore > result[j].RiskScore
+	})
+
+	return result, nil
+}
+
+// alertRank orders outbreaks by alert level, then by how many plants are at risk
+func alertRank(outbreak *entity.Outbreak) int {
+	rank := map[entity.RiskLevel]int{entity.RiskNone: 0, entity.RiskLow: 1, entity.RiskMedium: 2, entity.RiskHigh: 3}
+	return rank[outbreak.AlertLevel]*10000 + len(outbreak.AtRisk)
+}
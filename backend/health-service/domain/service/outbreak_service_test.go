@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/health-service/domain/entity"
+	"twigger-backend/backend/health-service/domain/repository"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/shared/domainerrors"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// planarGardenPlantRepository serves garden plants from memory, treating point
+// coordinates as metres on a flat plane
+type planarGardenPlantRepository struct {
+	mocks.StubGardenPlantRepository
+}
+
+// plant returns a stored garden plant so a test can change its state
+func (s *planarGardenPlantRepository) plant(gardenPlantID string) *gardenEntity.GardenPlant {
+	plant, _ := s.FindByID(context.Background(), gardenPlantID)
+	return plant
+}
+
+func (s *planarGardenPlantRepository) PairwiseDistances(ctx context.Context, locationsGeoJSON []string, maxDistanceM float64) ([]*gardenRepository.LocationPair, error) {
+	points := make([][2]float64, len(locationsGeoJSON))
+	for i, location := range locationsGeoJSON {
+		var point struct {
+			Coordinates [2]float64 `json:"coordinates"`
+		}
+		if err := json.Unmarshal([]byte(location), &point); err != nil {
+			return nil, err
+		}
+		points[i] = point.Coordinates
+	}
+
+	var pairs []*gardenRepository.LocationPair
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			d := math.Hypot(points[i][0]-points[j][0], points[i][1]-points[j][1])
+			if d <= maxDistanceM {
+				pairs = append(pairs, &gardenRepository.LocationPair{I: i, J: j, DistanceM: d})
+			}
+		}
+	}
+	return pairs, nil
+}
+
+func (s *stubProblemRepository) FindByID(ctx context.Context, problemID string) (*plantEntity.PlantProblem, error) {
+	for _, problem := range s.problems {
+		if problem.ProblemID == problemID {
+			return problem, nil
+		}
+	}
+	return nil, plantEntity.NewNotFoundError("plant_problem", problemID)
+}
+
+func (s *stubProblemRepository) FindSusceptiblePlants(ctx context.Context, problemID string, plantIDs []string) ([]string, error) {
+	source, err := s.FindByID(ctx, problemID)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool)
+	for _, plantID := range plantIDs {
+		wanted[plantID] = true
+	}
+
+	var result []string
+	for _, problem := range s.problems {
+		if wanted[problem.PlantID] && problem.ProblemType == source.ProblemType &&
+			strings.EqualFold(problem.ProblemName, source.ProblemName) {
+			result = append(result, problem.PlantID)
+		}
+	}
+	return result, nil
+}
+
+// stubProblemReportRepository stores reports in memory, joining plant and problem details
+type stubProblemReportRepository struct {
+	repository.ProblemReportRepository
+	gardenPlants *planarGardenPlantRepository
+	reports      []*entity.ProblemReport
+}
+
+func (s *stubProblemReportRepository) Save(ctx context.Context, report *entity.ProblemReport) error {
+	if err := report.Validate(); err != nil {
+		return domainerrors.NewValidationError("problem_report", err.Error())
+	}
+	for _, existing := range s.reports {
+		if existing.GardenPlantID == report.GardenPlantID && existing.ProblemID == report.ProblemID {
+			report.ReportID = existing.ReportID
+			existing.Notes = report.Notes
+			return nil
+		}
+	}
+	report.ReportID = "report-" + report.GardenPlantID
+	saved := *report
+	s.reports = append(s.reports, &saved)
+	return nil
+}
+
+func (s *stubProblemReportRepository) FindByID(ctx context.Context, reportID string) (*entity.ProblemReport, error) {
+	for _, report := range s.reports {
+		if report.ReportID == reportID {
+			return report, nil
+		}
+	}
+	return nil, domainerrors.NewNotFoundError("problem_report", reportID)
+}
+
+func (s *stubProblemReportRepository) Delete(ctx context.Context, reportID string) error {
+	for i, report := range s.reports {
+		if report.ReportID == reportID {
+			s.reports = append(s.reports[:i], s.reports[i+1:]...)
+			return nil
+		}
+	}
+	return domainerrors.NewNotFoundError("problem_report", reportID)
+}
+
+func (s *stubProblemReportRepository) FindOpenInGarden(ctx context.Context, gardenID string) ([]*entity.ProblemReport, error) {
+	var result []*entity.ProblemReport
+	for _, report := range s.reports {
+		plant := s.gardenPlants.plant(report.GardenPlantID)
+		if report.GardenID == gardenID && plant.IsActive() &&
+			plant.HealthStatus != nil && *plant.HealthStatus == gardenEntity.HealthStatusDiseased {
+			result = append(result, report)
+		}
+	}
+	return result, nil
+}
+
+func point(x, y float64) string {
+	location, _ := json.Marshal(map[string]interface{}{"type": "Point", "coordinates": []float64{x, y}})
+	return string(location)
+}
+
+func healthStatus(status gardenEntity.HealthStatus) *gardenEntity.HealthStatus {
+	return &status
+}
+
+// setupOutbreakService builds a garden with a diseased tomato at the origin, tomatoes and
+// potatoes at increasing distances, and a bean that blight doesn't affect
+func setupOutbreakService() (OutbreakService, *planarGardenPlantRepository, *stubProblemReportRepository) {
+	gardenPlants := &planarGardenPlantRepository{mocks.StubGardenPlantRepository{Plants: []*gardenEntity.GardenPlant{
+		{GardenPlantID: "gp-tomato-sick", GardenID: "garden-123", PlantID: "plant-tomato", LocationGeoJSON: point(0, 0), HealthStatus: healthStatus(gardenEntity.HealthStatusDiseased)},
+		{GardenPlantID: "gp-tomato-near", GardenID: "garden-123", PlantID: "plant-tomato", LocationGeoJSON: point(1, 0)},
+		{GardenPlantID: "gp-potato-mid", GardenID: "garden-123", PlantID: "plant-potato", LocationGeoJSON: point(0, 8)},
+		{GardenPlantID: "gp-potato-far", GardenID: "garden-123", PlantID: "plant-potato", LocationGeoJSON: point(300, 0)},
+		{GardenPlantID: "gp-potato-dead", GardenID: "garden-123", PlantID: "plant-potato", LocationGeoJSON: point(2, 0), HealthStatus: healthStatus(gardenEntity.HealthStatusDead)},
+		{GardenPlantID: "gp-bean", GardenID: "garden-123", PlantID: "plant-bean", LocationGeoJSON: point(0, 1)},
+		{GardenPlantID: "gp-tomato-other", GardenID: "garden-456", PlantID: "plant-tomato", LocationGeoJSON: point(0, 0)},
+		{GardenPlantID: "gp-tomato-ok", GardenID: "garden-456", PlantID: "plant-tomato", LocationGeoJSON: point(5, 5), HealthStatus: healthStatus(gardenEntity.HealthStatusHealthy)},
+	}}}
+
+	problems := &stubProblemRepository{problems: []*plantEntity.PlantProblem{
+		{ProblemID: "problem-blight-tomato", PlantID: "plant-tomato", ProblemType: plantEntity.ProblemTypeDisease, Severity: plantEntity.ProblemSeverityHigh, ProblemName: "Late blight"},
+		{ProblemID: "problem-blight-potato", PlantID: "plant-potato", ProblemType: plantEntity.ProblemTypeDisease, Severity: plantEntity.ProblemSeverityHigh, ProblemName: "Late Blight"},
+		{ProblemID: "problem-rust-bean", PlantID: "plant-bean", ProblemType: plantEntity.ProblemTypeDisease, Severity: plantEntity.ProblemSeverityLow, ProblemName: "Bean rust"},
+	}}
+
+	reports := &stubProblemReportRepository{gardenPlants: gardenPlants}
+
+	return NewOutbreakService(reports, gardenPlants, problems), gardenPlants, reports
+}
+
+func TestReportProblem_FlagsSusceptiblePlantsByProximity(t *testing.T) {
+	service, _, _ := setupOutbreakService()
+
+	outbreak, err := service.ReportProblem(context.Background(), "gp-tomato-sick", &entity.ProblemReport{
+		ProblemID:  "problem-blight-tomato",
+		ReportedBy: "user-123",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Late blight", outbreak.ProblemName)
+	require.Len(t, outbreak.Reports, 1)
+	assert.Equal(t, "gp-tomato-sick", outbreak.Reports[0].GardenPlantID)
+
+	var ids []string
+	for _, plant := range outbreak.AtRisk {
+		ids = append(ids, plant.GardenPlantID)
+	}
+	// Dead plants, unsusceptible plants and other gardens are left out
+	assert.Equal(t, []string{"gp-tomato-near", "gp-potato-mid", "gp-potato-far"}, ids)
+
+	near, mid, far := outbreak.AtRisk[0], outbreak.AtRisk[1], outbreak.AtRisk[2]
+	assert.Equal(t, entity.RiskHigh, near.RiskLevel)
+	require.NotNil(t, near.DistanceM)
+	assert.InDelta(t, 1.0, *near.DistanceM, 0.001)
+	assert.Equal(t, "gp-tomato-sick", *near.NearestAffectedID)
+
+	assert.Equal(t, entity.RiskMedium, mid.RiskLevel)
+
+	assert.Nil(t, far.DistanceM, "beyond the search radius")
+	assert.Equal(t, entity.RiskLow, far.RiskLevel)
+
+	assert.Equal(t, entity.RiskHigh, outbreak.AlertLevel)
+}
+
+func TestReportProblem_RequiresDiseasedActivePlant(t *testing.T) {
+	service, _, _ := setupOutbreakService()
+
+	_, err := service.ReportProblem(context.Background(), "gp-tomato-ok", &entity.ProblemReport{
+		ProblemID:  "problem-blight-tomato",
+		ReportedBy: "user-123",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "diseased")
+
+	_, err = service.ReportProblem(context.Background(), "gp-tomato-sick", &entity.ProblemReport{ReportedBy: "user-123"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "problem_id")
+
+	_, err = service.ReportProblem(context.Background(), "gp-tomato-sick", &entity.ProblemReport{
+		ProblemID:  "problem-missing",
+		ReportedBy: "user-123",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestGetGardenOutbreaks(t *testing.T) {
+	ctx := context.Background()
+	service, gardenPlants, _ := setupOutbreakService()
+
+	_, err := service.ReportProblem(ctx, "gp-tomato-sick", &entity.ProblemReport{ProblemID: "problem-blight-tomato", ReportedBy: "user-123"})
+	require.NoError(t, err)
+
+	// The mid potato falls ill with the same blight under its own catalogue entry
+	gardenPlants.plant("gp-potato-mid").HealthStatus = healthStatus(gardenEntity.HealthStatusDiseased)
+	_, err = service.ReportProblem(ctx, "gp-potato-mid", &entity.ProblemReport{ProblemID: "problem-blight-potato", ReportedBy: "user-123"})
+	require.NoError(t, err)
+
+	gardenPlants.plant("gp-bean").HealthStatus = healthStatus(gardenEntity.HealthStatusDiseased)
+	_, err = service.ReportProblem(ctx, "gp-bean", &entity.ProblemReport{ProblemID: "problem-rust-bean", ReportedBy: "user-123"})
+	require.NoError(t, err)
+
+	t.Run("groups reports of the same problem", func(t *testing.T) {
+		outbreaks, err := service.GetGardenOutbreaks(ctx, "garden-123")
+		require.NoError(t, err)
+		require.Len(t, outbreaks, 2)
+
+		blight := outbreaks[0]
+		assert.Equal(t, "problem-blight-tomato", blight.ProblemID)
+		assert.Len(t, blight.Reports, 2)
+		for _, plant := range blight.AtRisk {
+			assert.NotEqual(t, "gp-potato-mid", plant.GardenPlantID, "affected plants aren't at risk")
+		}
+
+		rust := outbreaks[1]
+		assert.Equal(t, "problem-rust-bean", rust.ProblemID)
+		assert.Empty(t, rust.AtRisk)
+		assert.Equal(t, entity.RiskNone, rust.AlertLevel)
+	})
+
+	t.Run("recovered plants close their reports", func(t *testing.T) {
+		gardenPlants.plant("gp-bean").HealthStatus = healthStatus(gardenEntity.HealthStatusHealthy)
+
+		outbreaks, err := service.GetGardenOutbreaks(ctx, "garden-123")
+		require.NoError(t, err)
+		require.Len(t, outbreaks, 1)
+		assert.Equal(t, "problem-blight-tomato", outbreaks[0].ProblemID)
+	})
+
+	t.Run("no outbreaks", func(t *testing.T) {
+		outbreaks, err := service.GetGardenOutbreaks(ctx, "garden-456")
+		require.NoError(t, err)
+		assert.NotNil(t, outbreaks)
+		assert.Empty(t, outbreaks)
+	})
+}
+
+func TestDeleteReport_ChecksGardenPlant(t *testing.T) {
+	ctx := context.Background()
+	service, _, reports := setupOutbreakService()
+
+	outbreak, err := service.ReportProblem(ctx, "gp-tomato-sick", &entity.ProblemReport{ProblemID: "problem-blight-tomato", ReportedBy: "user-123"})
+	require.NoError(t, err)
+	reportID := outbreak.Reports[0].ReportID
+
+	err = service.DeleteReport(ctx, "gp-tomato-near", reportID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+
+	require.NoError(t, service.DeleteReport(ctx, "gp-tomato-sick", reportID))
+	assert.Empty(t, reports.reports)
+}
@@ -0,0 +1,191 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"twigger-backend/backend/health-service/domain/entity"
+	"twigger-backend/backend/health-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// PostgresProblemReportRepository implements repository.ProblemReportRepository using PostgreSQL
+type PostgresProblemReportRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresProblemReportRepository creates a new PostgreSQL problem report repository
+func NewPostgresProblemReportRepository(db *sql.DB) repository.ProblemReportRepository {
+	return &PostgresProblemReportRepository{db: db}
+}
+
+// problemReportSelect selects reports with their plant and catalogue problem details
+const problemReportSelect = `
+	SELECT
+		r.report_id, r.garden_plant_id, r.garden_id, gp.plant_id, r.problem_id,
+		pp.problem_name, pp.problem_type, pp.severity,
+		r.notes, r.reported_by, r.created_at, r.updated_at
+	FROM garden_plant_problems r
+	INNER JOIN garden_plants gp ON r.garden_plant_id = gp.garden_plant_id
+	INNER JOIN plant_problems pp ON r.problem_id = pp.problem_id
+`
+
+// Save creates a report, or updates the notes of an existing report of the same problem
+func (r *PostgresProblemReportRepository) Save(ctx context.Context, report *entity.ProblemReport) error {
+	if err := report.Validate(); err != nil {
+		return domainerrors.NewValidationError("problem_report", err.Error())
+	}
+
+	if report.ReportID == "" {
+		report.ReportID = uuid.New().String()
+	}
+
+	now := time.Now()
+	report.CreatedAt = now
+	report.UpdatedAt = now
+
+	// On conflict the existing report keeps its ID, reporter and creation time
+	query := `
+		INSERT INTO garden_plant_problems (
+			report_id, garden_plant_id, garden_id, problem_id,
+			notes, reported_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+		ON CONFLICT (garden_plant_id, problem_id) DO UPDATE SET
+			notes = COALESCE(EXCLUDED.notes, garden_plant_problems.notes),
+			updated_at = EXCLUDED.updated_at
+		RETURNING report_id, reported_by, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		report.ReportID,
+		report.GardenPlantID,
+		report.GardenID,
+		report.ProblemID,
+		report.Notes,
+		report.ReportedBy,
+		report.CreatedAt,
+		report.UpdatedAt,
+	).Scan(&report.ReportID, &report.ReportedBy, &report.CreatedAt)
+	if err != nil {
+		return domainerrors.NewDatabaseError("problem_report_save", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a problem report by ID
+func (r *PostgresProblemReportRepository) FindByID(ctx context.Context, reportID string) (*entity.ProblemReport, error) {
+	query := problemReportSelect + ` WHERE r.report_id = $1`
+
+	report, err := scanProblemReport(r.db.QueryRowContext(ctx, query, reportID))
+	if err == sql.ErrNoRows {
+		return nil, domainerrors.NewNotFoundError("problem_report", reportID)
+	}
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("problem_report_find_by_id", err)
+	}
+
+	return report, nil
+}
+
+// Delete deletes a problem report
+func (r *PostgresProblemReportRepository) Delete(ctx context.Context, reportID string) error {
+	query := `DELETE FROM garden_plant_problems WHERE report_id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, reportID)
+	if err != nil {
+		return domainerrors.NewDatabaseError("problem_report_delete", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainerrors.NewDatabaseError("problem_report_delete_rows_affected", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainerrors.NewNotFoundError("problem_report", reportID)
+	}
+
+	return nil
+}
+
+// FindByGardenPlant finds a garden plant's reports, newest first
+func (r *PostgresProblemReportRepository) FindByGardenPlant(ctx context.Context, gardenPlantID string) ([]*entity.ProblemReport, error) {
+	query := problemReportSelect + ` WHERE r.garden_plant_id = $1 ORDER BY r.created_at DESC`
+
+	return r.queryReports(ctx, "problem_report_find_by_garden_plant", query, gardenPlantID)
+}
+
+// FindOpenInGarden finds reports on a garden's active, diseased plants, oldest first
+func (r *PostgresProblemReportRepository) FindOpenInGarden(ctx context.Context, gardenID string) ([]*entity.ProblemReport, error) {
+	query := problemReportSelect + `
+		WHERE r.garden_id = $1
+			AND gp.removed_date IS NULL
+			AND gp.health_status = 'diseased'
+		ORDER BY r.created_at ASC`
+
+	return r.queryReports(ctx, "problem_report_find_open_in_garden", query, gardenID)
+}
+
+// queryReports runs a report query and scans every row
+func (r *PostgresProblemReportRepository) queryReports(ctx context.Context, operation, query string, args ...interface{}) ([]*entity.ProblemReport, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError(operation, err)
+	}
+	defer rows.Close()
+
+	reports := []*entity.ProblemReport{}
+	for rows.Next() {
+		report, err := scanProblemReport(rows)
+		if err != nil {
+			return nil, domainerrors.NewDatabaseError("problem_report_scan", err)
+		}
+		reports = append(reports, report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domainerrors.NewDatabaseError(operation+"_rows_iteration", err)
+	}
+
+	return reports, nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanProblemReport scans a single report row, mapping nullable columns
+func scanProblemReport(row rowScanner) (*entity.ProblemReport, error) {
+	var report entity.ProblemReport
+	var notes sql.NullString
+
+	err := row.Scan(
+		&report.ReportID,
+		&report.GardenPlantID,
+		&report.GardenID,
+		&report.PlantID,
+		&report.ProblemID,
+		&report.ProblemName,
+		&report.ProblemType,
+		&report.Severity,
+		&notes,
+		&report.ReportedBy,
+		&report.CreatedAt,
+		&report.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if notes.Valid {
+		report.Notes = &notes.String
+	}
+
+	return &report, nil
+}
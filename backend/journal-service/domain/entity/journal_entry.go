@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Journal entry limits
+const (
+	MaxTitleLength = 200
+	MaxBodyLength  = 20000
+	MaxTags        = 20
+	MaxTagLength   = 50
+)
+
+// JournalEntry represents a dated note about a garden, optionally about one zone or plant
+type JournalEntry struct {
+	EntryID       string  `json:"entry_id"`
+	GardenID      string  `json:"garden_id"`
+	ZoneID        *string `json:"zone_id,omitempty"`
+	GardenPlantID *string `json:"garden_plant_id,omitempty"`
+	AuthorID      string  `json:"author_id"`
+
+	// Content
+	Title     *string   `json:"title,omitempty"`
+	Body      string    `json:"body"`
+	Tags      []string  `json:"tags"`
+	EntryDate time.Time `json:"entry_date"` // Day the entry is about; defaults to creation day
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate validates the journal entry entity
+func (e *JournalEntry) Validate() error {
+	if e.GardenID == "" {
+		return fmt.Errorf("garden_id is required")
+	}
+
+	if e.AuthorID == "" {
+		return fmt.Errorf("author_id is required")
+	}
+
+	if strings.TrimSpace(e.Body) == "" {
+		return fmt.Errorf("body is required")
+	}
+
+	if len(e.Body) > MaxBodyLength {
+		return fmt.Errorf("body must be %d characters or less", MaxBodyLength)
+	}
+
+	if e.Title != nil && len(*e.Title) > MaxTitleLength {
+		return fmt.Errorf("title must be %d characters or less", MaxTitleLength)
+	}
+
+	if e.EntryDate.IsZero() {
+		return fmt.Errorf("entry_date is required")
+	}
+
+	if len(e.Tags) > MaxTags {
+		return fmt.Errorf("at most %d tags are allowed", MaxTags)
+	}
+
+	for _, tag := range e.Tags {
+		if tag == "" {
+			return fmt.Errorf("tags cannot be empty")
+		}
+		if len(tag) > MaxTagLength {
+			return fmt.Errorf("tag %q must be %d characters or less", tag, MaxTagLength)
+		}
+	}
+
+	return nil
+}
+
+// HasTag returns true if the entry carries the given tag
+func (e *JournalEntry) HasTag(tag string) bool {
+	tag = NormalizeTag(tag)
+	for _, t := range e.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeTag lowercases a tag, trims whitespace and a leading '#'
+func NormalizeTag(tag string) string {
+	tag = strings.TrimSpace(tag)
+	tag = strings.TrimPrefix(tag, "#")
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// NormalizeTags normalizes tags, dropping empties and duplicates while keeping order
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"twigger-backend/backend/journal-service/domain/entity"
+)
+
+// JournalRepository defines the interface for journal entry persistence
+type JournalRepository interface {
+	// CRUD operations
+	Create(ctx context.Context, entry *entity.JournalEntry) error
+	FindByID(ctx context.Context, entryID string) (*entity.JournalEntry, error)
+	Update(ctx context.Context, entry *entity.JournalEntry) error
+	Delete(ctx context.Context, entryID string) error
+
+	// Query operations
+	FindByGardenID(ctx context.Context, gardenID string, filter *JournalFilter) ([]*entity.JournalEntry, error)
+	CountByGardenID(ctx context.Context, gardenID string, filter *JournalFilter) (int, error)
+	FindTagsByGardenID(ctx context.Context, gardenID string) ([]*TagCount, error)
+}
+
+// JournalFilter represents optional criteria for listing journal entries
+type JournalFilter struct {
+	From          *time.Time // Inclusive entry date
+	To            *time.Time // Inclusive entry date
+	Tags          []string   // Entries must carry all tags
+	ZoneID        *string
+	GardenPlantID *string
+	Query         *string // Trigram search over title and body
+
+	// Pagination
+	Limit  int
+	Offset int
+}
+
+// TagCount is a tag and how many entries in a garden use it
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/journal-service/domain/entity"
+	"twigger-backend/backend/journal-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// minSearchLength is the shortest query trigram search can match on
+const minSearchLength = 3
+
+// JournalService defines the business logic for garden journal entries
+type JournalService interface {
+	// CRUD operations
+	CreateEntry(ctx context.Context, entry *entity.JournalEntry) (*entity.JournalEntry, error)
+	GetEntry(ctx context.Context, entryID string) (*entity.JournalEntry, error)
+	UpdateEntry(ctx context.Context, entry *entity.JournalEntry) (*entity.JournalEntry, error)
+	DeleteEntry(ctx context.Context, entryID string) error
+
+	// ListEntries retrieves a garden's entries, newest first, with the total matching count
+	ListEntries(ctx context.Context, gardenID string, filter *repository.JournalFilter) ([]*entity.JournalEntry, int, error)
+
+	// ListTags retrieves the tags used in a garden's journal with usage counts
+	ListTags(ctx context.Context, gardenID string) ([]*repository.TagCount, error)
+}
+
+// journalService implements JournalService
+type journalService struct {
+	journalRepo     repository.JournalRepository
+	zoneRepo        gardenRepository.GardenZoneRepository
+	gardenPlantRepo gardenRepository.GardenPlantRepository
+}
+
+// NewJournalService creates a new journal service instance
+func NewJournalService(
+	journalRepo repository.JournalRepository,
+	zoneRepo gardenRepository.GardenZoneRepository,
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+) JournalService {
+	return &journalService{
+		journalRepo:     journalRepo,
+		zoneRepo:        zoneRepo,
+		gardenPlantRepo: gardenPlantRepo,
+	}
+}
+
+// CreateEntry creates a new journal entry
+func (s *journalService) CreateEntry(ctx context.Context, entry *entity.JournalEntry) (*entity.JournalEntry, error) {
+	if entry == nil {
+		return nil, domainerrors.NewInvalidInputError("entry", "entry cannot be nil")
+	}
+
+	if entry.EntryDate.IsZero() {
+		now := time.Now()
+		entry.EntryDate = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	}
+
+	if err := s.prepareEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := s.journalRepo.Create(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to create journal entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// GetEntry retrieves a journal entry by ID
+func (s *journalService) GetEntry(ctx context.Context, entryID string) (*entity.JournalEntry, error) {
+	if entryID == "" {
+		return nil, domainerrors.NewInvalidInputError("entry_id", "entry ID cannot be empty")
+	}
+
+	entry, err := s.journalRepo.FindByID(ctx, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get journal entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// UpdateEntry updates an existing journal entry
+func (s *journalService) UpdateEntry(ctx context.Context, entry *entity.JournalEntry) (*entity.JournalEntry, error) {
+	if entry == nil || entry.EntryID == "" {
+		return nil, domainerrors.NewInvalidInputError("entry_id", "entry ID cannot be empty")
+	}
+
+	existing, err := s.journalRepo.FindByID(ctx, entry.EntryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get journal entry: %w", err)
+	}
+
+	// Entries cannot move between gardens or change author
+	entry.GardenID = existing.GardenID
+	entry.AuthorID = existing.AuthorID
+	entry.CreatedAt = existing.CreatedAt
+
+	if err := s.prepareEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := s.journalRepo.Update(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to update journal entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// DeleteEntry deletes a journal entry
+func (s *journalService) DeleteEntry(ctx context.Context, entryID string) error {
+	if entryID == "" {
+		return domainerrors.NewInvalidInputError("entry_id", "entry ID cannot be empty")
+	}
+
+	if err := s.journalRepo.Delete(ctx, entryID); err != nil {
+		return fmt.Errorf("failed to delete journal entry: %w", err)
+	}
+
+	return nil
+}
+
+// ListEntries retrieves a garden's entries, newest first, with the total matching count
+func (s *journalService) ListEntries(ctx context.Context, gardenID string, filter *repository.JournalFilter) ([]*entity.JournalEntry, int, error) {
+	if gardenID == "" {
+		return nil, 0, domainerrors.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	if filter == nil {
+		filter = &repository.JournalFilter{}
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 20 // Default limit
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100 // Maximum limit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, 0, domainerrors.NewValidationError("from", "from date must be on or before to date")
+	}
+
+	filter.Tags = entity.NormalizeTags(filter.Tags)
+
+	if filter.Query != nil {
+		query := strings.TrimSpace(*filter.Query)
+		if len([]rune(query)) < minSearchLength {
+			return nil, 0, domainerrors.NewValidationError("q", fmt.Sprintf("search query must be at least %d characters", minSearchLength))
+		}
+		filter.Query = &query
+	}
+
+	entries, err := s.journalRepo.FindByGardenID(ctx, gardenID, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list journal entries: %w", err)
+	}
+
+	total, err := s.journalRepo.CountByGardenID(ctx, gardenID, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count journal entries: %w", err)
+	}
+
+	return entries, total, nil
+}
+
+// ListTags retrieves the tags used in a garden's journal with usage counts
+func (s *journalService) ListTags(ctx context.Context, gardenID string) ([]*repository.TagCount, error) {
+	if gardenID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	tags, err := s.journalRepo.FindTagsByGardenID(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journal tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// prepareEntry normalizes tags, validates the entry and checks its zone and plant links
+func (s *journalService) prepareEntry(ctx context.Context, entry *entity.JournalEntry) error {
+	entry.Tags = entity.NormalizeTags(entry.Tags)
+
+	if err := entry.Validate(); err != nil {
+		return domainerrors.NewValidationError("journal_entry", err.Error())
+	}
+
+	return s.validateLinks(ctx, entry)
+}
+
+// validateLinks ensures the linked zone and plant belong to the entry's garden.
+// A plant link without a zone inherits the plant's zone.
+func (s *journalService) validateLinks(ctx context.Context, entry *entity.JournalEntry) error {
+	if entry.GardenPlantID != nil {
+		gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, *entry.GardenPlantID)
+		if err != nil {
+			return fmt.Errorf("failed to get garden plant: %w", err)
+		}
+		if gardenPlant.GardenID != entry.GardenID {
+			return domainerrors.NewValidationError("garden_plant_id", "garden plant does not belong to this garden")
+		}
+
+		if entry.ZoneID == nil {
+			entry.ZoneID = gardenPlant.ZoneID
+		} else if gardenPlant.ZoneID != nil && *gardenPlant.ZoneID != *entry.ZoneID {
+			return domainerrors.NewValidationError("zone_id", "garden plant is not in this zone")
+		}
+	}
+
+	if entry.ZoneID != nil {
+		zone, err := s.zoneRepo.FindByID(ctx, *entry.ZoneID)
+		if err != nil {
+			return fmt.Errorf("failed to get zone: %w", err)
+		}
+		if zone.GardenID != entry.GardenID {
+			return domainerrors.NewValidationError("zone_id", "zone does not belong to this garden")
+		}
+	}
+
+	return nil
+}
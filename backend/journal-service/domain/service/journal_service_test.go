@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/journal-service/domain/entity"
+	"twigger-backend/backend/journal-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// MockJournalRepository is a mock implementation of repository.JournalRepository
+type MockJournalRepository struct {
+	mock.Mock
+}
+
+func (m *MockJournalRepository) Create(ctx context.Context, entry *entity.JournalEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockJournalRepository) FindByID(ctx context.Context, entryID string) (*entity.JournalEntry, error) {
+	args := m.Called(ctx, entryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.JournalEntry), args.Error(1)
+}
+
+func (m *MockJournalRepository) Update(ctx context.Context, entry *entity.JournalEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockJournalRepository) Delete(ctx context.Context, entryID string) error {
+	args := m.Called(ctx, entryID)
+	return args.Error(0)
+}
+
+func (m *MockJournalRepository) FindByGardenID(ctx context.Context, gardenID string, filter *repository.JournalFilter) ([]*entity.JournalEntry, error) {
+	args := m.Called(ctx, gardenID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.JournalEntry), args.Error(1)
+}
+
+func (m *MockJournalRepository) CountByGardenID(ctx context.Context, gardenID string, filter *repository.JournalFilter) (int, error) {
+	args := m.Called(ctx, gardenID, filter)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockJournalRepository) FindTagsByGardenID(ctx context.Context, gardenID string) ([]*repository.TagCount, error) {
+	args := m.Called(ctx, gardenID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.TagCount), args.Error(1)
+}
+
+func setupJournalService() (*journalService, *MockJournalRepository) {
+	zoneA := "zone-a"
+	mockRepo := new(MockJournalRepository)
+	service := &journalService{
+		journalRepo: mockRepo,
+		zoneRepo: &mocks.StubGardenZoneRepository{Zones: map[string]*gardenEntity.GardenZone{
+			"zone-a":     {ZoneID: "zone-a", GardenID: "garden-123"},
+			"zone-b":     {ZoneID: "zone-b", GardenID: "garden-123"},
+			"zone-other": {ZoneID: "zone-other", GardenID: "garden-456"},
+		}},
+		gardenPlantRepo: &mocks.StubGardenPlantRepository{Plants: []*gardenEntity.GardenPlant{
+			{GardenPlantID: "gp-in-a", GardenID: "garden-123", ZoneID: &zoneA},
+			{GardenPlantID: "gp-other", GardenID: "garden-456"},
+		}},
+	}
+	return service, mockRepo
+}
+
+func TestJournalService_CreateEntry_NormalizesTagsAndDefaultsDate(t *testing.T) {
+	service, mockRepo := setupJournalService()
+	ctx := context.Background()
+
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.JournalEntry")).Return(nil)
+
+	entry := &entity.JournalEntry{
+		GardenID: "garden-123",
+		AuthorID: "user-123",
+		Body:     "First tomatoes are turning red",
+		Tags:     []string{"#Harvest", " tomatoes ", "harvest", ""},
+	}
+
+	result, err := service.CreateEntry(ctx, entry)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"harvest", "tomatoes"}, result.Tags)
+	assert.False(t, result.EntryDate.IsZero())
+	assert.Equal(t, 0, result.EntryDate.Hour())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestJournalService_CreateEntry_PlantLinkInheritsZone(t *testing.T) {
+	service, mockRepo := setupJournalService()
+	ctx := context.Background()
+
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.JournalEntry")).Return(nil)
+
+	entry := &entity.JournalEntry{
+		GardenID:      "garden-123",
+		AuthorID:      "user-123",
+		GardenPlantID: mocks.StrPtr("gp-in-a"),
+		Body:          "Aphids on the new growth",
+	}
+
+	result, err := service.CreateEntry(ctx, entry)
+
+	require.NoError(t, err)
+	require.NotNil(t, result.ZoneID)
+	assert.Equal(t, "zone-a", *result.ZoneID)
+}
+
+func TestJournalService_CreateEntry_InvalidLinks(t *testing.T) {
+	tests := []struct {
+		name          string
+		zoneID        *string
+		gardenPlantID *string
+		wantErr       string
+	}{
+		{name: "zone in another garden", zoneID: mocks.StrPtr("zone-other"), wantErr: "zone does not belong"},
+		{name: "plant in another garden", gardenPlantID: mocks.StrPtr("gp-other"), wantErr: "garden plant does not belong"},
+		{name: "plant outside zone", zoneID: mocks.StrPtr("zone-b"), gardenPlantID: mocks.StrPtr("gp-in-a"), wantErr: "not in this zone"},
+		{name: "missing zone", zoneID: mocks.StrPtr("zone-missing"), wantErr: "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupJournalService()
+
+			entry := &entity.JournalEntry{
+				GardenID:      "garden-123",
+				AuthorID:      "user-123",
+				ZoneID:        tt.zoneID,
+				GardenPlantID: tt.gardenPlantID,
+				Body:          "Note",
+			}
+
+			_, err := service.CreateEntry(context.Background(), entry)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestJournalService_CreateEntry_ValidationError(t *testing.T) {
+	service, mockRepo := setupJournalService()
+
+	entry := &entity.JournalEntry{
+		GardenID: "garden-123",
+		AuthorID: "user-123",
+		Body:     "   ",
+	}
+
+	_, err := service.CreateEntry(context.Background(), entry)
+
+	assert.Error(t, err)
+	assert.IsType(t, &domainerrors.ValidationError{}, err)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestJournalService_UpdateEntry_KeepsGardenAndAuthor(t *testing.T) {
+	service, mockRepo := setupJournalService()
+	ctx := context.Background()
+
+	existing := &entity.JournalEntry{
+		EntryID:   "entry-123",
+		GardenID:  "garden-123",
+		AuthorID:  "user-123",
+		Body:      "Original",
+		EntryDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+	mockRepo.On("FindByID", ctx, "entry-123").Return(existing, nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.JournalEntry")).Return(nil)
+
+	update := &entity.JournalEntry{
+		EntryID:   "entry-123",
+		GardenID:  "garden-456",
+		AuthorID:  "user-456",
+		Body:      "Edited",
+		EntryDate: existing.EntryDate,
+	}
+
+	result, err := service.UpdateEntry(ctx, update)
+
+	require.NoError(t, err)
+	assert.Equal(t, "garden-123", result.GardenID)
+	assert.Equal(t, "user-123", result.AuthorID)
+	assert.Equal(t, "Edited", result.Body)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestJournalService_ListEntries(t *testing.T) {
+	service, mockRepo := setupJournalService()
+	ctx := context.Background()
+
+	entries := []*entity.JournalEntry{{EntryID: "entry-123", GardenID: "garden-123"}}
+	matchFilter := mock.MatchedBy(func(f *repository.JournalFilter) bool {
+		return f.Limit == 20 && len(f.Tags) == 1 && f.Tags[0] == "pests" && *f.Query == "aphid"
+	})
+	mockRepo.On("FindByGardenID", ctx, "garden-123", matchFilter).Return(entries, nil)
+	mockRepo.On("CountByGardenID", ctx, "garden-123", matchFilter).Return(1, nil)
+
+	query := "  aphid "
+	result, total, err := service.ListEntries(ctx, "garden-123", &repository.JournalFilter{
+		Tags:  []string{"#Pests"},
+		Query: &query,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, 1, total)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestJournalService_ListEntries_InvalidFilter(t *testing.T) {
+	from := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	shortQuery := "ab"
+
+	tests := []struct {
+		name   string
+		filter *repository.JournalFilter
+	}{
+		{name: "reversed date range", filter: &repository.JournalFilter{From: &from, To: &to}},
+		{name: "short search query", filter: &repository.JournalFilter{Query: &shortQuery}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupJournalService()
+
+			_, _, err := service.ListEntries(context.Background(), "garden-123", tt.filter)
+
+			assert.Error(t, err)
+			assert.IsType(t, &domainerrors.ValidationError{}, err)
+			mockRepo.AssertNotCalled(t, "FindByGardenID", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
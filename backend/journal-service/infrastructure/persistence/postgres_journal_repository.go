@@ -0,0 +1,347 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"twigger-backend/backend/journal-service/domain/entity"
+	"twigger-backend/backend/journal-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// PostgresJournalRepository implements repository.JournalRepository using PostgreSQL
+type PostgresJournalRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresJournalRepository creates a new PostgreSQL journal repository
+func NewPostgresJournalRepository(db *sql.DB) repository.JournalRepository {
+	return &PostgresJournalRepository{db: db}
+}
+
+const journalColumns = `
+	entry_id, garden_id, zone_id, garden_plant_id, author_id,
+	title, body, tags, entry_date, created_at, updated_at
+`
+
+// Create creates a new journal entry
+func (r *PostgresJournalRepository) Create(ctx context.Context, entry *entity.JournalEntry) error {
+	if err := entry.Validate(); err != nil {
+		return domainerrors.NewValidationError("journal_entry", err.Error())
+	}
+
+	if entry.EntryID == "" {
+		entry.EntryID = uuid.New().String()
+	}
+
+	now := time.Now()
+	entry.CreatedAt = now
+	entry.UpdatedAt = now
+
+	query := `
+		INSERT INTO garden_journal_entries (
+			entry_id, garden_id, zone_id, garden_plant_id, author_id,
+			title, body, tags, entry_date, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		entry.EntryID,
+		entry.GardenID,
+		entry.ZoneID,
+		entry.GardenPlantID,
+		entry.AuthorID,
+		entry.Title,
+		entry.Body,
+		pq.Array(entry.Tags),
+		entry.EntryDate,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		return domainerrors.NewDatabaseError("journal_entry_create", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a journal entry by ID
+func (r *PostgresJournalRepository) FindByID(ctx context.Context, entryID string) (*entity.JournalEntry, error) {
+	query := `SELECT ` + journalColumns + ` FROM garden_journal_entries WHERE entry_id = $1`
+
+	entry, err := scanJournalEntry(r.db.QueryRowContext(ctx, query, entryID))
+	if err == sql.ErrNoRows {
+		return nil, domainerrors.NewNotFoundError("journal_entry", entryID)
+	}
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("journal_entry_find_by_id", err)
+	}
+
+	return entry, nil
+}
+
+// Update updates a journal entry's content and links
+func (r *PostgresJournalRepository) Update(ctx context.Context, entry *entity.JournalEntry) error {
+	if err := entry.Validate(); err != nil {
+		return domainerrors.NewValidationError("journal_entry", err.Error())
+	}
+
+	entry.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE garden_journal_entries SET
+			zone_id = $2,
+			garden_plant_id = $3,
+			title = $4,
+			body = $5,
+			tags = $6,
+			entry_date = $7,
+			updated_at = $8
+		WHERE entry_id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		entry.EntryID,
+		entry.ZoneID,
+		entry.GardenPlantID,
+		entry.Title,
+		entry.Body,
+		pq.Array(entry.Tags),
+		entry.EntryDate,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		return domainerrors.NewDatabaseError("journal_entry_update", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainerrors.NewDatabaseError("journal_entry_update_rows_affected", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainerrors.NewNotFoundError("journal_entry", entry.EntryID)
+	}
+
+	return nil
+}
+
+// Delete deletes a journal entry
+func (r *PostgresJournalRepository) Delete(ctx context.Context, entryID string) error {
+	query := `DELETE FROM garden_journal_entries WHERE entry_id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, entryID)
+	if err != nil {
+		return domainerrors.NewDatabaseError("journal_entry_delete", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainerrors.NewDatabaseError("journal_entry_delete_rows_affected", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainerrors.NewNotFoundError("journal_entry", entryID)
+	}
+
+	return nil
+}
+
+// FindByGardenID finds a garden's journal entries, newest first.
+// Search results are ordered by trigram similarity instead.
+func (r *PostgresJournalRepository) FindByGardenID(ctx context.Context, gardenID string, filter *repository.JournalFilter) ([]*entity.JournalEntry, error) {
+	if filter == nil {
+		filter = &repository.JournalFilter{}
+	}
+
+	whereSQL, args, queryPos := buildJournalWhere(gardenID, filter)
+	argPos := len(args) + 1
+
+	orderBySQL := "ORDER BY entry_date DESC, created_at DESC"
+	if queryPos > 0 {
+		orderBySQL = fmt.Sprintf(
+			"ORDER BY GREATEST(word_similarity($%d, body), word_similarity($%d, COALESCE(title, ''))) DESC, entry_date DESC",
+			queryPos, queryPos)
+	}
+
+	query := `SELECT ` + journalColumns + ` FROM garden_journal_entries ` + whereSQL + ` ` + orderBySQL
+
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argPos, argPos+1)
+		args = append(args, filter.Limit, filter.Offset)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("journal_entry_find_by_garden", err)
+	}
+	defer rows.Close()
+
+	entries := []*entity.JournalEntry{}
+	for rows.Next() {
+		entry, err := scanJournalEntry(rows)
+		if err != nil {
+			return nil, domainerrors.NewDatabaseError("journal_entry_scan", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domainerrors.NewDatabaseError("journal_entry_rows_iteration", err)
+	}
+
+	return entries, nil
+}
+
+// CountByGardenID counts a garden's journal entries matching the filter, ignoring pagination
+func (r *PostgresJournalRepository) CountByGardenID(ctx context.Context, gardenID string, filter *repository.JournalFilter) (int, error) {
+	if filter == nil {
+		filter = &repository.JournalFilter{}
+	}
+
+	whereSQL, args, _ := buildJournalWhere(gardenID, filter)
+	query := `SELECT COUNT(*) FROM garden_journal_entries ` + whereSQL
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, domainerrors.NewDatabaseError("journal_entry_count", err)
+	}
+
+	return count, nil
+}
+
+// FindTagsByGardenID lists the tags used in a garden's journal, most used first
+func (r *PostgresJournalRepository) FindTagsByGardenID(ctx context.Context, gardenID string) ([]*repository.TagCount, error) {
+	query := `
+		SELECT tag, COUNT(*) AS entry_count
+		FROM garden_journal_entries, unnest(tags) AS tag
+		WHERE garden_id = $1
+		GROUP BY tag
+		ORDER BY entry_count DESC, tag ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, gardenID)
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("journal_tags_find_by_garden", err)
+	}
+	defer rows.Close()
+
+	tags := []*repository.TagCount{}
+	for rows.Next() {
+		var tag repository.TagCount
+		if err := rows.Scan(&tag.Tag, &tag.Count); err != nil {
+			return nil, domainerrors.NewDatabaseError("journal_tags_scan", err)
+		}
+		tags = append(tags, &tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domainerrors.NewDatabaseError("journal_tags_rows_iteration", err)
+	}
+
+	return tags, nil
+}
+
+// buildJournalWhere builds the WHERE clause for a journal filter.
+// Returns the clause, its arguments and the position of the search query argument (0 if none).
+func buildJournalWhere(gardenID string, filter *repository.JournalFilter) (string, []interface{}, int) {
+	whereClauses := []string{"garden_id = $1"}
+	args := []interface{}{gardenID}
+	argPos := 2
+	queryPos := 0
+
+	if filter.From != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("entry_date >= $%d", argPos))
+		args = append(args, *filter.From)
+		argPos++
+	}
+	if filter.To != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("entry_date <= $%d", argPos))
+		args = append(args, *filter.To)
+		argPos++
+	}
+	if len(filter.Tags) > 0 {
+		// Uses the GIN index on tags
+		whereClauses = append(whereClauses, fmt.Sprintf("tags @> $%d", argPos))
+		args = append(args, pq.Array(filter.Tags))
+		argPos++
+	}
+	if filter.ZoneID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("zone_id = $%d", argPos))
+		args = append(args, *filter.ZoneID)
+		argPos++
+	}
+	if filter.GardenPlantID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("garden_plant_id = $%d", argPos))
+		args = append(args, *filter.GardenPlantID)
+		argPos++
+	}
+	if filter.Query != nil && *filter.Query != "" {
+		// ILIKE with the pg_trgm GIN indexes (see migration 006)
+		whereClauses = append(whereClauses, fmt.Sprintf(
+			"(body ILIKE '%%' || $%d || '%%' OR title ILIKE '%%' || $%d || '%%')", argPos, argPos))
+		args = append(args, escapeLikePattern(*filter.Query))
+		queryPos = argPos
+	}
+
+	return "WHERE " + strings.Join(whereClauses, " AND "), args, queryPos
+}
+
+// escapeLikePattern escapes LIKE wildcards so user input matches literally
+func escapeLikePattern(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(s)
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanJournalEntry scans a single journal entry row, mapping nullable columns
+func scanJournalEntry(row rowScanner) (*entity.JournalEntry, error) {
+	var entry entity.JournalEntry
+	var zoneID, gardenPlantID, title sql.NullString
+	var tags pq.StringArray
+
+	err := row.Scan(
+		&entry.EntryID,
+		&entry.GardenID,
+		&zoneID,
+		&gardenPlantID,
+		&entry.AuthorID,
+		&title,
+		&entry.Body,
+		&tags,
+		&entry.EntryDate,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map nullable fields
+	if zoneID.Valid {
+		entry.ZoneID = &zoneID.String
+	}
+	if gardenPlantID.Valid {
+		entry.GardenPlantID = &gardenPlantID.String
+	}
+	if title.Valid {
+		entry.Title = &title.String
+	}
+	entry.Tags = []string(tags)
+	if entry.Tags == nil {
+		entry.Tags = []string{}
+	}
+
+	return &entry, nil
+}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// OwnerType identifies what a photo is attached to
+type OwnerType string
+
+const (
+	OwnerJournalEntry OwnerType = "journal_entry"
+	OwnerGardenPlant  OwnerType = "garden_plant"
+	OwnerPlantProblem OwnerType = "plant_problem"
+)
+
+// Allowed image content types
+const (
+	ContentTypeJPEG = "image/jpeg"
+	ContentTypePNG  = "image/png"
+)
+
+// Photo holds the metadata of an uploaded image; the bytes live in blob storage
+type Photo struct {
+	PhotoID    string    `json:"photo_id"`
+	OwnerType  OwnerType `json:"owner_type"`
+	OwnerID    string    `json:"owner_id"`
+	GardenID   *string   `json:"garden_id,omitempty"` // NULL for plant problem photos
+	UploadedBy string    `json:"uploaded_by"`
+
+	// Blob storage
+	StorageKey   string `json:"-"`
+	ThumbnailKey string `json:"-"`
+
+	// Image metadata
+	ContentType      string  `json:"content_type"`
+	SizeBytes        int64   `json:"size_bytes"`
+	Width            int     `json:"width"`
+	Height           int     `json:"height"`
+	OriginalFilename *string `json:"original_filename,omitempty"`
+	Caption          *string `json:"caption,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Validate validates the photo entity
+func (p *Photo) Validate() error {
+	if !p.OwnerType.IsValid() {
+		return fmt.Errorf("invalid owner_type: must be journal_entry, garden_plant, or plant_problem")
+	}
+
+	if p.OwnerID == "" {
+		return fmt.Errorf("owner_id is required")
+	}
+
+	if p.OwnerType != OwnerPlantProblem && p.GardenID == nil {
+		return fmt.Errorf("garden_id is required for %s photos", p.OwnerType)
+	}
+
+	if p.UploadedBy == "" {
+		return fmt.Errorf("uploaded_by is required")
+	}
+
+	if p.StorageKey == "" || p.ThumbnailKey == "" {
+		return fmt.Errorf("storage keys are required")
+	}
+
+	if !IsAllowedContentType(p.ContentType) {
+		return fmt.Errorf("unsupported content_type: %s", p.ContentType)
+	}
+
+	if p.SizeBytes <= 0 {
+		return fmt.Errorf("size_bytes must be positive")
+	}
+
+	if p.Width <= 0 || p.Height <= 0 {
+		return fmt.Errorf("width and height must be positive")
+	}
+
+	if p.Caption != nil && len(*p.Caption) > 500 {
+		return fmt.Errorf("caption must be 500 characters or less")
+	}
+
+	return nil
+}
+
+// IsValid checks if the owner type is valid
+func (ot OwnerType) IsValid() bool {
+	switch ot {
+	case OwnerJournalEntry, OwnerGardenPlant, OwnerPlantProblem:
+		return true
+	}
+	return false
+}
+
+// IsAllowedContentType returns true for image types that can be uploaded
+func IsAllowedContentType(contentType string) bool {
+	return contentType == ContentTypeJPEG || contentType == ContentTypePNG
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"twigger-backend/backend/photo-service/domain/entity"
+)
+
+// PhotoRepository defines the interface for photo metadata persistence
+type PhotoRepository interface {
+	// CRUD operations
+	Create(ctx context.Context, photo *entity.Photo) error
+	FindByID(ctx context.Context, photoID string) (*entity.Photo, error)
+	Delete(ctx context.Context, photoID string) error
+
+	// Query operations
+	FindByOwner(ctx context.Context, ownerType entity.OwnerType, ownerID string) ([]*entity.Photo, error)
+	CountByOwner(ctx context.Context, ownerType entity.OwnerType, ownerID string) (int, error)
+
+	// FindOrphaned finds photos whose owner or uploader has been deleted, loading only
+	// the ID and storage keys
+	FindOrphaned(ctx context.Context, limit int) ([]*entity.Photo, error)
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png" // register PNG decoder
+	"net/http"
+
+	"twigger-backend/backend/photo-service/domain/entity"
+)
+
+// Thumbnail settings
+const (
+	thumbnailMaxDimension = 320
+	thumbnailJPEGQuality  = 80
+)
+
+// JPEG markers
+const (
+	markerSOI   = 0xD8
+	markerSOS   = 0xDA
+	markerAPP1  = 0xE1
+	markerAPP13 = 0xED // Photoshop resources, including IPTC captions and locations
+	markerCOM   = 0xFE
+)
+
+// EXIF tags
+const (
+	tagGPSInfoIFD = 0x8825
+)
+
+var (
+	errMalformedJPEG = errors.New("malformed JPEG")
+	errMalformedPNG  = errors.New("malformed PNG")
+	errMalformedEXIF = errors.New("malformed EXIF")
+
+	exifHeader = []byte("Exif\x00\x00")
+	pngHeader  = []byte("\x89PNG\r\n\x1a\n")
+
+	// pngMetadataChunks are the PNG chunks that carry free-form metadata: EXIF, and text
+	// chunks that hold XMP packets, comments or other descriptive data
+	pngMetadataChunks = map[string]bool{
+		"eXIf": true,
+		"tEXt": true,
+		"zTXt": true,
+		"iTXt": true,
+	}
+)
+
+// detectContentType sniffs the image type from its bytes rather than trusting the client
+func detectContentType(data []byte) string {
+	return http.DetectContentType(data)
+}
+
+// stripLocationMetadata removes metadata that can reveal where an image was taken
+func stripLocationMetadata(data []byte, contentType string) ([]byte, error) {
+	switch contentType {
+	case entity.ContentTypeJPEG:
+		return stripJPEGLocation(data)
+	case entity.ContentTypePNG:
+		return stripPNGMetadata(data)
+	}
+	return data, nil
+}
+
+// stripJPEGLocation clears the EXIF GPS IFD and drops every other metadata segment that
+// can carry a location: XMP and other non-EXIF APP1 packets, APP13 (IPTC) and comments.
+// Other EXIF data (orientation, camera) is kept. An EXIF block that cannot be parsed is
+// dropped entirely rather than risk leaking a location.
+func stripJPEGLocation(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != markerSOI {
+		return nil, errMalformedJPEG
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(data[:2])
+
+	pos := 2
+	for pos < len(data) {
+		if data[pos] != 0xFF || pos+1 >= len(data) {
+			return nil, errMalformedJPEG
+		}
+		marker := data[pos+1]
+
+		// Fill bytes between segments
+		if marker == 0xFF {
+			pos++
+			continue
+		}
+
+		// Entropy-coded image data follows the start of scan; copy the rest verbatim
+		if marker == markerSOS {
+			out.Write(data[pos:])
+			return out.Bytes(), nil
+		}
+
+		if pos+4 > len(data) {
+			return nil, errMalformedJPEG
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, errMalformedJPEG
+		}
+		segment := data[pos:end]
+		payload := segment[4:]
+
+		switch marker {
+		case markerAPP1:
+			if !bytes.HasPrefix(payload, exifHeader) {
+				pos = end
+				continue // XMP (standard and extended) or unknown packets
+			}
+			cleaned := make([]byte, len(segment))
+			copy(cleaned, segment)
+			if err := clearGPSIFD(cleaned[4+len(exifHeader):]); err != nil {
+				pos = end
+				continue // drop the whole EXIF block
+			}
+			segment = cleaned
+		case markerAPP13, markerCOM:
+			pos = end
+			continue
+		}
+
+		out.Write(segment)
+		pos = end
+	}
+
+	return nil, errMalformedJPEG
+}
+
+// clearGPSIFD zeroes the GPS IFD of a TIFF/EXIF block in place.
+// The block keeps its size so no other offsets need rewriting.
+func clearGPSIFD(tiff []byte) error {
+	if len(tiff) < 8 {
+		return errMalformedEXIF
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return errMalformedEXIF
+	}
+
+	ifd0 := int(order.Uint32(tiff[4:8]))
+	if ifd0+2 > len(tiff) {
+		return errMalformedEXIF
+	}
+
+	count := int(order.Uint16(tiff[ifd0 : ifd0+2]))
+	if ifd0+2+count*12 > len(tiff) {
+		return errMalformedEXIF
+	}
+
+	for i := 0; i < count; i++ {
+		entry := tiff[ifd0+2+i*12 : ifd0+2+(i+1)*12]
+		if order.Uint16(entry[0:2]) != tagGPSInfoIFD {
+			continue
+		}
+
+		gps := int(order.Uint32(entry[8:12]))
+		if gps+2 > len(tiff) {
+			return errMalformedEXIF
+		}
+
+		gpsCount := int(order.Uint16(tiff[gps : gps+2]))
+		entriesEnd := gps + 2 + gpsCount*12
+		if entriesEnd > len(tiff) {
+			return errMalformedEXIF
+		}
+
+		// Zero out-of-line values (e.g. latitude rationals) before the entries that point at them
+		for j := 0; j < gpsCount; j++ {
+			gpsEntry := tiff[gps+2+j*12 : gps+2+(j+1)*12]
+			size := tiffTypeSize(order.Uint16(gpsEntry[2:4])) * int(order.Uint32(gpsEntry[4:8]))
+			if size <= 4 {
+				continue
+			}
+			offset := int(order.Uint32(gpsEntry[8:12]))
+			if offset+size > len(tiff) {
+				return errMalformedEXIF
+			}
+			clear(tiff[offset : offset+size])
+		}
+
+		// An empty IFD: zero entries and no next IFD
+		clear(tiff[gps:entriesEnd])
+		if entriesEnd+4 <= len(tiff) {
+			clear(tiff[entriesEnd : entriesEnd+4])
+		}
+	}
+
+	return nil
+}
+
+// tiffTypeSize returns the byte size of one value of a TIFF field type
+func tiffTypeSize(fieldType uint16) int {
+	switch fieldType {
+	case 1, 2, 6, 7: // BYTE, ASCII, SBYTE, UNDEFINED
+		return 1
+	case 3, 8: // SHORT, SSHORT
+		return 2
+	case 4, 9, 11: // LONG, SLONG, FLOAT
+		return 4
+	case 5, 10, 12: // RATIONAL, SRATIONAL, DOUBLE
+		return 8
+	}
+	return 0
+}
+
+// stripPNGMetadata drops the EXIF and text chunks from a PNG, keeping the chunks
+// needed to render it
+func stripPNGMetadata(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, pngHeader) {
+		return nil, errMalformedPNG
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(pngHeader)
+
+	pos := len(pngHeader)
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return nil, errMalformedPNG
+		}
+		length := int(binary.BigEndian.Uint32(data[pos : pos+4]))
+		chunkType := string(data[pos+4 : pos+8])
+		end := pos + 12 + length // length + type + data + CRC
+		if end > len(data) {
+			return nil, errMalformedPNG
+		}
+
+		if !pngMetadataChunks[chunkType] {
+			out.Write(data[pos:end])
+		}
+
+		pos = end
+		if chunkType == "IEND" {
+			break
+		}
+	}
+
+	return out.Bytes(), nil
+}
+
+// makeThumbnail scales an image to fit within thumbnailMaxDimension and encodes it as JPEG.
+// Transparent areas are flattened onto white.
+func makeThumbnail(img image.Image) ([]byte, error) {
+	thumb := resizeToFit(img, thumbnailMaxDimension)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: thumbnailJPEGQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resizeToFit downscales by box averaging so the longest side is at most maxDim.
+// Smaller images are copied at their original size.
+func resizeToFit(src image.Image, maxDim int) *image.RGBA {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	dstW, dstH := srcW, srcH
+	if srcW > maxDim || srcH > maxDim {
+		if srcW >= srcH {
+			dstW = maxDim
+			dstH = max(1, srcH*maxDim/srcW)
+		} else {
+			dstH = maxDim
+			dstW = max(1, srcW*maxDim/srcH)
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0 := bounds.Min.Y + y*srcH/dstH
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcH/dstH)
+		for x := 0; x < dstW; x++ {
+			x0 := bounds.Min.X + x*srcW/dstW
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcW/dstW)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+
+			// Premultiplied average composited over white
+			white := 0xFFFF - a/n
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8((r/n + white) >> 8),
+				G: uint8((g/n + white) >> 8),
+				B: uint8((b/n + white) >> 8),
+				A: 0xFF,
+			})
+		}
+	}
+
+	return dst
+}
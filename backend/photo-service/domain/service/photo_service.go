@@ -0,0 +1,319 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/google/uuid"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	journalRepository "twigger-backend/backend/journal-service/domain/repository"
+	"twigger-backend/backend/photo-service/domain/entity"
+	"twigger-backend/backend/photo-service/domain/repository"
+	"twigger-backend/backend/photo-service/domain/storage"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// Upload limits
+const (
+	MaxPhotoBytes     = 10 << 20   // 10 MB
+	maxPhotoPixels    = 40_000_000 // guards against decompression bombs
+	maxPhotosPerOwner = 50
+)
+
+// PhotoService defines the business logic for photo attachments
+type PhotoService interface {
+	// ResolveOwnerGarden checks the owner exists and returns its garden (nil for plant problems)
+	ResolveOwnerGarden(ctx context.Context, ownerType entity.OwnerType, ownerID string) (*string, error)
+
+	// UploadPhoto validates, cleans and stores an image with a thumbnail
+	UploadPhoto(ctx context.Context, input *UploadPhotoInput) (*entity.Photo, error)
+
+	// GetPhoto retrieves photo metadata by ID
+	GetPhoto(ctx context.Context, photoID string) (*entity.Photo, error)
+
+	// ListPhotos retrieves the photos attached to an owner, newest first
+	ListPhotos(ctx context.Context, ownerType entity.OwnerType, ownerID string) ([]*entity.Photo, error)
+
+	// OpenPhoto opens the stored image or its thumbnail. Callers must close the reader.
+	OpenPhoto(ctx context.Context, photo *entity.Photo, thumbnail bool) (io.ReadCloser, error)
+
+	// DeletePhoto removes a photo's metadata and stored images
+	DeletePhoto(ctx context.Context, photoID string) error
+
+	// SweepOrphanedPhotos removes up to limit photos whose owner or uploader has been
+	// deleted, with their stored images, and returns how many were removed
+	SweepOrphanedPhotos(ctx context.Context, limit int) (int, error)
+}
+
+// UploadPhotoInput holds an uploaded image and what it is attached to
+type UploadPhotoInput struct {
+	OwnerType        entity.OwnerType
+	OwnerID          string
+	UploadedBy       string
+	OriginalFilename *string
+	Caption          *string
+	Data             []byte
+}
+
+// photoService implements PhotoService
+type photoService struct {
+	photoRepo       repository.PhotoRepository
+	blobs           storage.BlobStorage
+	journalRepo     journalRepository.JournalRepository
+	gardenPlantRepo gardenRepository.GardenPlantRepository
+	problemRepo     plantRepository.PlantProblemRepository
+}
+
+// NewPhotoService creates a new photo service instance
+func NewPhotoService(
+	photoRepo repository.PhotoRepository,
+	blobs storage.BlobStorage,
+	journalRepo journalRepository.JournalRepository,
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+	problemRepo plantRepository.PlantProblemRepository,
+) PhotoService {
+	return &photoService{
+		photoRepo:       photoRepo,
+		blobs:           blobs,
+		journalRepo:     journalRepo,
+		gardenPlantRepo: gardenPlantRepo,
+		problemRepo:     problemRepo,
+	}
+}
+
+// ResolveOwnerGarden checks the owner exists and returns its garden (nil for plant problems)
+func (s *photoService) ResolveOwnerGarden(ctx context.Context, ownerType entity.OwnerType, ownerID string) (*string, error) {
+	if ownerID == "" {
+		return nil, domainerrors.NewInvalidInputError("owner_id", "owner ID cannot be empty")
+	}
+
+	switch ownerType {
+	case entity.OwnerJournalEntry:
+		entry, err := s.journalRepo.FindByID(ctx, ownerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get journal entry: %w", err)
+		}
+		return &entry.GardenID, nil
+
+	case entity.OwnerGardenPlant:
+		gardenPlant, err := s.gardenPlantRepo.FindByID(ctx, ownerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get garden plant: %w", err)
+		}
+		return &gardenPlant.GardenID, nil
+
+	case entity.OwnerPlantProblem:
+		if _, err := s.problemRepo.FindByID(ctx, ownerID); err != nil {
+			return nil, fmt.Errorf("failed to get plant problem: %w", err)
+		}
+		return nil, nil
+	}
+
+	return nil, domainerrors.NewInvalidInputError("owner_type", "owner type must be journal_entry, garden_plant, or plant_problem")
+}
+
+// UploadPhoto validates, cleans and stores an image with a thumbnail
+func (s *photoService) UploadPhoto(ctx context.Context, input *UploadPhotoInput) (*entity.Photo, error) {
+	if input == nil {
+		return nil, domainerrors.NewInvalidInputError("photo", "upload cannot be nil")
+	}
+
+	if len(input.Data) == 0 {
+		return nil, domainerrors.NewValidationError("file", "file is empty")
+	}
+	if len(input.Data) > MaxPhotoBytes {
+		return nil, domainerrors.NewValidationError("file", fmt.Sprintf("file must be %d MB or smaller", MaxPhotoBytes>>20))
+	}
+
+	contentType := detectContentType(input.Data)
+	if !entity.IsAllowedContentType(contentType) {
+		return nil, domainerrors.NewValidationError("file", fmt.Sprintf("unsupported image type %s: must be JPEG or PNG", contentType))
+	}
+
+	gardenID, err := s.ResolveOwnerGarden(ctx, input.OwnerType, input.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.photoRepo.CountByOwner(ctx, input.OwnerType, input.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count photos: %w", err)
+	}
+	if count >= maxPhotosPerOwner {
+		return nil, domainerrors.NewValidationError("file", fmt.Sprintf("at most %d photos can be attached", maxPhotosPerOwner))
+	}
+
+	// Check dimensions before decoding the full image
+	config, _, err := image.DecodeConfig(bytes.NewReader(input.Data))
+	if err != nil {
+		return nil, domainerrors.NewValidationError("file", "file is not a valid image")
+	}
+	if config.Width*config.Height > maxPhotoPixels {
+		return nil, domainerrors.NewValidationError("file", "image dimensions are too large")
+	}
+
+	cleaned, err := stripLocationMetadata(input.Data, contentType)
+	if err != nil {
+		return nil, domainerrors.NewValidationError("file", "file is not a valid image")
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(cleaned))
+	if err != nil {
+		return nil, domainerrors.NewValidationError("file", "file is not a valid image")
+	}
+
+	thumbnail, err := makeThumbnail(img)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create thumbnail: %w", err)
+	}
+
+	photoID := uuid.New().String()
+	photo := &entity.Photo{
+		PhotoID:          photoID,
+		OwnerType:        input.OwnerType,
+		OwnerID:          input.OwnerID,
+		GardenID:         gardenID,
+		UploadedBy:       input.UploadedBy,
+		StorageKey:       photoStorageKey(input.OwnerType, input.OwnerID, photoID, contentType),
+		ThumbnailKey:     photoStorageKey(input.OwnerType, input.OwnerID, photoID+"_thumb", entity.ContentTypeJPEG),
+		ContentType:      contentType,
+		SizeBytes:        int64(len(cleaned)),
+		Width:            config.Width,
+		Height:           config.Height,
+		OriginalFilename: input.OriginalFilename,
+		Caption:          input.Caption,
+	}
+
+	if err := photo.Validate(); err != nil {
+		return nil, domainerrors.NewValidationError("photo", err.Error())
+	}
+
+	if err := s.blobs.Put(ctx, photo.StorageKey, bytes.NewReader(cleaned), contentType); err != nil {
+		return nil, fmt.Errorf("failed to store photo: %w", err)
+	}
+	if err := s.blobs.Put(ctx, photo.ThumbnailKey, bytes.NewReader(thumbnail), entity.ContentTypeJPEG); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to store thumbnail: %w", err), s.deleteBlobs(ctx, photo))
+	}
+
+	if err := s.photoRepo.Create(ctx, photo); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to save photo: %w", err), s.deleteBlobs(ctx, photo))
+	}
+
+	return photo, nil
+}
+
+// GetPhoto retrieves photo metadata by ID
+func (s *photoService) GetPhoto(ctx context.Context, photoID string) (*entity.Photo, error) {
+	if photoID == "" {
+		return nil, domainerrors.NewInvalidInputError("photo_id", "photo ID cannot be empty")
+	}
+
+	photo, err := s.photoRepo.FindByID(ctx, photoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get photo: %w", err)
+	}
+
+	return photo, nil
+}
+
+// ListPhotos retrieves the photos attached to an owner, newest first
+func (s *photoService) ListPhotos(ctx context.Context, ownerType entity.OwnerType, ownerID string) ([]*entity.Photo, error) {
+	if !ownerType.IsValid() {
+		return nil, domainerrors.NewInvalidInputError("owner_type", "owner type must be journal_entry, garden_plant, or plant_problem")
+	}
+	if ownerID == "" {
+		return nil, domainerrors.NewInvalidInputError("owner_id", "owner ID cannot be empty")
+	}
+
+	photos, err := s.photoRepo.FindByOwner(ctx, ownerType, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photos: %w", err)
+	}
+
+	return photos, nil
+}
+
+// OpenPhoto opens the stored image or its thumbnail. Callers must close the reader.
+func (s *photoService) OpenPhoto(ctx context.Context, photo *entity.Photo, thumbnail bool) (io.ReadCloser, error) {
+	key := photo.StorageKey
+	if thumbnail {
+		key = photo.ThumbnailKey
+	}
+
+	reader, err := s.blobs.Get(ctx, key)
+	if err == storage.ErrObjectNotFound {
+		return nil, domainerrors.NewNotFoundError("photo_content", photo.PhotoID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open photo: %w", err)
+	}
+
+	return reader, nil
+}
+
+// DeletePhoto removes a photo's metadata and stored images
+func (s *photoService) DeletePhoto(ctx context.Context, photoID string) error {
+	photo, err := s.GetPhoto(ctx, photoID)
+	if err != nil {
+		return err
+	}
+
+	// Images go first so a storage failure keeps the record and the delete can be retried;
+	// deleting an already missing image is not an error
+	if err := s.deleteBlobs(ctx, photo); err != nil {
+		return err
+	}
+
+	if err := s.photoRepo.Delete(ctx, photoID); err != nil {
+		return fmt.Errorf("failed to delete photo: %w", err)
+	}
+
+	return nil
+}
+
+// SweepOrphanedPhotos removes up to limit photos whose owner or uploader has been
+// deleted, with their stored images, and returns how many were removed
+func (s *photoService) SweepOrphanedPhotos(ctx context.Context, limit int) (int, error) {
+	photos, err := s.photoRepo.FindOrphaned(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find orphaned photos: %w", err)
+	}
+
+	removed := 0
+	for _, photo := range photos {
+		// As in DeletePhoto, the record is kept until its images are gone
+		if err := s.deleteBlobs(ctx, photo); err != nil {
+			return removed, err
+		}
+		if err := s.photoRepo.Delete(ctx, photo.PhotoID); err != nil {
+			return removed, fmt.Errorf("failed to delete orphaned photo: %w", err)
+		}
+		removed++
+	}
+
+	return removed, nil
+}
+
+// deleteBlobs removes a photo's stored images
+func (s *photoService) deleteBlobs(ctx context.Context, photo *entity.Photo) error {
+	for _, key := range []string{photo.StorageKey, photo.ThumbnailKey} {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete photo object %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// photoStorageKey builds the blob key for a photo, grouped by owner
+func photoStorageKey(ownerType entity.OwnerType, ownerID, name, contentType string) string {
+	ext := ".jpg"
+	if contentType == entity.ContentTypePNG {
+		ext = ".png"
+	}
+	return fmt.Sprintf("photos/%s/%s/%s%s", ownerType, ownerID, name, ext)
+}
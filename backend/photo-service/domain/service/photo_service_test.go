@@ -0,0 +1,496 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	journalEntity "twigger-backend/backend/journal-service/domain/entity"
+	journalRepository "twigger-backend/backend/journal-service/domain/repository"
+	"twigger-backend/backend/photo-service/domain/entity"
+	"twigger-backend/backend/photo-service/domain/storage"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// MockPhotoRepository is a mock implementation of repository.PhotoRepository
+type MockPhotoRepository struct {
+	mock.Mock
+}
+
+func (m *MockPhotoRepository) Create(ctx context.Context, photo *entity.Photo) error {
+	args := m.Called(ctx, photo)
+	return args.Error(0)
+}
+
+func (m *MockPhotoRepository) FindByID(ctx context.Context, photoID string) (*entity.Photo, error) {
+	args := m.Called(ctx, photoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Photo), args.Error(1)
+}
+
+func (m *MockPhotoRepository) Delete(ctx context.Context, photoID string) error {
+	args := m.Called(ctx, photoID)
+	return args.Error(0)
+}
+
+func (m *MockPhotoRepository) FindByOwner(ctx context.Context, ownerType entity.OwnerType, ownerID string) ([]*entity.Photo, error) {
+	args := m.Called(ctx, ownerType, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Photo), args.Error(1)
+}
+
+func (m *MockPhotoRepository) CountByOwner(ctx context.Context, ownerType entity.OwnerType, ownerID string) (int, error) {
+	args := m.Called(ctx, ownerType, ownerID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPhotoRepository) FindOrphaned(ctx context.Context, limit int) ([]*entity.Photo, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Photo), args.Error(1)
+}
+
+// memoryBlobStorage keeps objects in a map
+type memoryBlobStorage struct {
+	objects map[string][]byte
+}
+
+func (s *memoryBlobStorage) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.objects[key] = data
+	return nil
+}
+
+func (s *memoryBlobStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *memoryBlobStorage) Delete(ctx context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+// stubJournalRepository serves entries from memory; other methods are not used by photos
+type stubJournalRepository struct {
+	journalRepository.JournalRepository
+	entries map[string]*journalEntity.JournalEntry
+}
+
+func (s *stubJournalRepository) FindByID(ctx context.Context, entryID string) (*journalEntity.JournalEntry, error) {
+	if entry, ok := s.entries[entryID]; ok {
+		return entry, nil
+	}
+	return nil, domainerrors.NewNotFoundError("journal_entry", entryID)
+}
+
+// stubProblemRepository serves plant problems from memory; other methods are not used by photos
+type stubProblemRepository struct {
+	plantRepository.PlantProblemRepository
+	problems map[string]*plantEntity.PlantProblem
+}
+
+func (s *stubProblemRepository) FindByID(ctx context.Context, problemID string) (*plantEntity.PlantProblem, error) {
+	if problem, ok := s.problems[problemID]; ok {
+		return problem, nil
+	}
+	return nil, plantEntity.NewNotFoundError("plant_problem", problemID)
+}
+
+func setupPhotoService() (*photoService, *MockPhotoRepository, *memoryBlobStorage) {
+	mockRepo := new(MockPhotoRepository)
+	blobs := &memoryBlobStorage{objects: map[string][]byte{}}
+	service := &photoService{
+		photoRepo: mockRepo,
+		blobs:     blobs,
+		journalRepo: &stubJournalRepository{entries: map[string]*journalEntity.JournalEntry{
+			"entry-1": {EntryID: "entry-1", GardenID: "garden-123"},
+		}},
+		gardenPlantRepo: &mocks.StubGardenPlantRepository{Plants: []*gardenEntity.GardenPlant{
+			{GardenPlantID: "gp-1", GardenID: "garden-123"},
+		}},
+		problemRepo: &stubProblemRepository{problems: map[string]*plantEntity.PlantProblem{
+			"problem-1": {ProblemID: "problem-1"},
+		}},
+	}
+	return service, mockRepo, blobs
+}
+
+// gpsLatitude is a recognizable GPSLatitude value (three rationals) for the test EXIF block
+var gpsLatitude = []byte{
+	0x33, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, // 51/1
+	0x1E, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, // 30/1
+	0xD2, 0x04, 0x00, 0x00, 0x64, 0x00, 0x00, 0x00, // 1234/100
+}
+
+// testJPEGWithGPS encodes a JPEG and inserts an EXIF block containing a GPS IFD
+func testJPEGWithGPS(t *testing.T, width, height int) []byte {
+	var img bytes.Buffer
+	require.NoError(t, jpeg.Encode(&img, testImage(width, height), nil))
+
+	// Little-endian TIFF: header, IFD0 with a GPS pointer, GPS IFD with a latitude
+	tiff := make([]byte, 44)
+	copy(tiff, "II")
+	binary.LittleEndian.PutUint16(tiff[2:], 42)
+	binary.LittleEndian.PutUint32(tiff[4:], 8)
+
+	binary.LittleEndian.PutUint16(tiff[8:], 1)
+	binary.LittleEndian.PutUint16(tiff[10:], tagGPSInfoIFD)
+	binary.LittleEndian.PutUint16(tiff[12:], 4) // LONG
+	binary.LittleEndian.PutUint32(tiff[14:], 1)
+	binary.LittleEndian.PutUint32(tiff[18:], 26)
+
+	binary.LittleEndian.PutUint16(tiff[26:], 1)
+	binary.LittleEndian.PutUint16(tiff[28:], 0x0002) // GPSLatitude
+	binary.LittleEndian.PutUint16(tiff[30:], 5)      // RATIONAL
+	binary.LittleEndian.PutUint32(tiff[32:], 3)
+	binary.LittleEndian.PutUint32(tiff[36:], 44)
+	tiff = append(tiff, gpsLatitude...)
+
+	segment := []byte{0xFF, markerAPP1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(2+len(exifHeader)+len(tiff)))
+	segment = append(segment, exifHeader...)
+	segment = append(segment, tiff...)
+
+	data := append([]byte{}, img.Bytes()[:2]...)
+	data = append(data, segment...)
+	return append(data, img.Bytes()[2:]...)
+}
+
+// withJPEGSegment inserts a metadata segment right after a JPEG's start of image marker
+func withJPEGSegment(data []byte, marker byte, payload []byte) []byte {
+	segment := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(2+len(payload)))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+// withPNGChunk inserts an ancillary chunk right after a PNG's IHDR chunk
+func withPNGChunk(data []byte, chunkType string, payload []byte) []byte {
+	chunk := make([]byte, 4, 12+len(payload))
+	binary.BigEndian.PutUint32(chunk, uint32(len(payload)))
+	chunk = append(chunk, chunkType...)
+	chunk = append(chunk, payload...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	ihdrEnd := len(pngHeader) + 12 + int(binary.BigEndian.Uint32(data[len(pngHeader):]))
+	out := append([]byte{}, data[:ihdrEnd]...)
+	out = append(out, chunk...)
+	return append(out, data[ihdrEnd:]...)
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, testImage(width, height)))
+	return buf.Bytes()
+}
+
+func testImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 0x80, A: 0xFF})
+		}
+	}
+	return img
+}
+
+func TestPhotoService_UploadPhoto_JPEGStripsGPSAndCreatesThumbnail(t *testing.T) {
+	service, mockRepo, blobs := setupPhotoService()
+	ctx := context.Background()
+
+	data := testJPEGWithGPS(t, 640, 480)
+	require.True(t, bytes.Contains(data, gpsLatitude))
+
+	mockRepo.On("CountByOwner", ctx, entity.OwnerJournalEntry, "entry-1").Return(0, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Photo")).Return(nil)
+
+	photo, err := service.UploadPhoto(ctx, &UploadPhotoInput{
+		OwnerType:  entity.OwnerJournalEntry,
+		OwnerID:    "entry-1",
+		UploadedBy: "user-1",
+		Data:       data,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, photo.GardenID)
+	assert.Equal(t, "garden-123", *photo.GardenID)
+	assert.Equal(t, entity.ContentTypeJPEG, photo.ContentType)
+	assert.Equal(t, 640, photo.Width)
+	assert.Equal(t, 480, photo.Height)
+	assert.True(t, strings.HasSuffix(photo.StorageKey, ".jpg"))
+
+	stored := blobs.objects[photo.StorageKey]
+	require.NotNil(t, stored)
+	assert.False(t, bytes.Contains(stored, gpsLatitude), "GPS coordinates should be removed")
+	assert.True(t, bytes.Contains(stored, exifHeader), "other EXIF data should be kept")
+	_, err = jpeg.Decode(bytes.NewReader(stored))
+	assert.NoError(t, err)
+
+	thumbnail, err := jpeg.Decode(bytes.NewReader(blobs.objects[photo.ThumbnailKey]))
+	require.NoError(t, err)
+	assert.Equal(t, 320, thumbnail.Bounds().Dx())
+	assert.Equal(t, 240, thumbnail.Bounds().Dy())
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPhotoService_UploadPhoto_PNGForPlantProblem(t *testing.T) {
+	service, mockRepo, blobs := setupPhotoService()
+	ctx := context.Background()
+
+	mockRepo.On("CountByOwner", ctx, entity.OwnerPlantProblem, "problem-1").Return(0, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Photo")).Return(nil)
+
+	photo, err := service.UploadPhoto(ctx, &UploadPhotoInput{
+		OwnerType:  entity.OwnerPlantProblem,
+		OwnerID:    "problem-1",
+		UploadedBy: "user-1",
+		Data:       testPNG(t, 100, 50),
+	})
+
+	require.NoError(t, err)
+	assert.Nil(t, photo.GardenID)
+	assert.Equal(t, entity.ContentTypePNG, photo.ContentType)
+	assert.True(t, strings.HasSuffix(photo.StorageKey, ".png"))
+
+	// Small images keep their size in the thumbnail
+	thumbnail, err := jpeg.Decode(bytes.NewReader(blobs.objects[photo.ThumbnailKey]))
+	require.NoError(t, err)
+	assert.Equal(t, 100, thumbnail.Bounds().Dx())
+	assert.Equal(t, 50, thumbnail.Bounds().Dy())
+}
+
+func TestStripLocationMetadata_DropsMetadataSegments(t *testing.T) {
+	location := []byte("51.5074 N, 0.1278 W")
+	xmp := append([]byte("<x:xmpmeta><exif:GPSLatitude>"), location...)
+	var jpegData bytes.Buffer
+	require.NoError(t, jpeg.Encode(&jpegData, testImage(16, 16), nil))
+	pngData := testPNG(t, 16, 16)
+
+	tests := []struct {
+		name        string
+		data        []byte
+		contentType string
+	}{
+		{
+			name:        "jpeg xmp",
+			data:        withJPEGSegment(jpegData.Bytes(), markerAPP1, append([]byte("http://ns.adobe.com/xap/1.0/\x00"), xmp...)),
+			contentType: entity.ContentTypeJPEG,
+		},
+		{
+			name:        "jpeg extended xmp",
+			data:        withJPEGSegment(jpegData.Bytes(), markerAPP1, append([]byte("http://ns.adobe.com/xmp/extension/\x00"), xmp...)),
+			contentType: entity.ContentTypeJPEG,
+		},
+		{
+			name:        "jpeg iptc",
+			data:        withJPEGSegment(jpegData.Bytes(), markerAPP13, append([]byte("Photoshop 3.0\x008BIM\x04\x04"), location...)),
+			contentType: entity.ContentTypeJPEG,
+		},
+		{
+			name:        "jpeg comment",
+			data:        withJPEGSegment(jpegData.Bytes(), markerCOM, location),
+			contentType: entity.ContentTypeJPEG,
+		},
+		{
+			name:        "png text",
+			data:        withPNGChunk(pngData, "tEXt", append([]byte("Location\x00"), location...)),
+			contentType: entity.ContentTypePNG,
+		},
+		{
+			name:        "png international text",
+			data:        withPNGChunk(pngData, "iTXt", append([]byte("XML:com.adobe.xmp\x00\x00\x00\x00\x00"), xmp...)),
+			contentType: entity.ContentTypePNG,
+		},
+		{
+			name:        "png exif",
+			data:        withPNGChunk(pngData, "eXIf", append([]byte("MM\x00\x2a"), location...)),
+			contentType: entity.ContentTypePNG,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, bytes.Contains(tt.data, location))
+
+			cleaned, err := stripLocationMetadata(tt.data, tt.contentType)
+
+			require.NoError(t, err)
+			assert.False(t, bytes.Contains(cleaned, location), "metadata should be removed")
+			_, _, err = image.Decode(bytes.NewReader(cleaned))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPhotoService_UploadPhoto_RejectsInvalidFiles(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "not an image", data: []byte("hello, this is a text file")},
+		{name: "gif", data: []byte("GIF89a\x01\x00\x01\x00\x00\x00\x00")},
+		{name: "too large", data: make([]byte, MaxPhotoBytes+1)},
+		{name: "truncated jpeg", data: []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, blobs := setupPhotoService()
+			ctx := context.Background()
+
+			mockRepo.On("CountByOwner", ctx, entity.OwnerGardenPlant, "gp-1").Return(0, nil).Maybe()
+
+			_, err := service.UploadPhoto(ctx, &UploadPhotoInput{
+				OwnerType:  entity.OwnerGardenPlant,
+				OwnerID:    "gp-1",
+				UploadedBy: "user-1",
+				Data:       tt.data,
+			})
+
+			assert.Error(t, err)
+			assert.IsType(t, &domainerrors.ValidationError{}, err)
+			assert.Empty(t, blobs.objects)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPhotoService_UploadPhoto_OwnerLimit(t *testing.T) {
+	service, mockRepo, _ := setupPhotoService()
+	ctx := context.Background()
+
+	mockRepo.On("CountByOwner", ctx, entity.OwnerGardenPlant, "gp-1").Return(maxPhotosPerOwner, nil)
+
+	_, err := service.UploadPhoto(ctx, &UploadPhotoInput{
+		OwnerType:  entity.OwnerGardenPlant,
+		OwnerID:    "gp-1",
+		UploadedBy: "user-1",
+		Data:       testPNG(t, 10, 10),
+	})
+
+	assert.Error(t, err)
+	assert.IsType(t, &domainerrors.ValidationError{}, err)
+}
+
+func TestPhotoService_UploadPhoto_UnknownOwner(t *testing.T) {
+	service, _, _ := setupPhotoService()
+
+	_, err := service.UploadPhoto(context.Background(), &UploadPhotoInput{
+		OwnerType:  entity.OwnerGardenPlant,
+		OwnerID:    "gp-missing",
+		UploadedBy: "user-1",
+		Data:       testPNG(t, 10, 10),
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestPhotoService_UploadPhoto_RepositoryErrorRemovesBlobs(t *testing.T) {
+	service, mockRepo, blobs := setupPhotoService()
+	ctx := context.Background()
+
+	mockRepo.On("CountByOwner", ctx, entity.OwnerGardenPlant, "gp-1").Return(0, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Photo")).Return(errors.New("connection reset"))
+
+	_, err := service.UploadPhoto(ctx, &UploadPhotoInput{
+		OwnerType:  entity.OwnerGardenPlant,
+		OwnerID:    "gp-1",
+		UploadedBy: "user-1",
+		Data:       testPNG(t, 10, 10),
+	})
+
+	assert.Error(t, err)
+	assert.Empty(t, blobs.objects)
+}
+
+func TestPhotoService_DeletePhoto_RemovesBlobs(t *testing.T) {
+	service, mockRepo, blobs := setupPhotoService()
+	ctx := context.Background()
+
+	photo := &entity.Photo{
+		PhotoID:      "photo-1",
+		StorageKey:   "photos/garden_plant/gp-1/photo-1.png",
+		ThumbnailKey: "photos/garden_plant/gp-1/photo-1_thumb.jpg",
+	}
+	blobs.objects[photo.StorageKey] = []byte("image")
+	blobs.objects[photo.ThumbnailKey] = []byte("thumb")
+
+	mockRepo.On("FindByID", ctx, "photo-1").Return(photo, nil)
+	mockRepo.On("Delete", ctx, "photo-1").Return(nil)
+
+	err := service.DeletePhoto(ctx, "photo-1")
+
+	assert.NoError(t, err)
+	assert.Empty(t, blobs.objects)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPhotoService_SweepOrphanedPhotos_RemovesBlobsThenRecords(t *testing.T) {
+	service, mockRepo, blobs := setupPhotoService()
+	ctx := context.Background()
+
+	orphans := []*entity.Photo{
+		{PhotoID: "photo-1", StorageKey: "photos/journal_entry/je-1/photo-1.jpg", ThumbnailKey: "photos/journal_entry/je-1/photo-1_thumb.jpg"},
+		{PhotoID: "photo-2", StorageKey: "photos/garden_plant/gp-1/photo-2.png", ThumbnailKey: "photos/garden_plant/gp-1/photo-2_thumb.jpg"},
+	}
+	for _, photo := range orphans {
+		blobs.objects[photo.StorageKey] = []byte("image")
+		blobs.objects[photo.ThumbnailKey] = []byte("thumb")
+	}
+	blobs.objects["photos/plant_problem/pp-1/photo-3.jpg"] = []byte("attached")
+
+	mockRepo.On("FindOrphaned", ctx, 100).Return(orphans, nil)
+	mockRepo.On("Delete", ctx, "photo-1").Return(nil)
+	mockRepo.On("Delete", ctx, "photo-2").Return(errors.New("connection reset"))
+
+	removed, err := service.SweepOrphanedPhotos(ctx, 100)
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, map[string][]byte{"photos/plant_problem/pp-1/photo-3.jpg": []byte("attached")}, blobs.objects)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPhotoService_OpenPhoto_MissingContent(t *testing.T) {
+	service, _, _ := setupPhotoService()
+
+	_, err := service.OpenPhoto(context.Background(), &entity.Photo{PhotoID: "photo-1", StorageKey: "missing"}, false)
+
+	assert.Error(t, err)
+	assert.IsType(t, &domainerrors.NotFoundError{}, err)
+}
@@ -0,0 +1,22 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrObjectNotFound is returned when a blob does not exist
+var ErrObjectNotFound = errors.New("storage object not found")
+
+// BlobStorage stores binary objects such as photos under string keys
+type BlobStorage interface {
+	// Put stores the contents of r under key, replacing any existing object
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+
+	// Get opens the object stored under key. Callers must close the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the object stored under key. Missing objects are not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,273 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"twigger-backend/backend/photo-service/domain/entity"
+	"twigger-backend/backend/photo-service/domain/repository"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// PostgresPhotoRepository implements repository.PhotoRepository using PostgreSQL
+type PostgresPhotoRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresPhotoRepository creates a new PostgreSQL photo repository
+func NewPostgresPhotoRepository(db *sql.DB) repository.PhotoRepository {
+	return &PostgresPhotoRepository{db: db}
+}
+
+// The owner is stored in one of three foreign key columns so deletes reach the photo;
+// owner_type/owner_id are derived from whichever is set.
+const photoColumns = `
+	photo_id,
+	CASE
+		WHEN journal_entry_id IS NOT NULL THEN 'journal_entry'
+		WHEN garden_plant_id IS NOT NULL THEN 'garden_plant'
+		ELSE 'plant_problem'
+	END AS owner_type,
+	COALESCE(journal_entry_id, garden_plant_id, problem_id) AS owner_id,
+	garden_id, uploaded_by, storage_key, thumbnail_key, content_type,
+	size_bytes, width, height, original_filename, caption, created_at
+`
+
+// Deleting a photo's owner, garden or uploader nulls the reference and leaves the row
+// for the sweeper. attachedPhoto matches the photos that are still attached.
+const (
+	attachedPhoto = `
+	uploaded_by IS NOT NULL
+	AND num_nonnulls(journal_entry_id, garden_plant_id, problem_id) = 1
+	AND (problem_id IS NOT NULL OR garden_id IS NOT NULL)
+`
+	orphanedPhoto = `
+	(uploaded_by IS NULL
+	OR num_nonnulls(journal_entry_id, garden_plant_id, problem_id) = 0
+	OR (problem_id IS NULL AND garden_id IS NULL))
+`
+)
+
+// Create creates a new photo record
+func (r *PostgresPhotoRepository) Create(ctx context.Context, photo *entity.Photo) error {
+	if err := photo.Validate(); err != nil {
+		return domainerrors.NewValidationError("photo", err.Error())
+	}
+
+	if photo.PhotoID == "" {
+		photo.PhotoID = uuid.New().String()
+	}
+	photo.CreatedAt = time.Now()
+
+	journalEntryID, gardenPlantID, problemID := ownerColumns(photo.OwnerType, photo.OwnerID)
+
+	query := `
+		INSERT INTO photos (
+			photo_id, journal_entry_id, garden_plant_id, problem_id, garden_id,
+			uploaded_by, storage_key, thumbnail_key, content_type,
+			size_bytes, width, height, original_filename, caption, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		photo.PhotoID,
+		journalEntryID,
+		gardenPlantID,
+		problemID,
+		photo.GardenID,
+		photo.UploadedBy,
+		photo.StorageKey,
+		photo.ThumbnailKey,
+		photo.ContentType,
+		photo.SizeBytes,
+		photo.Width,
+		photo.Height,
+		photo.OriginalFilename,
+		photo.Caption,
+		photo.CreatedAt,
+	)
+	if err != nil {
+		return domainerrors.NewDatabaseError("photo_create", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a photo by ID
+func (r *PostgresPhotoRepository) FindByID(ctx context.Context, photoID string) (*entity.Photo, error) {
+	query := `SELECT ` + photoColumns + ` FROM photos WHERE photo_id = $1 AND` + attachedPhoto
+
+	photo, err := scanPhoto(r.db.QueryRowContext(ctx, query, photoID))
+	if err == sql.ErrNoRows {
+		return nil, domainerrors.NewNotFoundError("photo", photoID)
+	}
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("photo_find_by_id", err)
+	}
+
+	return photo, nil
+}
+
+// Delete deletes a photo record
+func (r *PostgresPhotoRepository) Delete(ctx context.Context, photoID string) error {
+	query := `DELETE FROM photos WHERE photo_id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, photoID)
+	if err != nil {
+		return domainerrors.NewDatabaseError("photo_delete", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainerrors.NewDatabaseError("photo_delete_rows_affected", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainerrors.NewNotFoundError("photo", photoID)
+	}
+
+	return nil
+}
+
+// FindByOwner finds the photos attached to an owner, newest first
+func (r *PostgresPhotoRepository) FindByOwner(ctx context.Context, ownerType entity.OwnerType, ownerID string) ([]*entity.Photo, error) {
+	query := `SELECT ` + photoColumns + ` FROM photos WHERE ` + ownerColumn(ownerType) + ` = $1 AND` + attachedPhoto + `ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, ownerID)
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("photo_find_by_owner", err)
+	}
+	defer rows.Close()
+
+	photos := []*entity.Photo{}
+	for rows.Next() {
+		photo, err := scanPhoto(rows)
+		if err != nil {
+			return nil, domainerrors.NewDatabaseError("photo_scan", err)
+		}
+		photos = append(photos, photo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domainerrors.NewDatabaseError("photo_rows_iteration", err)
+	}
+
+	return photos, nil
+}
+
+// CountByOwner counts the photos attached to an owner
+func (r *PostgresPhotoRepository) CountByOwner(ctx context.Context, ownerType entity.OwnerType, ownerID string) (int, error) {
+	query := `SELECT COUNT(*) FROM photos WHERE ` + ownerColumn(ownerType) + ` = $1 AND` + attachedPhoto
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, ownerID).Scan(&count); err != nil {
+		return 0, domainerrors.NewDatabaseError("photo_count_by_owner", err)
+	}
+
+	return count, nil
+}
+
+// FindOrphaned finds photos whose owner, garden or uploader has been deleted, oldest first.
+// Only the ID and storage keys are loaded.
+func (r *PostgresPhotoRepository) FindOrphaned(ctx context.Context, limit int) ([]*entity.Photo, error) {
+	query := `
+		SELECT photo_id, storage_key, thumbnail_key
+		FROM photos
+		WHERE` + orphanedPhoto + `ORDER BY created_at
+		LIMIT $1
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("photo_find_orphaned", err)
+	}
+	defer rows.Close()
+
+	photos := []*entity.Photo{}
+	for rows.Next() {
+		var photo entity.Photo
+		if err := rows.Scan(&photo.PhotoID, &photo.StorageKey, &photo.ThumbnailKey); err != nil {
+			return nil, domainerrors.NewDatabaseError("photo_scan", err)
+		}
+		photos = append(photos, &photo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domainerrors.NewDatabaseError("photo_rows_iteration", err)
+	}
+
+	return photos, nil
+}
+
+// ownerColumn returns the foreign key column for an owner type
+func ownerColumn(ownerType entity.OwnerType) string {
+	switch ownerType {
+	case entity.OwnerJournalEntry:
+		return "journal_entry_id"
+	case entity.OwnerGardenPlant:
+		return "garden_plant_id"
+	default:
+		return "problem_id"
+	}
+}
+
+// ownerColumns spreads an owner into the three foreign key columns
+func ownerColumns(ownerType entity.OwnerType, ownerID string) (journalEntryID, gardenPlantID, problemID *string) {
+	switch ownerType {
+	case entity.OwnerJournalEntry:
+		journalEntryID = &ownerID
+	case entity.OwnerGardenPlant:
+		gardenPlantID = &ownerID
+	case entity.OwnerPlantProblem:
+		problemID = &ownerID
+	}
+	return journalEntryID, gardenPlantID, problemID
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanPhoto scans a single photo row, mapping nullable columns
+func scanPhoto(row rowScanner) (*entity.Photo, error) {
+	var photo entity.Photo
+	var gardenID, originalFilename, caption sql.NullString
+
+	err := row.Scan(
+		&photo.PhotoID,
+		&photo.OwnerType,
+		&photo.OwnerID,
+		&gardenID,
+		&photo.UploadedBy,
+		&photo.StorageKey,
+		&photo.ThumbnailKey,
+		&photo.ContentType,
+		&photo.SizeBytes,
+		&photo.Width,
+		&photo.Height,
+		&originalFilename,
+		&caption,
+		&photo.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map nullable fields
+	if gardenID.Valid {
+		photo.GardenID = &gardenID.String
+	}
+	if originalFilename.Valid {
+		photo.OriginalFilename = &originalFilename.String
+	}
+	if caption.Valid {
+		photo.Caption = &caption.String
+	}
+
+	return &photo, nil
+}
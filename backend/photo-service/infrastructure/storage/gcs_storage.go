@@ -0,0 +1,74 @@
+//go:build gcs
+// +build gcs
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	gcs "cloud.google.com/go/storage"
+	"twigger-backend/backend/photo-service/domain/storage"
+)
+
+// GCSStorage implements storage.BlobStorage on a Google Cloud Storage bucket.
+// Only built with the "gcs" build tag.
+type GCSStorage struct {
+	client *gcs.Client
+	bucket string
+}
+
+// NewGCSStorage creates a blob store backed by the given bucket using default credentials
+func NewGCSStorage(ctx context.Context, bucket string) (storage.BlobStorage, error) {
+	if bucket == "" {
+		return nil, fmt.Errorf("storage bucket is required")
+	}
+
+	client, err := gcs.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+
+	return &GCSStorage{client: client, bucket: bucket}, nil
+}
+
+// Put stores the contents of r under key, replacing any existing object
+func (s *GCSStorage) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	w := s.client.Bucket(s.bucket).Object(key).NewWriter(ctx)
+	w.ContentType = contentType
+
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+
+	return nil
+}
+
+// Get opens the object stored under key
+func (s *GCSStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	reader, err := s.client.Bucket(s.bucket).Object(key).NewReader(ctx)
+	if errors.Is(err, gcs.ErrObjectNotExist) {
+		return nil, storage.ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open object: %w", err)
+	}
+
+	return reader, nil
+}
+
+// Delete removes the object stored under key
+func (s *GCSStorage) Delete(ctx context.Context, key string) error {
+	err := s.client.Bucket(s.bucket).Object(key).Delete(ctx)
+	if err != nil && !errors.Is(err, gcs.ErrObjectNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
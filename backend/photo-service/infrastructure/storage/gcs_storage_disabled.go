@@ -0,0 +1,16 @@
+//go:build !gcs
+// +build !gcs
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"twigger-backend/backend/photo-service/domain/storage"
+)
+
+// NewGCSStorage is unavailable unless the binary is built with the "gcs" build tag
+func NewGCSStorage(ctx context.Context, bucket string) (storage.BlobStorage, error) {
+	return nil, fmt.Errorf("GCS storage is not compiled in: rebuild with -tags gcs")
+}
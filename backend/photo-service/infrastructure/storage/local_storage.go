@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"twigger-backend/backend/photo-service/domain/storage"
+)
+
+// LocalStorage implements storage.BlobStorage on the local filesystem.
+// Intended for development and single-instance deployments.
+type LocalStorage struct {
+	baseDir string
+}
+
+// NewLocalStorage creates a filesystem blob store rooted at baseDir, creating it if needed
+func NewLocalStorage(baseDir string) (*LocalStorage, error) {
+	if baseDir == "" {
+		return nil, fmt.Errorf("storage directory is required")
+	}
+
+	absDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve storage directory: %w", err)
+	}
+
+	if err := os.MkdirAll(absDir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	return &LocalStorage{baseDir: absDir}, nil
+}
+
+// Put stores the contents of r under key, replacing any existing object.
+// Data is written to a temporary file and renamed so readers never see partial objects.
+func (s *LocalStorage) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create object directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create object: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op after a successful rename
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+
+	return nil
+}
+
+// Get opens the object stored under key
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, storage.ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open object: %w", err)
+	}
+
+	return f, nil
+}
+
+// Delete removes the object stored under key
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// path maps a key to a file under baseDir, rejecting keys that escape it
+func (s *LocalStorage) path(key string) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("object key is required")
+	}
+
+	path := filepath.Join(s.baseDir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.baseDir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object key: %s", key)
+	}
+
+	return path, nil
+}
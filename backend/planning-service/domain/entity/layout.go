@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"time"
+
+	"twigger-backend/backend/plant-service/pkg/types"
+)
+
+// SpacedPlant is a placed plant and the room it needs at maturity
+type SpacedPlant struct {
+	GardenPlantID string   `json:"garden_plant_id"`
+	PlantID       string   `json:"plant_id"`
+	PlantName     string   `json:"plant_name,omitempty"`
+	MatureSpreadM *float64 `json:"mature_spread_m,omitempty"` // Nil when the species has no recorded spread
+}
+
+// SpacingConflict is a pair of plants growing closer than their mature spreads allow
+type SpacingConflict struct {
+	PlantA           SpacedPlant `json:"plant_a"`
+	PlantB           SpacedPlant `json:"plant_b"`
+	DistanceM        float64     `json:"distance_m"`
+	RequiredSpacingM float64     `json:"required_spacing_m"`
+	OverlapM         float64     `json:"overlap_m"` // How much closer than required the plants are
+}
+
+// NewSpacingConflict returns the conflict between two plants, or nil if they have enough room
+func NewSpacingConflict(a, b SpacedPlant, distanceM float64) *SpacingConflict {
+	required := RequiredSpacingM(a.MatureSpreadM, b.MatureSpreadM)
+	if required <= 0 || distanceM >= required {
+		return nil
+	}
+
+	return &SpacingConflict{
+		PlantA:           a,
+		PlantB:           b,
+		DistanceM:        distanceM,
+		RequiredSpacingM: required,
+		OverlapM:         required - distanceM,
+	}
+}
+
+// RequiredSpacingM returns the centre to centre distance two plants need for their
+// mature canopies to just touch: half of each plant's spread. Unknown spreads count as zero.
+func RequiredSpacingM(spreadA, spreadB *float64) float64 {
+	var total float64
+	if spreadA != nil {
+		total += *spreadA
+	}
+	if spreadB != nil {
+		total += *spreadB
+	}
+	return total / 2
+}
+
+// TypicalSpreadM reduces a mature spread range to one value: the typical spread,
+// else the midpoint of min and max, else whichever bound is known
+func TypicalSpreadM(spread *types.SizeRange) *float64 {
+	if spread == nil {
+		return nil
+	}
+
+	switch {
+	case spread.TypicalM != nil:
+		return spread.TypicalM
+	case spread.MinM != nil && spread.MaxM != nil:
+		midpoint := (*spread.MinM + *spread.MaxM) / 2
+		return &midpoint
+	case spread.MaxM != nil:
+		return spread.MaxM
+	default:
+		return spread.MinM
+	}
+}
+
+// LayoutReport lists every overcrowded pair of active plants in a garden
+type LayoutReport struct {
+	GardenID            string             `json:"garden_id"`
+	PlantsChecked       int                `json:"plants_checked"`
+	PlantsWithoutSpread int                `json:"plants_without_spread"` // Counted as needing no room
+	Conflicts           []*SpacingConflict `json:"conflicts"`             // Largest overlap first
+	GeneratedAt         time.Time          `json:"generated_at"`
+}
@@ -0,0 +1,132 @@
+package entity
+
+import (
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/pkg/types"
+)
+
+// MaxRecommendations bounds how many plants one zone recommendation returns
+const MaxRecommendations = 50
+
+// Points awarded per factor when ranking recommendations. A plant matching every
+// factor of a fully described zone scores 100 before companion adjustments.
+const (
+	HardinessMatchPoints = 20
+	SunMatchPoints       = 30
+	WaterMatchPoints     = 30
+	SoilMatchPoints      = 20
+
+	CompanionBonusPoints    = 5  // Per beneficial plant already in the zone
+	MaxCompanionBonusPoints = 15 // Cap on the total companion bonus
+	AntagonistPenaltyPoints = 20 // Per antagonistic plant already in the zone
+)
+
+// Factors a recommendation can match
+const (
+	MatchHardiness = "hardiness"
+	MatchSun       = "sun"
+	MatchWater     = "water"
+	MatchSoil      = "soil"
+)
+
+// ZoneConditions are the growing constraints derived from a zone and its garden.
+// Nil fields were not recorded and do not constrain recommendations.
+type ZoneConditions struct {
+	SunHoursSummer *int                         `json:"sun_hours_summer,omitempty"`
+	SunRequirement *types.SunRequirement        `json:"sun_requirement,omitempty"` // Derived from SunHoursSummer
+	SoilType       *string                      `json:"soil_type,omitempty"`
+	IrrigationType *gardenEntity.IrrigationType `json:"irrigation_type,omitempty"`
+	WaterNeeds     *types.WaterNeeds            `json:"water_needs,omitempty"` // Derived from IrrigationType
+	HardinessZone  *string                      `json:"hardiness_zone,omitempty"`
+	CountryID      *string                      `json:"country_id,omitempty"`
+}
+
+// SunRequirementForHours maps hours of direct summer sun to the light a zone provides
+func SunRequirementForHours(hours int) types.SunRequirement {
+	switch {
+	case hours >= 6:
+		return types.SunFullSun
+	case hours >= 4:
+		return types.SunPartialSun
+	case hours >= 2:
+		return types.SunPartialShade
+	default:
+		return types.SunFullShade
+	}
+}
+
+// AcceptableSunRequirements lists the plant sun requirements that can grow in a zone's light,
+// best match first
+func AcceptableSunRequirements(sun types.SunRequirement) []types.SunRequirement {
+	switch sun {
+	case types.SunFullSun:
+		return []types.SunRequirement{types.SunFullSun, types.SunPartialSun}
+	case types.SunPartialSun:
+		return []types.SunRequirement{types.SunPartialSun, types.SunMorningSun, types.SunAfternoonShade, types.SunFullSun, types.SunPartialShade}
+	case types.SunPartialShade:
+		return []types.SunRequirement{types.SunPartialShade, types.SunDappledShade, types.SunMorningSun, types.SunAfternoonShade, types.SunPartialSun}
+	case types.SunFullShade:
+		return []types.SunRequirement{types.SunFullShade, types.SunDappledShade, types.SunPartialShade}
+	}
+	return []types.SunRequirement{sun}
+}
+
+// WaterNeedsForIrrigation maps a zone's irrigation to the moisture it keeps the soil at.
+// Unknown irrigation types return nil.
+func WaterNeedsForIrrigation(irrigationType gardenEntity.IrrigationType) *types.WaterNeeds {
+	var needs types.WaterNeeds
+	switch irrigationType {
+	case gardenEntity.IrrigationNone:
+		needs = types.WaterDry
+	case gardenEntity.IrrigationManual, gardenEntity.IrrigationDrip:
+		needs = types.WaterModerate
+	case gardenEntity.IrrigationSoaker, gardenEntity.IrrigationSprinkler:
+		needs = types.WaterMoist
+	default:
+		return nil
+	}
+	return &needs
+}
+
+// waterLevels orders water needs from driest to wettest
+var waterLevels = map[types.WaterNeeds]int{
+	types.WaterVeryDry:  0,
+	types.WaterDry:      1,
+	types.WaterModerate: 2,
+	types.WaterMoist:    3,
+	types.WaterWet:      4,
+	types.WaterBog:      5,
+	types.WaterAquatic:  6,
+}
+
+// WaterNeedsDistance returns how many moisture levels apart two water needs are,
+// or -1 if either is unknown
+func WaterNeedsDistance(a, b types.WaterNeeds) int {
+	levelA, okA := waterLevels[a]
+	levelB, okB := waterLevels[b]
+	if !okA || !okB {
+		return -1
+	}
+	if levelA > levelB {
+		return levelA - levelB
+	}
+	return levelB - levelA
+}
+
+// PlantRecommendation is a plant suited to a zone and how well it matches
+type PlantRecommendation struct {
+	Plant       *plantEntity.Plant `json:"plant"`
+	Score       int                `json:"score"`                 // 0-100, higher is a better match
+	Matches     []string           `json:"matches"`               // Factors the plant fully matches
+	Companions  []string           `json:"companions,omitempty"`  // Plants in the zone it grows well with
+	Antagonists []string           `json:"antagonists,omitempty"` // Plants in the zone it should not grow near
+}
+
+// ZoneRecommendations ranks plants for a zone, best match first
+type ZoneRecommendations struct {
+	ZoneID          string                 `json:"zone_id"`
+	GardenID        string                 `json:"garden_id"`
+	Conditions      ZoneConditions         `json:"conditions"`
+	Recommendations []*PlantRecommendation `json:"recommendations"`
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"time"
+
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/pkg/types"
+)
+
+// RegionalStatus is why a plant should not be grown freely in a country
+type RegionalStatus string
+
+const (
+	RegionalStatusProhibited RegionalStatus = "prohibited" // Illegal to plant; placements are rejected
+	RegionalStatusRestricted RegionalStatus = "restricted" // May need a permit; placements are warned about
+	RegionalStatusInvasive   RegionalStatus = "invasive"   // Spreads into the wild; placements are warned about
+)
+
+// RegionalStatusOf returns the most serious regional status of a country plant:
+// legal status before invasiveness. Plants without a concern return false.
+func RegionalStatusOf(countryPlant *plantEntity.CountryPlant) (RegionalStatus, bool) {
+	if countryPlant == nil {
+		return "", false
+	}
+
+	if countryPlant.LegalStatus != nil {
+		switch types.LegalStatus(*countryPlant.LegalStatus) {
+		case types.LegalProhibited:
+			return RegionalStatusProhibited, true
+		case types.LegalRestricted:
+			return RegionalStatusRestricted, true
+		}
+	}
+
+	if countryPlant.NativeStatus != nil && types.NativeStatus(*countryPlant.NativeStatus) == types.NativeStatusInvasive {
+		return RegionalStatusInvasive, true
+	}
+
+	return "", false
+}
+
+// RegionalOffender is a plant in a garden that is prohibited, restricted or invasive in its country
+type RegionalOffender struct {
+	GardenPlantID string         `json:"garden_plant_id"`
+	PlantID       string         `json:"plant_id"`
+	PlantName     string         `json:"plant_name,omitempty"`
+	ZoneID        *string        `json:"zone_id,omitempty"`
+	Status        RegionalStatus `json:"status"`
+	NativeStatus  *string        `json:"native_status,omitempty"`
+	LegalStatus   *string        `json:"legal_status,omitempty"`
+}
+
+// RegionalStatusReport lists a garden's plants that are prohibited, restricted or invasive
+// in the country the garden is in
+type RegionalStatusReport struct {
+	GardenID    string              `json:"garden_id"`
+	CountryID   *string             `json:"country_id,omitempty"` // Nil when the garden has no location or lies outside known countries
+	CountryName *string             `json:"country_name,omitempty"`
+	Offenders   []*RegionalOffender `json:"offenders"`
+	GeneratedAt time.Time           `json:"generated_at"`
+}
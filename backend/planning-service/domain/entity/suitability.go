@@ -0,0 +1,482 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/pkg/types"
+)
+
+// SuitabilityFactor is one condition a plant is scored against
+type SuitabilityFactor string
+
+const (
+	FactorHardiness      SuitabilityFactor = "hardiness_zone"
+	FactorHeatZone       SuitabilityFactor = "heat_zone"
+	FactorSun            SuitabilityFactor = "sun"
+	FactorWater          SuitabilityFactor = "water"
+	FactorSoilDrainage   SuitabilityFactor = "soil_drainage"
+	FactorPH             SuitabilityFactor = "ph"
+	FactorSalt           SuitabilityFactor = "salt_tolerance"
+	FactorWind           SuitabilityFactor = "wind_tolerance"
+	FactorRegionalStatus SuitabilityFactor = "regional_status" // Native, invasive and legal status in the country
+)
+
+// FactorWeights are the points each factor contributes to a 100 point score
+var FactorWeights = map[SuitabilityFactor]int{
+	FactorHardiness:      25,
+	FactorHeatZone:       10,
+	FactorSun:            15,
+	FactorWater:          15,
+	FactorSoilDrainage:   10,
+	FactorPH:             10,
+	FactorSalt:           5,
+	FactorWind:           5,
+	FactorRegionalStatus: 5,
+}
+
+// suitabilityFactors fixes the order factors are reported in
+var suitabilityFactors = []SuitabilityFactor{
+	FactorHardiness, FactorHeatZone, FactorSun, FactorWater, FactorSoilDrainage,
+	FactorPH, FactorSalt, FactorWind, FactorRegionalStatus,
+}
+
+// FactorStatus is how well a plant meets one factor
+type FactorStatus string
+
+const (
+	FactorMatch    FactorStatus = "match"    // Full points
+	FactorPartial  FactorStatus = "partial"  // Half points: close, or tolerated rather than preferred
+	FactorMismatch FactorStatus = "mismatch" // No points
+	FactorUnknown  FactorStatus = "unknown"  // Site or plant data missing; left out of the score
+)
+
+// phTolerance is how far outside a plant's pH range soil still counts as a partial match
+const phTolerance = 0.5
+
+// SiteConditions are the conditions at a garden or zone that plants are scored against.
+// Nil fields are unknown.
+type SiteConditions struct {
+	CountryID      *string               `json:"country_id,omitempty"`
+	HardinessZone  *string               `json:"hardiness_zone,omitempty"`
+	HeatZone       *string               `json:"heat_zone,omitempty"`
+	SunHoursSummer *int                  `json:"sun_hours_summer,omitempty"`
+	SunRequirement *types.SunRequirement `json:"sun_requirement,omitempty"`
+	WaterNeeds     *types.WaterNeeds     `json:"water_needs,omitempty"`
+	SoilType       *string               `json:"soil_type,omitempty"`
+	SoilDrainage   *types.SoilDrainage   `json:"soil_drainage,omitempty"`
+	SoilPH         *float64              `json:"soil_ph,omitempty"`
+	SaltExposed    *bool                 `json:"salt_exposed,omitempty"`
+	WindExposed    *bool                 `json:"wind_exposed,omitempty"`
+}
+
+// SuitabilityRequest asks how well a plant suits a garden, or a zone within it.
+// Site observations the garden does not record can be supplied directly.
+type SuitabilityRequest struct {
+	PlantID  string
+	GardenID string
+	ZoneID   *string
+
+	SoilPH       *float64
+	SoilDrainage *types.SoilDrainage
+	SaltExposed  *bool
+	WindExposed  *bool
+}
+
+// Validate validates the suitability request
+func (r *SuitabilityRequest) Validate() error {
+	if r.PlantID == "" {
+		return fmt.Errorf("plant_id is required")
+	}
+
+	if r.GardenID == "" && r.ZoneID == nil {
+		return fmt.Errorf("garden_id or zone_id is required")
+	}
+
+	if r.SoilPH != nil && (*r.SoilPH < 0 || *r.SoilPH > 14) {
+		return fmt.Errorf("soil_ph must be between 0 and 14")
+	}
+
+	if r.SoilDrainage != nil && !r.SoilDrainage.IsValid() {
+		return fmt.Errorf("invalid soil_drainage: %s", *r.SoilDrainage)
+	}
+
+	return nil
+}
+
+// FactorScore explains how one factor contributed to a suitability score
+type FactorScore struct {
+	Factor SuitabilityFactor `json:"factor"`
+	Status FactorStatus      `json:"status"`
+	Weight int               `json:"weight"`
+	Points int               `json:"points"`
+	Reason string            `json:"reason"`
+}
+
+// SuitabilityScore rates how well a plant suits a garden location
+type SuitabilityScore struct {
+	PlantID  string  `json:"plant_id"`
+	GardenID string  `json:"garden_id"`
+	ZoneID   *string `json:"zone_id,omitempty"`
+
+	Score      int  `json:"score"`      // 0-100 over the factors with data
+	Coverage   int  `json:"coverage"`   // Percentage of factor weight that had data
+	Prohibited bool `json:"prohibited"` // Planting is illegal in the country; the score is 0
+
+	Site    SiteConditions `json:"site"`
+	Factors []FactorScore  `json:"factors"`
+}
+
+// EvaluateSuitability scores a plant's growing conditions and regional status against a site.
+// Either may be nil when the plant has no data for the site's country.
+func EvaluateSuitability(site *SiteConditions, gc *types.GrowingConditions, countryPlant *plantEntity.CountryPlant) *SuitabilityScore {
+	result := &SuitabilityScore{Site: *site}
+
+	evaluators := map[SuitabilityFactor]func() (FactorStatus, string){
+		FactorHardiness:    func() (FactorStatus, string) { return evaluateHardiness(site, gc) },
+		FactorHeatZone:     func() (FactorStatus, string) { return evaluateHeatZone(site, gc) },
+		FactorSun:          func() (FactorStatus, string) { return evaluateSun(site, gc) },
+		FactorWater:        func() (FactorStatus, string) { return evaluateWater(site, gc) },
+		FactorSoilDrainage: func() (FactorStatus, string) { return evaluateDrainage(site, gc) },
+		FactorPH:           func() (FactorStatus, string) { return evaluatePH(site, gc) },
+		FactorSalt: func() (FactorStatus, string) {
+			return evaluateExposure(site.SaltExposed, gc, gc != nil && gc.SaltTolerant, "salt")
+		},
+		FactorWind: func() (FactorStatus, string) {
+			return evaluateExposure(site.WindExposed, gc, gc != nil && gc.WindTolerant, "wind")
+		},
+		FactorRegionalStatus: func() (FactorStatus, string) { return evaluateStatus(countryPlant) },
+	}
+
+	earned, known, total := 0, 0, 0
+	for _, factor := range suitabilityFactors {
+		status, reason := evaluators[factor]()
+		weight := FactorWeights[factor]
+		total += weight
+
+		score := FactorScore{Factor: factor, Status: status, Weight: weight, Reason: reason}
+		switch status {
+		case FactorMatch:
+			score.Points = weight
+		case FactorPartial:
+			score.Points = weight / 2
+		}
+		if status != FactorUnknown {
+			known += weight
+			earned += score.Points
+		}
+		result.Factors = append(result.Factors, score)
+	}
+
+	if known > 0 {
+		result.Score = int(math.Round(float64(earned) * 100 / float64(known)))
+	}
+	result.Coverage = int(math.Round(float64(known) * 100 / float64(total)))
+
+	if countryPlant != nil && countryPlant.LegalStatus != nil && types.LegalStatus(*countryPlant.LegalStatus) == types.LegalProhibited {
+		result.Prohibited = true
+		result.Score = 0
+	}
+
+	return result
+}
+
+func evaluateHardiness(site *SiteConditions, gc *types.GrowingConditions) (FactorStatus, string) {
+	if site.HardinessZone == nil {
+		return FactorUnknown, "the garden's hardiness zone has not been detected"
+	}
+	if gc == nil || len(gc.HardinessZones) == 0 {
+		return FactorUnknown, "no hardiness zones recorded for this plant"
+	}
+
+	siteLo, siteHi, ok := hardinessRange(*site.HardinessZone)
+	if !ok {
+		return FactorUnknown, fmt.Sprintf("unrecognised hardiness zone %s", *site.HardinessZone)
+	}
+
+	gap := math.MaxInt32
+	for _, zone := range gc.HardinessZones {
+		lo, hi, ok := hardinessRange(zone)
+		if !ok {
+			continue
+		}
+		gap = minInt(gap, rangeGap(siteLo, siteHi, lo, hi))
+	}
+
+	zones := strings.Join(gc.HardinessZones, ", ")
+	switch {
+	case gap == 0:
+		return FactorMatch, fmt.Sprintf("hardy in zone %s (grows in %s)", *site.HardinessZone, zones)
+	case gap == 1:
+		return FactorPartial, fmt.Sprintf("zone %s is half a zone outside its range (%s)", *site.HardinessZone, zones)
+	default:
+		return FactorMismatch, fmt.Sprintf("not hardy in zone %s (grows in %s)", *site.HardinessZone, zones)
+	}
+}
+
+func evaluateHeatZone(site *SiteConditions, gc *types.GrowingConditions) (FactorStatus, string) {
+	if site.HeatZone == nil {
+		return FactorUnknown, "the garden's heat zone has not been detected"
+	}
+	if gc == nil || len(gc.HeatZones) == 0 {
+		return FactorUnknown, "no heat zones recorded for this plant"
+	}
+
+	siteZone, err := strconv.Atoi(*site.HeatZone)
+	if err != nil {
+		return FactorUnknown, fmt.Sprintf("unrecognised heat zone %s", *site.HeatZone)
+	}
+
+	gap := math.MaxInt32
+	for _, zone := range gc.HeatZones {
+		if z, err := strconv.Atoi(zone); err == nil {
+			gap = minInt(gap, rangeGap(siteZone, siteZone, z, z))
+		}
+	}
+
+	zones := strings.Join(gc.HeatZones, ", ")
+	switch {
+	case gap == 0:
+		return FactorMatch, fmt.Sprintf("tolerates heat zone %s (grows in %s)", *site.HeatZone, zones)
+	case gap == 1:
+		return FactorPartial, fmt.Sprintf("heat zone %s is one zone outside its range (%s)", *site.HeatZone, zones)
+	default:
+		return FactorMismatch, fmt.Sprintf("heat zone %s is outside its range (%s)", *site.HeatZone, zones)
+	}
+}
+
+func evaluateSun(site *SiteConditions, gc *types.GrowingConditions) (FactorStatus, string) {
+	if site.SunRequirement == nil {
+		return FactorUnknown, "sun hours have not been recorded for this location"
+	}
+	if gc == nil || len(gc.SunRequirements) == 0 {
+		return FactorUnknown, "no sun requirements recorded for this plant"
+	}
+
+	sun := *site.SunRequirement
+	if gc.HasSunRequirement(sun) {
+		return FactorMatch, fmt.Sprintf("wants %s, which the site provides", sun)
+	}
+	for _, acceptable := range AcceptableSunRequirements(sun) {
+		if gc.HasSunRequirement(acceptable) {
+			return FactorPartial, fmt.Sprintf("prefers %s but tolerates %s", acceptable, sun)
+		}
+	}
+	if gc.ShadeTolerance && (sun == types.SunPartialShade || sun == types.SunFullShade) {
+		return FactorPartial, fmt.Sprintf("shade tolerant, though the site is %s", sun)
+	}
+	return FactorMismatch, fmt.Sprintf("needs %s; the site is %s", joinSun(gc.SunRequirements), sun)
+}
+
+func evaluateWater(site *SiteConditions, gc *types.GrowingConditions) (FactorStatus, string) {
+	if site.WaterNeeds == nil {
+		return FactorUnknown, "irrigation has not been recorded for this location"
+	}
+	if gc == nil || gc.WaterNeeds == nil {
+		return FactorUnknown, "no water needs recorded for this plant"
+	}
+
+	if *site.WaterNeeds == types.WaterDry && gc.DroughtTolerant {
+		return FactorMatch, "drought tolerant, suits unirrigated ground"
+	}
+
+	switch WaterNeedsDistance(*gc.WaterNeeds, *site.WaterNeeds) {
+	case 0:
+		return FactorMatch, fmt.Sprintf("wants %s soil, which the site provides", *gc.WaterNeeds)
+	case 1:
+		return FactorPartial, fmt.Sprintf("wants %s soil; the site is %s", *gc.WaterNeeds, *site.WaterNeeds)
+	default:
+		return FactorMismatch, fmt.Sprintf("wants %s soil; the site is %s", *gc.WaterNeeds, *site.WaterNeeds)
+	}
+}
+
+func evaluateDrainage(site *SiteConditions, gc *types.GrowingConditions) (FactorStatus, string) {
+	if site.SoilDrainage == nil {
+		return FactorUnknown, "soil drainage is not known for this location"
+	}
+	if gc == nil || gc.SoilDrainage == nil {
+		return FactorUnknown, "no drainage preference recorded for this plant"
+	}
+
+	switch SoilDrainageDistance(*gc.SoilDrainage, *site.SoilDrainage) {
+	case 0:
+		return FactorMatch, fmt.Sprintf("wants %s soil, which the site has", *gc.SoilDrainage)
+	case 1:
+		return FactorPartial, fmt.Sprintf("wants %s soil; the site is %s", *gc.SoilDrainage, *site.SoilDrainage)
+	default:
+		return FactorMismatch, fmt.Sprintf("wants %s soil; the site is %s", *gc.SoilDrainage, *site.SoilDrainage)
+	}
+}
+
+func evaluatePH(site *SiteConditions, gc *types.GrowingConditions) (FactorStatus, string) {
+	if site.SoilPH == nil {
+		return FactorUnknown, "soil pH has not been measured"
+	}
+	if gc == nil || gc.PHPreference == nil || (gc.PHPreference.MinPH == nil && gc.PHPreference.MaxPH == nil) {
+		return FactorUnknown, "no pH preference recorded for this plant"
+	}
+
+	ph := *site.SoilPH
+	minPH, maxPH := 0.0, 14.0
+	if gc.PHPreference.MinPH != nil {
+		minPH = *gc.PHPreference.MinPH
+	}
+	if gc.PHPreference.MaxPH != nil {
+		maxPH = *gc.PHPreference.MaxPH
+	}
+
+	switch {
+	case ph >= minPH && ph <= maxPH:
+		return FactorMatch, fmt.Sprintf("pH %.1f is within %.1f-%.1f", ph, minPH, maxPH)
+	case ph >= minPH-phTolerance && ph <= maxPH+phTolerance:
+		return FactorPartial, fmt.Sprintf("pH %.1f is just outside %.1f-%.1f", ph, minPH, maxPH)
+	default:
+		return FactorMismatch, fmt.Sprintf("pH %.1f is outside %.1f-%.1f", ph, minPH, maxPH)
+	}
+}
+
+// evaluateExposure scores salt or wind: unexposed sites suit every plant,
+// exposed sites only tolerant ones
+func evaluateExposure(exposed *bool, gc *types.GrowingConditions, tolerant bool, what string) (FactorStatus, string) {
+	if exposed == nil {
+		return FactorUnknown, fmt.Sprintf("%s exposure is not known for this location", what)
+	}
+	if !*exposed {
+		return FactorMatch, fmt.Sprintf("the site is sheltered from %s", what)
+	}
+	if gc == nil {
+		return FactorUnknown, fmt.Sprintf("no %s tolerance recorded for this plant", what)
+	}
+	if tolerant {
+		return FactorMatch, fmt.Sprintf("%s tolerant, suits an exposed site", what)
+	}
+	return FactorMismatch, fmt.Sprintf("not %s tolerant; the site is exposed", what)
+}
+
+// evaluateStatus scores native and legal status. Natives score in full,
+// invasive and prohibited plants nothing.
+func evaluateStatus(countryPlant *plantEntity.CountryPlant) (FactorStatus, string) {
+	if countryPlant == nil {
+		return FactorUnknown, "no regional status recorded for this country"
+	}
+
+	if countryPlant.LegalStatus != nil {
+		switch types.LegalStatus(*countryPlant.LegalStatus) {
+		case types.LegalProhibited:
+			return FactorMismatch, "prohibited: planting is illegal in this country"
+		case types.LegalRestricted:
+			return FactorPartial, "restricted: planting may need a permit in this country"
+		}
+	}
+
+	if countryPlant.NativeStatus == nil {
+		return FactorUnknown, "native status is not recorded for this country"
+	}
+
+	switch types.NativeStatus(*countryPlant.NativeStatus) {
+	case types.NativeStatusNative, types.NativeStatusEndemic:
+		return FactorMatch, fmt.Sprintf("%s to this country", *countryPlant.NativeStatus)
+	case types.NativeStatusInvasive:
+		return FactorMismatch, "invasive in this country"
+	default:
+		return FactorPartial, fmt.Sprintf("%s in this country", strings.ReplaceAll(*countryPlant.NativeStatus, "_", " "))
+	}
+}
+
+// drainageLevels orders soil drainage from driest to wettest
+var drainageLevels = map[types.SoilDrainage]int{
+	types.DrainageVeryWell: 0,
+	types.DrainageWell:     1,
+	types.DrainageModerate: 2,
+	types.DrainagePoor:     3,
+	types.DrainageWaterlog: 4,
+}
+
+// SoilDrainageDistance returns how many drainage levels apart two drainages are,
+// or -1 if either is unknown
+func SoilDrainageDistance(a, b types.SoilDrainage) int {
+	levelA, okA := drainageLevels[a]
+	levelB, okB := drainageLevels[b]
+	if !okA || !okB {
+		return -1
+	}
+	if levelA > levelB {
+		return levelA - levelB
+	}
+	return levelB - levelA
+}
+
+// SoilDrainageForSoilType estimates drainage from a soil type. Unknown types return nil.
+func SoilDrainageForSoilType(soilType string) *types.SoilDrainage {
+	var drainage types.SoilDrainage
+	switch strings.ToLower(soilType) {
+	case "sand", "sandy", "gravel":
+		drainage = types.DrainageVeryWell
+	case "loam", "sandy_loam", "chalk":
+		drainage = types.DrainageWell
+	case "silt", "clay_loam":
+		drainage = types.DrainageModerate
+	case "clay", "peat":
+		drainage = types.DrainagePoor
+	default:
+		return nil
+	}
+	return &drainage
+}
+
+// hardinessRange converts a hardiness zone to half-zone indexes: "7a" is 14-14,
+// "7b" 15-15 and "7" covers both
+func hardinessRange(zone string) (int, int, bool) {
+	zone = strings.ToLower(strings.TrimSpace(zone))
+	if zone == "" {
+		return 0, 0, false
+	}
+
+	half := -1
+	switch zone[len(zone)-1] {
+	case 'a':
+		half = 0
+		zone = zone[:len(zone)-1]
+	case 'b':
+		half = 1
+		zone = zone[:len(zone)-1]
+	}
+
+	n, err := strconv.Atoi(zone)
+	if err != nil {
+		return 0, 0, false
+	}
+	if half < 0 {
+		return n * 2, n*2 + 1, true
+	}
+	return n*2 + half, n*2 + half, true
+}
+
+// rangeGap returns how far apart two inclusive ranges are, 0 when they overlap
+func rangeGap(aLo, aHi, bLo, bHi int) int {
+	switch {
+	case aHi < bLo:
+		return bLo - aHi
+	case bHi < aLo:
+		return aLo - bHi
+	}
+	return 0
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func joinSun(requirements []types.SunRequirement) string {
+	names := make([]string, len(requirements))
+	for i, sr := range requirements {
+		names[i] = string(sr)
+	}
+	return strings.Join(names, " or ")
+}
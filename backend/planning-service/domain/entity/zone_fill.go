@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"fmt"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+)
+
+// MaxFillPoints bounds how many plants one zone fill may place
+const MaxFillPoints = 250
+
+// MinFillSpacingM is the closest plants or rows may be spaced in a zone fill
+const MinFillSpacingM = 0.05
+
+// GridPattern is the layout used to fill a zone with plants
+type GridPattern string
+
+const (
+	GridPatternSquare GridPattern = "square" // Square-foot style grid, equal spacing both ways
+	GridPatternHex    GridPattern = "hex"    // Triangular offset grid, every other row shifted half a space
+	GridPatternRows   GridPattern = "rows"   // East-west rows with their own row spacing
+)
+
+// IsValid checks if the grid pattern is supported
+func (p GridPattern) IsValid() bool {
+	switch p {
+	case GridPatternSquare, GridPatternHex, GridPatternRows:
+		return true
+	}
+	return false
+}
+
+// ZoneFillOptions describes how to fill a zone with one plant
+type ZoneFillOptions struct {
+	PlantID     string      `json:"plant_id"`
+	Pattern     GridPattern `json:"pattern"`
+	SpacingM    *float64    `json:"spacing_m,omitempty"`     // Between plants; defaults to the plant's mature spread
+	RowSpacingM *float64    `json:"row_spacing_m,omitempty"` // Between rows, rows pattern only; defaults to SpacingM
+	DryRun      bool        `json:"dry_run"`                 // Return the proposed points without placing plants
+}
+
+// Validate validates the fill options
+func (o *ZoneFillOptions) Validate() error {
+	if o.PlantID == "" {
+		return fmt.Errorf("plant_id is required")
+	}
+
+	if !o.Pattern.IsValid() {
+		return fmt.Errorf("pattern must be square, hex or rows")
+	}
+
+	if o.SpacingM != nil && *o.SpacingM < MinFillSpacingM {
+		return fmt.Errorf("spacing_m must be at least %g", MinFillSpacingM)
+	}
+
+	if o.RowSpacingM != nil {
+		if o.Pattern != GridPatternRows {
+			return fmt.Errorf("row_spacing_m only applies to the rows pattern")
+		}
+		if *o.RowSpacingM < MinFillSpacingM {
+			return fmt.Errorf("row_spacing_m must be at least %g", MinFillSpacingM)
+		}
+	}
+
+	return nil
+}
+
+// GridPoint is a proposed planting location
+type GridPoint struct {
+	Lng float64 `json:"lng"`
+	Lat float64 `json:"lat"`
+}
+
+// GeoJSON returns the point as a GeoJSON Point, rounded to about a centimetre
+func (p GridPoint) GeoJSON() string {
+	return fmt.Sprintf(`{"type":"Point","coordinates":[%.7f,%.7f]}`, p.Lng, p.Lat)
+}
+
+// ZoneFillPlan is the grid generated for a zone and, unless dry run, the plants placed on it
+type ZoneFillPlan struct {
+	ZoneID      string      `json:"zone_id"`
+	GardenID    string      `json:"garden_id"`
+	PlantID     string      `json:"plant_id"`
+	Pattern     GridPattern `json:"pattern"`
+	SpacingM    float64     `json:"spacing_m"`
+	RowSpacingM float64     `json:"row_spacing_m"`
+	Count       int         `json:"count"`
+	Points      []GridPoint `json:"points"`
+	DryRun      bool        `json:"dry_run"`
+
+	Placed []*gardenEntity.GardenPlant `json:"placed,omitempty"`
+}
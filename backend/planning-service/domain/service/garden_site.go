@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/planning-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/plant-service/pkg/types"
+)
+
+// gardenLocation is where a garden lies: its centre and the country containing it
+type gardenLocation struct {
+	lat     float64
+	lng     float64
+	country *plantEntity.Country // Nil when the garden lies outside every known country
+}
+
+// countryID returns the ID of the garden's country, or nil when it has none
+func (l *gardenLocation) countryID() *string {
+	if l == nil || l.country == nil {
+		return nil
+	}
+	return &l.country.CountryID
+}
+
+// locateGarden looks up a garden's centre and country. It returns nil when the garden has
+// no location; failed lookups are returned rather than treated as unknown.
+func locateGarden(
+	ctx context.Context,
+	gardenRepo gardenRepository.GardenRepository,
+	countryRepo plantRepository.CountryRepository,
+	garden *gardenEntity.Garden,
+) (*gardenLocation, error) {
+	if garden.BoundaryGeoJSON == nil {
+		return nil, nil
+	}
+
+	lat, lng, err := gardenRepo.GetCenterPoint(ctx, garden.GardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate garden: %w", err)
+	}
+
+	location := &gardenLocation{lat: lat, lng: lng}
+	country, err := countryRepo.FindByPoint(ctx, lat, lng)
+	if err != nil && !isNotFound(err) {
+		return nil, fmt.Errorf("failed to find garden country: %w", err)
+	}
+	if err == nil {
+		location.country = country
+	}
+
+	return location, nil
+}
+
+// zoneLightAndWater derives the light a zone gets from its summer sun hours and the soil
+// moisture its irrigation keeps. Either is nil when the zone does not record it.
+func zoneLightAndWater(zone *gardenEntity.GardenZone) (*types.SunRequirement, *types.WaterNeeds) {
+	var sun *types.SunRequirement
+	if zone.SunHoursSummer != nil {
+		requirement := entity.SunRequirementForHours(*zone.SunHoursSummer)
+		sun = &requirement
+	}
+
+	var water *types.WaterNeeds
+	if zone.IrrigationType != nil {
+		water = entity.WaterNeedsForIrrigation(*zone.IrrigationType)
+	}
+
+	return sun, water
+}
+
+// isNotFound reports whether a plant data lookup found nothing
+func isNotFound(err error) bool {
+	var notFound *plantEntity.NotFoundError
+	return errors.As(err, &notFound)
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"twigger-backend/backend/planning-service/domain/entity"
+	"twigger-backend/backend/shared/geometry"
+)
+
+// Geometry helpers for zone fill grids.
+// Grids are laid out in a local planar frame (meters east/north of the
+// zone's first vertex), which is accurate enough at garden scale.
+
+// maxGridCells bounds how many grid positions one fill may test, however few fit the zone
+const maxGridCells = 100_000
+
+// localPolygon is a polygon's outer ring and holes in the local frame
+type localPolygon struct {
+	outer []geometry.Point
+	holes [][]geometry.Point
+}
+
+// parseZonePolygons reads a Polygon or MultiPolygon zone geometry into the local frame
+// of its first vertex
+func parseZonePolygons(geojsonStr string) ([]localPolygon, *geometry.LocalProjection, error) {
+	var geom struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	if err := json.Unmarshal([]byte(geojsonStr), &geom); err != nil {
+		return nil, nil, fmt.Errorf("invalid geojson: %w", err)
+	}
+
+	var polygons [][][][]float64
+	switch geom.Type {
+	case "Polygon":
+		var coords [][][]float64
+		if err := json.Unmarshal(geom.Coordinates, &coords); err != nil {
+			return nil, nil, fmt.Errorf("invalid polygon coordinates")
+		}
+		polygons = [][][][]float64{coords}
+	case "MultiPolygon":
+		if err := json.Unmarshal(geom.Coordinates, &polygons); err != nil {
+			return nil, nil, fmt.Errorf("invalid multipolygon coordinates")
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported zone geometry type: %s", geom.Type)
+	}
+
+	if len(polygons) == 0 || len(polygons[0]) == 0 || len(polygons[0][0]) == 0 || len(polygons[0][0][0]) < 2 {
+		return nil, nil, fmt.Errorf("zone geometry has no coordinates")
+	}
+	first := polygons[0][0][0]
+	proj := geometry.NewLocalProjection(first[1], first[0])
+
+	toRing := func(coords [][]float64) []geometry.Point {
+		ring := make([]geometry.Point, 0, len(coords))
+		for _, c := range coords {
+			if len(c) >= 2 {
+				ring = append(ring, proj.ToLocal(c[0], c[1]))
+			}
+		}
+		return ring
+	}
+
+	result := make([]localPolygon, 0, len(polygons))
+	for _, polygon := range polygons {
+		if len(polygon) == 0 {
+			continue
+		}
+		local := localPolygon{outer: toRing(polygon[0])}
+		for _, hole := range polygon[1:] {
+			local.holes = append(local.holes, toRing(hole))
+		}
+		result = append(result, local)
+	}
+
+	return result, proj, nil
+}
+
+// gridPoints lays out a grid over the polygons' bounding box and keeps the points that
+// lie inside a polygon at least inset meters from every edge.
+// rowStep separates rows; every other row shifts by rowOffset.
+func gridPoints(polygons []localPolygon, spacing, rowStep, rowOffset, inset float64, limit int) ([]geometry.Point, error) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, polygon := range polygons {
+		for _, p := range polygon.outer {
+			minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+			minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+		}
+	}
+	if math.IsInf(minX, 0) {
+		return nil, nil
+	}
+
+	// Refuse before scanning: a tiny step over a large zone would test millions of positions
+	cells := (math.Floor((maxX-minX)/spacing) + 1) * (math.Floor((maxY-minY)/rowStep) + 1)
+	if spacing <= 0 || rowStep <= 0 || cells > maxGridCells {
+		return nil, fmt.Errorf("the zone is too large for this spacing; use a wider spacing")
+	}
+
+	// Start each row at the inset so plants line up with the zone's corner
+	var points []geometry.Point
+	row := 0
+	for y := minY + inset; y <= maxY-inset+1e-9; y += rowStep {
+		startX := minX + inset
+		if row%2 == 1 {
+			startX += rowOffset
+		}
+		for x := startX; x <= maxX-inset+1e-9; x += spacing {
+			pt := geometry.Point{X: x, Y: y}
+			if !insidePolygons(pt, polygons, inset) {
+				continue
+			}
+			if len(points) == limit {
+				return nil, fmt.Errorf("the grid needs more than %d plants; use a wider spacing", limit)
+			}
+			points = append(points, pt)
+		}
+		row++
+	}
+
+	return points, nil
+}
+
+// insidePolygons tests whether a point lies inside one of the polygons, outside its holes,
+// and at least inset meters from all of its edges
+func insidePolygons(pt geometry.Point, polygons []localPolygon, inset float64) bool {
+	for _, polygon := range polygons {
+		if !geometry.PointInRing(pt, polygon.outer) {
+			continue
+		}
+
+		inHole := false
+		for _, hole := range polygon.holes {
+			if geometry.PointInRing(pt, hole) {
+				inHole = true
+				break
+			}
+		}
+		if inHole {
+			continue
+		}
+
+		clear := ringDistance(pt, polygon.outer) >= inset-1e-9
+		for _, hole := range polygon.holes {
+			clear = clear && ringDistance(pt, hole) >= inset-1e-9
+		}
+		if clear {
+			return true
+		}
+	}
+	return false
+}
+
+// ringDistance returns the distance from a point to the nearest edge of a ring
+func ringDistance(pt geometry.Point, ring []geometry.Point) float64 {
+	nearest := math.Inf(1)
+	n := len(ring)
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		nearest = math.Min(nearest, segmentDistance(pt, ring[j], ring[i]))
+	}
+	return nearest
+}
+
+// segmentDistance returns the distance from a point to the segment a-b
+func segmentDistance(pt, a, b geometry.Point) float64 {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	lengthSq := dx*dx + dy*dy
+	if lengthSq == 0 {
+		return math.Hypot(pt.X-a.X, pt.Y-a.Y)
+	}
+
+	t := ((pt.X-a.X)*dx + (pt.Y-a.Y)*dy) / lengthSq
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(pt.X-(a.X+t*dx), pt.Y-(a.Y+t*dy))
+}
+
+// toGridPoints converts local points back to lon/lat
+func toGridPoints(points []geometry.Point, proj *geometry.LocalProjection) []entity.GridPoint {
+	result := make([]entity.GridPoint, len(points))
+	for i, pt := range points {
+		lng, lat := proj.ToLngLat(pt)
+		result[i] = entity.GridPoint{Lng: lng, Lat: lat}
+	}
+	return result
+}
@@ -0,0 +1,469 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/planning-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/constants"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+const (
+	// defaultAntagonistDistanceM keeps antagonists apart when a relationship has no max distance
+	defaultAntagonistDistanceM = 2.0
+
+	// defaultCompanionDistanceM is how close companions must grow to help each other
+	// when a relationship has no optimal distance
+	defaultCompanionDistanceM = 1.0
+
+	// maxNeighbourRadiusM caps the spatial search for related neighbours
+	maxNeighbourRadiusM = 30.0
+
+	// spacingSearchRadiusM is how far from a placement neighbours are checked for spacing.
+	// Plants with a wider spread search that far instead.
+	spacingSearchRadiusM = 10.0
+)
+
+const (
+	// WarningCodeAntagonisticNeighbour marks placement warnings raised for antagonistic neighbours
+	WarningCodeAntagonisticNeighbour = "antagonistic_neighbour"
+
+	// WarningCodeSpacingConflict marks placement warnings raised for plants closer than their mature spreads allow
+	WarningCodeSpacingConflict = "spacing_conflict"
+)
+
+// PlacementAdvisorService checks new placements against the plants already in the garden
+type PlacementAdvisorService interface {
+	// CheckPlacement warns about antagonistic plants nearby, or rejects the placement when
+	// the plant asks for it, and lists beneficial neighbours within their optimal distance.
+	// Neighbours closer than the plants' mature spreads allow are also warned about.
+	// It satisfies the garden service's placement check hook.
+	CheckPlacement(ctx context.Context, gardenPlant *gardenEntity.GardenPlant) ([]gardenEntity.PlacementWarning, error)
+
+	// CheckBulkPlacement checks each plant like CheckPlacement and also checks the spacing
+	// of the batch's plants against each other. Warnings are indexed like gardenPlants.
+	CheckBulkPlacement(ctx context.Context, gardenPlants []*gardenEntity.GardenPlant) ([][]gardenEntity.PlacementWarning, error)
+
+	// GetLayoutReport lists every pair of active plants in a garden growing closer than
+	// their mature spreads allow
+	GetLayoutReport(ctx context.Context, gardenID string) (*entity.LayoutReport, error)
+}
+
+// placementAdvisorService implements PlacementAdvisorService
+type placementAdvisorService struct {
+	gardenRepo      gardenRepository.GardenRepository
+	gardenPlantRepo gardenRepository.GardenPlantRepository
+	plantRepo       plantRepository.PlantRepository
+}
+
+// NewPlacementAdvisorService creates a new placement advisor service instance
+func NewPlacementAdvisorService(
+	gardenRepo gardenRepository.GardenRepository,
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+	plantRepo plantRepository.PlantRepository,
+) PlacementAdvisorService {
+	return &placementAdvisorService{
+		gardenRepo:      gardenRepo,
+		gardenPlantRepo: gardenPlantRepo,
+		plantRepo:       plantRepo,
+	}
+}
+
+// CheckPlacement runs the companion planting and spacing checks for a new placement
+func (s *placementAdvisorService) CheckPlacement(ctx context.Context, gardenPlant *gardenEntity.GardenPlant) ([]gardenEntity.PlacementWarning, error) {
+	warnings, err := s.checkCompanions(ctx, gardenPlant)
+	if err != nil {
+		return nil, err
+	}
+
+	spacingWarnings, err := s.checkSpacing(ctx, gardenPlant)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(warnings, spacingWarnings...), nil
+}
+
+// CheckBulkPlacement checks each plant against the garden, then the batch's spacing against itself
+func (s *placementAdvisorService) CheckBulkPlacement(ctx context.Context, gardenPlants []*gardenEntity.GardenPlant) ([][]gardenEntity.PlacementWarning, error) {
+	warnings := make([][]gardenEntity.PlacementWarning, len(gardenPlants))
+	for i, gardenPlant := range gardenPlants {
+		plantWarnings, err := s.CheckPlacement(ctx, gardenPlant)
+		if err != nil {
+			return nil, fmt.Errorf("placement check failed for plant at index %d: %w", i, err)
+		}
+		warnings[i] = plantWarnings
+	}
+
+	if len(gardenPlants) < 2 {
+		return warnings, nil
+	}
+
+	plantIDs := make([]string, len(gardenPlants))
+	locations := make([]string, len(gardenPlants))
+	for i, gardenPlant := range gardenPlants {
+		plantIDs[i] = gardenPlant.PlantID
+		locations[i] = gardenPlant.LocationGeoJSON
+	}
+
+	spacing, err := s.loadPlantSpacing(ctx, plantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	searchRadiusM := widestSpread(spacing)
+	if searchRadiusM == 0 {
+		return warnings, nil
+	}
+
+	pairs, err := s.gardenPlantRepo.PairwiseDistances(ctx, locations, searchRadiusM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to measure distances: %w", err)
+	}
+
+	for _, pair := range pairs {
+		a := newSpacedPlant(gardenPlants[pair.I].GardenPlantID, gardenPlants[pair.I].PlantID, spacing)
+		b := newSpacedPlant(gardenPlants[pair.J].GardenPlantID, gardenPlants[pair.J].PlantID, spacing)
+		conflict := entity.NewSpacingConflict(a, b, pair.DistanceM)
+		if conflict == nil {
+			continue
+		}
+		warnings[pair.I] = append(warnings[pair.I], spacingWarning(b, conflict))
+		warnings[pair.J] = append(warnings[pair.J], spacingWarning(a, conflict))
+	}
+
+	return warnings, nil
+}
+
+// GetLayoutReport lists every overcrowded pair of active plants in a garden
+func (s *placementAdvisorService) GetLayoutReport(ctx context.Context, gardenID string) (*entity.LayoutReport, error) {
+	if gardenID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	if _, err := s.gardenRepo.FindByID(ctx, gardenID); err != nil {
+		return nil, fmt.Errorf("garden not found: %w", err)
+	}
+
+	gardenPlants, err := s.gardenPlantRepo.FindActiveInGarden(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list garden plants: %w", err)
+	}
+
+	report := &entity.LayoutReport{
+		GardenID:      gardenID,
+		PlantsChecked: len(gardenPlants),
+		Conflicts:     []*entity.SpacingConflict{},
+		GeneratedAt:   time.Now(),
+	}
+	if len(gardenPlants) < 2 {
+		return report, nil
+	}
+
+	plantIDs := make([]string, len(gardenPlants))
+	for i, gardenPlant := range gardenPlants {
+		plantIDs[i] = gardenPlant.PlantID
+	}
+
+	spacing, err := s.loadPlantSpacing(ctx, plantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gardenPlant := range gardenPlants {
+		if info, ok := spacing[gardenPlant.PlantID]; !ok || info.spreadM == nil {
+			report.PlantsWithoutSpread++
+		}
+	}
+
+	searchRadiusM := widestSpread(spacing)
+	if searchRadiusM == 0 {
+		return report, nil
+	}
+
+	pairs, err := s.gardenPlantRepo.FindClosePairs(ctx, gardenID, searchRadiusM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find close plants: %w", err)
+	}
+
+	for _, pair := range pairs {
+		a := newSpacedPlant(pair.GardenPlantAID, pair.PlantAID, spacing)
+		b := newSpacedPlant(pair.GardenPlantBID, pair.PlantBID, spacing)
+		if conflict := entity.NewSpacingConflict(a, b, pair.DistanceM); conflict != nil {
+			report.Conflicts = append(report.Conflicts, conflict)
+		}
+	}
+
+	sort.SliceStable(report.Conflicts, func(i, j int) bool {
+		return report.Conflicts[i].OverlapM > report.Conflicts[j].OverlapM
+	})
+
+	return report, nil
+}
+
+// checkCompanions finds related plants near the placement
+func (s *placementAdvisorService) checkCompanions(ctx context.Context, gardenPlant *gardenEntity.GardenPlant) ([]gardenEntity.PlacementWarning, error) {
+	companions, err := s.plantRepo.GetCompanions(ctx, gardenPlant.PlantID, constants.EnglishLanguageID, nil, &plantEntity.CompanionFilter{
+		PlantID:        &gardenPlant.PlantID,
+		ExcludeNeutral: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load companions: %w", err)
+	}
+
+	// Index relationships by the other plant and size the search to the widest one
+	relationships := make(map[string]*plantEntity.Companion)
+	radiusM := 0.0
+	for _, companion := range companions {
+		otherID, err := companion.GetOtherPlantID(gardenPlant.PlantID)
+		if err != nil {
+			continue
+		}
+		relationships[otherID] = companion
+		if reach := relationshipReachM(companion); reach > radiusM {
+			radiusM = reach
+		}
+	}
+	if len(relationships) == 0 {
+		return nil, nil
+	}
+	if radiusM > maxNeighbourRadiusM {
+		radiusM = maxNeighbourRadiusM
+	}
+
+	nearby, err := s.gardenPlantRepo.FindNearby(ctx, gardenPlant.GardenID, gardenPlant.LocationGeoJSON, radiusM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load nearby plants: %w", err)
+	}
+
+	var warnings []gardenEntity.PlacementWarning
+	for _, near := range nearby {
+		if near.GardenPlant.GardenPlantID == gardenPlant.GardenPlantID {
+			continue
+		}
+
+		companion, ok := relationships[near.GardenPlant.PlantID]
+		if !ok || near.DistanceM > relationshipReachM(companion) {
+			continue
+		}
+
+		neighbour := newPlacementNeighbour(near, companion, gardenPlant.PlantID)
+		if companion.IsAntagonistic() {
+			warnings = append(warnings, antagonistWarning(neighbour, relationshipReachM(companion)))
+		} else {
+			gardenPlant.BeneficialNeighbours = append(gardenPlant.BeneficialNeighbours, neighbour)
+		}
+	}
+
+	if len(warnings) > 0 && gardenPlant.RejectAntagonists {
+		return nil, gardenEntity.NewPlacementRejectedError(
+			fmt.Sprintf("%d antagonistic plant(s) are too close", len(warnings)),
+			warnings,
+		)
+	}
+
+	return warnings, nil
+}
+
+// checkSpacing warns about existing plants closer than the two plants' mature spreads allow
+func (s *placementAdvisorService) checkSpacing(ctx context.Context, gardenPlant *gardenEntity.GardenPlant) ([]gardenEntity.PlacementWarning, error) {
+	spacing, err := s.loadPlantSpacing(ctx, []string{gardenPlant.PlantID})
+	if err != nil {
+		return nil, err
+	}
+
+	searchRadiusM := spacingSearchRadiusM
+	if spread := spacing[gardenPlant.PlantID].spreadM; spread != nil && *spread > searchRadiusM {
+		searchRadiusM = math.Min(*spread, maxNeighbourRadiusM)
+	}
+
+	nearby, err := s.gardenPlantRepo.FindNearby(ctx, gardenPlant.GardenID, gardenPlant.LocationGeoJSON, searchRadiusM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load nearby plants: %w", err)
+	}
+
+	var neighbourIDs []string
+	for _, near := range nearby {
+		if _, ok := spacing[near.GardenPlant.PlantID]; !ok {
+			neighbourIDs = append(neighbourIDs, near.GardenPlant.PlantID)
+		}
+	}
+	neighbourSpacing, err := s.loadPlantSpacing(ctx, neighbourIDs)
+	if err != nil {
+		return nil, err
+	}
+	for plantID, info := range neighbourSpacing {
+		spacing[plantID] = info
+	}
+
+	placed := newSpacedPlant(gardenPlant.GardenPlantID, gardenPlant.PlantID, spacing)
+
+	var warnings []gardenEntity.PlacementWarning
+	for _, near := range nearby {
+		if near.GardenPlant.GardenPlantID == gardenPlant.GardenPlantID {
+			continue
+		}
+
+		neighbour := newSpacedPlant(near.GardenPlant.GardenPlantID, near.GardenPlant.PlantID, spacing)
+		if conflict := entity.NewSpacingConflict(placed, neighbour, near.DistanceM); conflict != nil {
+			warnings = append(warnings, spacingWarning(neighbour, conflict))
+		}
+	}
+
+	return warnings, nil
+}
+
+// plantSpacing holds the name and typical mature spread of a plant species
+type plantSpacing struct {
+	name    string
+	spreadM *float64
+}
+
+// loadPlantSpacing loads names and mature spreads for the distinct plants in plantIDs.
+// Plants without physical characteristics get a nil spread.
+func (s *placementAdvisorService) loadPlantSpacing(ctx context.Context, plantIDs []string) (map[string]*plantSpacing, error) {
+	spacing := make(map[string]*plantSpacing)
+	var distinct []string
+	for _, plantID := range plantIDs {
+		if _, ok := spacing[plantID]; !ok {
+			spacing[plantID] = &plantSpacing{}
+			distinct = append(distinct, plantID)
+		}
+	}
+	if len(distinct) == 0 {
+		return spacing, nil
+	}
+
+	plants, err := s.plantRepo.FindByIDs(ctx, distinct, constants.EnglishLanguageID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plants: %w", err)
+	}
+	for _, plant := range plants {
+		if info, ok := spacing[plant.PlantID]; ok {
+			info.name = plant.FullBotanicalName
+		}
+	}
+
+	for _, plantID := range distinct {
+		characteristics, err := s.plantRepo.GetPhysicalCharacteristics(ctx, plantID, constants.EnglishLanguageID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load physical characteristics for plant %s: %w", plantID, err)
+		}
+		if characteristics != nil {
+			spacing[plantID].spreadM = entity.TypicalSpreadM(characteristics.MatureSpread)
+		}
+	}
+
+	return spacing, nil
+}
+
+// widestSpread returns the largest known spread, capped to bound spatial searches.
+// No pair of the plants can need more room than this.
+func widestSpread(spacing map[string]*plantSpacing) float64 {
+	widest := 0.0
+	for _, info := range spacing {
+		if info.spreadM != nil && *info.spreadM > widest {
+			widest = *info.spreadM
+		}
+	}
+	return math.Min(widest, maxNeighbourRadiusM)
+}
+
+// newSpacedPlant describes a garden plant with its species' spacing
+func newSpacedPlant(gardenPlantID, plantID string, spacing map[string]*plantSpacing) entity.SpacedPlant {
+	spaced := entity.SpacedPlant{
+		GardenPlantID: gardenPlantID,
+		PlantID:       plantID,
+	}
+	if info, ok := spacing[plantID]; ok {
+		spaced.PlantName = info.name
+		spaced.MatureSpreadM = info.spreadM
+	}
+	return spaced
+}
+
+// spacingWarning builds the placement warning for a neighbour that is too close
+func spacingWarning(neighbour entity.SpacedPlant, conflict *entity.SpacingConflict) gardenEntity.PlacementWarning {
+	name := neighbour.PlantName
+	if name == "" {
+		name = neighbour.PlantID
+	}
+
+	return gardenEntity.PlacementWarning{
+		Code: WarningCodeSpacingConflict,
+		Message: fmt.Sprintf("%s is %.1f m away; their mature spreads need %.1f m",
+			name, conflict.DistanceM, conflict.RequiredSpacingM),
+		Details: map[string]interface{}{
+			"garden_plant_id":    neighbour.GardenPlantID,
+			"plant_id":           neighbour.PlantID,
+			"plant_name":         neighbour.PlantName,
+			"distance_m":         conflict.DistanceM,
+			"required_spacing_m": conflict.RequiredSpacingM,
+		},
+	}
+}
+
+// relationshipReachM returns the distance within which a relationship takes effect:
+// antagonists interfere up to their max distance, companions help up to their optimal distance
+func relationshipReachM(companion *plantEntity.Companion) float64 {
+	if companion.IsAntagonistic() {
+		if companion.MaxDistanceM != nil {
+			return *companion.MaxDistanceM
+		}
+		if companion.OptimalDistanceM != nil {
+			return *companion.OptimalDistanceM
+		}
+		return defaultAntagonistDistanceM
+	}
+
+	if companion.OptimalDistanceM != nil {
+		return *companion.OptimalDistanceM
+	}
+	return defaultCompanionDistanceM
+}
+
+// newPlacementNeighbour describes a nearby plant and its relationship to the placed plant
+func newPlacementNeighbour(near *gardenRepository.NearbyGardenPlant, companion *plantEntity.Companion, plantID string) gardenEntity.PlacementNeighbour {
+	neighbour := gardenEntity.PlacementNeighbour{
+		GardenPlantID:    near.GardenPlant.GardenPlantID,
+		PlantID:          near.GardenPlant.PlantID,
+		RelationshipType: string(companion.RelationshipType),
+		Benefits:         companion.Benefits,
+		DistanceM:        near.DistanceM,
+		OptimalDistanceM: companion.OptimalDistanceM,
+		MaxDistanceM:     companion.MaxDistanceM,
+	}
+	if plant, err := companion.GetOtherPlant(plantID); err == nil {
+		neighbour.PlantName = plant.FullBotanicalName
+	}
+	return neighbour
+}
+
+// antagonistWarning builds the placement warning for an antagonistic neighbour
+func antagonistWarning(neighbour gardenEntity.PlacementNeighbour, minDistanceM float64) gardenEntity.PlacementWarning {
+	name := neighbour.PlantName
+	if name == "" {
+		name = neighbour.PlantID
+	}
+
+	return gardenEntity.PlacementWarning{
+		Code: WarningCodeAntagonisticNeighbour,
+		Message: fmt.Sprintf("%s is %.1f m away; antagonistic plants should be at least %.1f m apart",
+			name, neighbour.DistanceM, minDistanceM),
+		Details: map[string]interface{}{
+			"garden_plant_id": neighbour.GardenPlantID,
+			"plant_id":        neighbour.PlantID,
+			"plant_name":      neighbour.PlantName,
+			"distance_m":      neighbour.DistanceM,
+			"min_distance_m":  minDistanceM,
+		},
+	}
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// spatialGardenPlantRepository adds the spatial queries to the shared stub, serving nearby plants and distances from memory
+type spatialGardenPlantRepository struct {
+	mocks.StubGardenPlantRepository
+	nearby    []*gardenRepository.NearbyGardenPlant
+	pairs     []*gardenRepository.GardenPlantPair
+	distances []*gardenRepository.LocationPair
+	radii     []float64
+	err       error
+}
+
+func (s *spatialGardenPlantRepository) FindActiveInGarden(ctx context.Context, gardenID string) ([]*gardenEntity.GardenPlant, error) {
+	var result []*gardenEntity.GardenPlant
+	for _, near := range s.nearby {
+		result = append(result, near.GardenPlant)
+	}
+	return result, nil
+}
+
+func (s *spatialGardenPlantRepository) FindClosePairs(ctx context.Context, gardenID string, maxDistanceM float64) ([]*gardenRepository.GardenPlantPair, error) {
+	var result []*gardenRepository.GardenPlantPair
+	for _, pair := range s.pairs {
+		if pair.DistanceM <= maxDistanceM {
+			result = append(result, pair)
+		}
+	}
+	return result, nil
+}
+
+func (s *spatialGardenPlantRepository) PairwiseDistances(ctx context.Context, locationsGeoJSON []string, maxDistanceM float64) ([]*gardenRepository.LocationPair, error) {
+	var result []*gardenRepository.LocationPair
+	for _, pair := range s.distances {
+		if pair.DistanceM <= maxDistanceM {
+			result = append(result, pair)
+		}
+	}
+	return result, nil
+}
+
+func (s *spatialGardenPlantRepository) FindNearby(ctx context.Context, gardenID, locationGeoJSON string, radiusM float64) ([]*gardenRepository.NearbyGardenPlant, error) {
+	s.radii = append(s.radii, radiusM)
+	if s.err != nil {
+		return nil, s.err
+	}
+	var result []*gardenRepository.NearbyGardenPlant
+	for _, near := range s.nearby {
+		if near.DistanceM <= radiusM {
+			result = append(result, near)
+		}
+	}
+	return result, nil
+}
+
+// catalogPlantRepository resolves any plant ID and serves companion relationships and spreads from memory; other methods are not used by the advisor
+type catalogPlantRepository struct {
+	plantRepository.PlantRepository
+	companions []*plantEntity.Companion
+	spreads    map[string]float64
+	candidates []*plantEntity.Plant
+	conditions map[string]*types.GrowingConditions
+	filter     *plantRepository.GrowingConditionsFilter
+}
+
+func (s *catalogPlantRepository) FindByGrowingConditions(ctx context.Context, filter *plantRepository.GrowingConditionsFilter) ([]*plantEntity.Plant, error) {
+	s.filter = filter
+	return s.candidates, nil
+}
+
+func (s *catalogPlantRepository) GetGrowingConditions(ctx context.Context, plantID, countryID, languageID string) (*types.GrowingConditions, error) {
+	if gc, ok := s.conditions[plantID]; ok {
+		return gc, nil
+	}
+	return nil, plantEntity.NewNotFoundError("growing conditions", plantID)
+}
+
+func (s *catalogPlantRepository) FindByID(ctx context.Context, plantID, languageID string, countryID *string) (*plantEntity.Plant, error) {
+	if plantID == "plant-missing" {
+		return nil, plantEntity.NewNotFoundError("plant", plantID)
+	}
+	return &plantEntity.Plant{PlantID: plantID, FullBotanicalName: "Botanical " + plantID}, nil
+}
+
+func (s *catalogPlantRepository) FindByIDs(ctx context.Context, plantIDs []string, languageID string, countryID *string) ([]*plantEntity.Plant, error) {
+	var result []*plantEntity.Plant
+	for _, id := range plantIDs {
+		result = append(result, &plantEntity.Plant{PlantID: id, FullBotanicalName: "Botanical " + id})
+	}
+	return result, nil
+}
+
+func (s *catalogPlantRepository) GetPhysicalCharacteristics(ctx context.Context, plantID, languageID string) (*types.PhysicalCharacteristics, error) {
+	spread, ok := s.spreads[plantID]
+	if !ok {
+		return nil, nil
+	}
+	return &types.PhysicalCharacteristics{PlantID: plantID, MatureSpread: &types.SizeRange{TypicalM: &spread}}, nil
+}
+
+func (s *catalogPlantRepository) GetCompanions(ctx context.Context, plantID, languageID string, countryID *string, filter *plantEntity.CompanionFilter) ([]*plantEntity.Companion, error) {
+	var result []*plantEntity.Companion
+	for _, companion := range s.companions {
+		if filter.Matches(companion) {
+			result = append(result, companion)
+		}
+	}
+	return result, nil
+}
+
+func nearbyPlant(gardenPlantID, plantID string, distanceM float64) *gardenRepository.NearbyGardenPlant {
+	return &gardenRepository.NearbyGardenPlant{
+		GardenPlant: &gardenEntity.GardenPlant{GardenPlantID: gardenPlantID, GardenID: "garden-123", PlantID: plantID},
+		DistanceM:   distanceM,
+	}
+}
+
+// setupPlacementAdvisor places tomatoes near basil (beneficial within 0.5 m)
+// and fennel (antagonistic within 1.5 m)
+func setupPlacementAdvisor() (*placementAdvisorService, *spatialGardenPlantRepository) {
+	gardenPlants := &spatialGardenPlantRepository{nearby: []*gardenRepository.NearbyGardenPlant{
+		nearbyPlant("gp-basil", "plant-basil", 0.3),
+		nearbyPlant("gp-fennel", "plant-fennel", 1.2),
+		nearbyPlant("gp-basil-far", "plant-basil", 0.9),
+		nearbyPlant("gp-carrot", "plant-carrot", 0.2),
+	}}
+
+	service := &placementAdvisorService{
+		gardenRepo:      mocks.NewStubGardenRepository(&gardenEntity.Garden{GardenID: "garden-123"}, nil),
+		gardenPlantRepo: gardenPlants,
+		plantRepo: &catalogPlantRepository{companions: []*plantEntity.Companion{
+			{
+				RelationshipID:   "rel-basil",
+				PlantAID:         "plant-tomato",
+				PlantBID:         "plant-basil",
+				RelationshipType: types.RelationshipBeneficial,
+				Benefits:         []string{plantEntity.BenefitPestControl},
+				OptimalDistanceM: mocks.FloatPtr(0.5),
+				PlantB:           &plantEntity.Plant{PlantID: "plant-basil", FullBotanicalName: "Ocimum basilicum"},
+			},
+			{
+				RelationshipID:   "rel-fennel",
+				PlantAID:         "plant-fennel",
+				PlantBID:         "plant-tomato",
+				RelationshipType: types.RelationshipAntagonistic,
+				MaxDistanceM:     mocks.FloatPtr(1.5),
+				PlantA:           &plantEntity.Plant{PlantID: "plant-fennel", FullBotanicalName: "Foeniculum vulgare"},
+			},
+			{
+				RelationshipID:   "rel-carrot",
+				PlantAID:         "plant-tomato",
+				PlantBID:         "plant-carrot",
+				RelationshipType: types.RelationshipNeutral,
+			},
+		}},
+	}
+	return service, gardenPlants
+}
+
+func newTomato() *gardenEntity.GardenPlant {
+	return &gardenEntity.GardenPlant{
+		GardenID:        "garden-123",
+		PlantID:         "plant-tomato",
+		LocationGeoJSON: `{"type":"Point","coordinates":[-122.4194,37.7749]}`,
+		Quantity:        1,
+	}
+}
+
+func TestPlacementAdvisorService_CheckPlacement_WarnsAndListsCompanions(t *testing.T) {
+	service, gardenPlants := setupPlacementAdvisor()
+	tomato := newTomato()
+
+	warnings, err := service.CheckPlacement(context.Background(), tomato)
+
+	require.NoError(t, err)
+	assert.Contains(t, gardenPlants.radii, 1.5)
+
+	require.Len(t, warnings, 1)
+	assert.Equal(t, WarningCodeAntagonisticNeighbour, warnings[0].Code)
+	assert.Equal(t, "gp-fennel", warnings[0].Details["garden_plant_id"])
+	assert.Contains(t, warnings[0].Message, "Foeniculum vulgare")
+
+	// Basil beyond its optimal distance and neutral carrots are not listed
+	require.Len(t, tomato.BeneficialNeighbours, 1)
+	assert.Equal(t, "gp-basil", tomato.BeneficialNeighbours[0].GardenPlantID)
+	assert.Equal(t, "Ocimum basilicum", tomato.BeneficialNeighbours[0].PlantName)
+	assert.Equal(t, []string{plantEntity.BenefitPestControl}, tomato.BeneficialNeighbours[0].Benefits)
+}
+
+func TestPlacementAdvisorService_CheckPlacement_RejectsAntagonists(t *testing.T) {
+	service, _ := setupPlacementAdvisor()
+	tomato := newTomato()
+	tomato.RejectAntagonists = true
+
+	_, err := service.CheckPlacement(context.Background(), tomato)
+
+	var rejected *gardenEntity.PlacementRejectedError
+	require.True(t, errors.As(err, &rejected))
+	require.Len(t, rejected.Warnings, 1)
+	assert.Equal(t, "gp-fennel", rejected.Warnings[0].Details["garden_plant_id"])
+}
+
+func TestPlacementAdvisorService_CheckPlacement_LookupFailure(t *testing.T) {
+	t.Run("fails when warning", func(t *testing.T) {
+		service, gardenPlants := setupPlacementAdvisor()
+		gardenPlants.err = errors.New("connection refused")
+
+		_, err := service.CheckPlacement(context.Background(), newTomato())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "connection refused")
+	})
+
+	t.Run("fails when rejecting", func(t *testing.T) {
+		service, gardenPlants := setupPlacementAdvisor()
+		gardenPlants.err = errors.New("connection refused")
+		tomato := newTomato()
+		tomato.RejectAntagonists = true
+
+		_, err := service.CheckPlacement(context.Background(), tomato)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "nearby plants")
+	})
+}
+
+func TestPlacementAdvisorService_CheckPlacement_NoRelationships(t *testing.T) {
+	service, gardenPlants := setupPlacementAdvisor()
+	plant := newTomato()
+	plant.PlantID = "plant-potato"
+
+	warnings, err := service.CheckPlacement(context.Background(), plant)
+
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Empty(t, plant.BeneficialNeighbours)
+	assert.Equal(t, []float64{spacingSearchRadiusM}, gardenPlants.radii, "only the spacing search runs without relationships")
+}
+
+func TestPlacementAdvisorService_CheckPlacement_SpacingConflicts(t *testing.T) {
+	service, _ := setupPlacementAdvisor()
+	service.plantRepo.(*catalogPlantRepository).spreads = map[string]float64{
+		"plant-tomato": 0.6,
+		"plant-fennel": 1.0, // Needs 0.8 m from a tomato: 1.2 m is fine
+		"plant-carrot": 0.2, // Needs 0.4 m from a tomato: 0.2 m is too close
+	}
+	tomato := newTomato()
+
+	warnings, err := service.CheckPlacement(context.Background(), tomato)
+
+	require.NoError(t, err)
+	var spacingWarnings []gardenEntity.PlacementWarning
+	for _, warning := range warnings {
+		if warning.Code == WarningCodeSpacingConflict {
+			spacingWarnings = append(spacingWarnings, warning)
+		}
+	}
+
+	// Basil has no recorded spread, so it needs only half the tomato's spread (0.3 m)
+	// and 0.3 m away is just enough
+	require.Len(t, spacingWarnings, 1)
+	assert.Equal(t, "gp-carrot", spacingWarnings[0].Details["garden_plant_id"])
+	assert.InDelta(t, 0.4, spacingWarnings[0].Details["required_spacing_m"], 1e-9)
+}
+
+func TestPlacementAdvisorService_CheckBulkPlacement_ChecksBatchSpacing(t *testing.T) {
+	service, gardenPlants := setupPlacementAdvisor()
+	gardenPlants.nearby = nil
+	gardenPlants.distances = []*gardenRepository.LocationPair{
+		{I: 0, J: 1, DistanceM: 0.5},
+		{I: 0, J: 2, DistanceM: 1.0},
+		{I: 1, J: 2, DistanceM: 0.5},
+	}
+	service.plantRepo.(*catalogPlantRepository).spreads = map[string]float64{"plant-squash": 1.2}
+
+	batch := []*gardenEntity.GardenPlant{
+		{GardenPlantID: "gp-1", GardenID: "garden-123", PlantID: "plant-squash"},
+		{GardenPlantID: "gp-2", GardenID: "garden-123", PlantID: "plant-squash"},
+		{GardenPlantID: "gp-3", GardenID: "garden-123", PlantID: "plant-squash"},
+	}
+
+	warnings, err := service.CheckBulkPlacement(context.Background(), batch)
+
+	require.NoError(t, err)
+	require.Len(t, warnings, 3)
+	// Squash need 1.2 m between plants: the outer pair at 1.0 m also conflicts
+	assert.Len(t, warnings[0], 2)
+	assert.Len(t, warnings[1], 2)
+	assert.Len(t, warnings[2], 2)
+	assert.Equal(t, "gp-2", warnings[0][0].Details["garden_plant_id"])
+}
+
+func TestPlacementAdvisorService_GetLayoutReport(t *testing.T) {
+	service, gardenPlants := setupPlacementAdvisor()
+	service.plantRepo.(*catalogPlantRepository).spreads = map[string]float64{
+		"plant-basil":  0.4,
+		"plant-fennel": 1.0,
+	}
+	gardenPlants.pairs = []*gardenRepository.GardenPlantPair{
+		{GardenPlantAID: "gp-basil", PlantAID: "plant-basil", GardenPlantBID: "gp-basil-far", PlantBID: "plant-basil", DistanceM: 0.3},
+		{GardenPlantAID: "gp-basil", PlantAID: "plant-basil", GardenPlantBID: "gp-fennel", PlantBID: "plant-fennel", DistanceM: 0.5},
+		{GardenPlantAID: "gp-carrot", PlantAID: "plant-carrot", GardenPlantBID: "gp-fennel", PlantBID: "plant-fennel", DistanceM: 0.6},
+	}
+
+	report, err := service.GetLayoutReport(context.Background(), "garden-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, report.PlantsChecked)
+	assert.Equal(t, 1, report.PlantsWithoutSpread)
+
+	// Basil and fennel need 0.7 m (0.2 overlap); basil pair needs 0.4 m (0.1 overlap);
+	// carrots have no spread so fennel alone needs 0.5 m, which 0.6 m satisfies
+	require.Len(t, report.Conflicts, 2)
+	assert.Equal(t, "gp-fennel", report.Conflicts[0].PlantB.GardenPlantID)
+	assert.InDelta(t, 0.2, report.Conflicts[0].OverlapM, 1e-9)
+	assert.Equal(t, "gp-basil-far", report.Conflicts[1].PlantB.GardenPlantID)
+	assert.Equal(t, "Botanical plant-basil", report.Conflicts[1].PlantA.PlantName)
+}
+
+func TestPlacementAdvisorService_GetLayoutReport_GardenNotFound(t *testing.T) {
+	service, _ := setupPlacementAdvisor()
+
+	_, err := service.GetLayoutReport(context.Background(), "garden-missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/planning-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/shared/constants"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+const (
+	// defaultRecommendations is how many plants a zone recommendation returns by default
+	defaultRecommendations = 10
+
+	// recommendationCandidates is how many plants matching the zone's hard constraints
+	// are ranked before the best are returned
+	recommendationCandidates = 100
+)
+
+// RecommendationService recommends plants suited to a garden zone
+type RecommendationService interface {
+	// RecommendForZone derives growing conditions from a zone and its garden, finds plants
+	// that can grow there and ranks them by how well they match, with a bonus for
+	// companions of the plants already in the zone
+	RecommendForZone(ctx context.Context, zoneID string, limit int) (*entity.ZoneRecommendations, error)
+}
+
+// recommendationService implements RecommendationService
+type recommendationService struct {
+	zoneRepo        gardenRepository.GardenZoneRepository
+	gardenRepo      gardenRepository.GardenRepository
+	gardenPlantRepo gardenRepository.GardenPlantRepository
+	plantRepo       plantRepository.PlantRepository
+	countryRepo     plantRepository.CountryRepository
+}
+
+// NewRecommendationService creates a new recommendation service instance
+func NewRecommendationService(
+	zoneRepo gardenRepository.GardenZoneRepository,
+	gardenRepo gardenRepository.GardenRepository,
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+	plantRepo plantRepository.PlantRepository,
+	countryRepo plantRepository.CountryRepository,
+) RecommendationService {
+	return &recommendationService{
+		zoneRepo:        zoneRepo,
+		gardenRepo:      gardenRepo,
+		gardenPlantRepo: gardenPlantRepo,
+		plantRepo:       plantRepo,
+		countryRepo:     countryRepo,
+	}
+}
+
+// zoneRelations are the relationships between a candidate plant and the plants in a zone
+type zoneRelations struct {
+	companions  []string
+	antagonists []string
+}
+
+// RecommendForZone ranks plants for a zone, best match first
+func (s *recommendationService) RecommendForZone(ctx context.Context, zoneID string, limit int) (*entity.ZoneRecommendations, error) {
+	if zoneID == "" {
+		return nil, domainerrors.NewInvalidInputError("zone_id", "zone ID cannot be empty")
+	}
+
+	if limit <= 0 {
+		limit = defaultRecommendations
+	}
+	if limit > entity.MaxRecommendations {
+		limit = entity.MaxRecommendations
+	}
+
+	zone, err := s.zoneRepo.FindByID(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("zone not found: %w", err)
+	}
+
+	garden, err := s.gardenRepo.FindByID(ctx, zone.GardenID)
+	if err != nil {
+		return nil, fmt.Errorf("garden not found: %w", err)
+	}
+
+	conditions, err := s.zoneConditions(ctx, zone, garden)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &plantRepository.GrowingConditionsFilter{
+		HardinessZone: conditions.HardinessZone,
+		CountryID:     conditions.CountryID,
+		Limit:         recommendationCandidates,
+	}
+	if conditions.SunRequirement != nil {
+		filter.SunRequirements = entity.AcceptableSunRequirements(*conditions.SunRequirement)
+	}
+
+	candidates, err := s.plantRepo.FindByGrowingConditions(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find plants for zone: %w", err)
+	}
+
+	relations, err := s.loadZoneRelations(ctx, zoneID, conditions.CountryID)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]*entity.PlantRecommendation, 0, len(candidates))
+	for _, plant := range candidates {
+		// Conditions are only recorded per country; without one, rank on the filter alone
+		var gc *types.GrowingConditions
+		if conditions.CountryID != nil {
+			gc, err = s.plantRepo.GetGrowingConditions(ctx, plant.PlantID, *conditions.CountryID, constants.EnglishLanguageID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get growing conditions: %w", err)
+			}
+		}
+		recommendations = append(recommendations, scoreRecommendation(plant, gc, &conditions, relations[plant.PlantID]))
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+
+	return &entity.ZoneRecommendations{
+		ZoneID:          zoneID,
+		GardenID:        zone.GardenID,
+		Conditions:      conditions,
+		Recommendations: recommendations,
+	}, nil
+}
+
+// zoneConditions derives growing conditions from the zone and its garden.
+// Gardens without a location, or outside every known country, have no country.
+func (s *recommendationService) zoneConditions(ctx context.Context, zone *gardenEntity.GardenZone, garden *gardenEntity.Garden) (entity.ZoneConditions, error) {
+	conditions := entity.ZoneConditions{
+		SunHoursSummer: zone.SunHoursSummer,
+		SoilType:       zone.SoilType,
+		IrrigationType: zone.IrrigationType,
+		HardinessZone:  garden.HardinessZone,
+	}
+	conditions.SunRequirement, conditions.WaterNeeds = zoneLightAndWater(zone)
+
+	location, err := locateGarden(ctx, s.gardenRepo, s.countryRepo, garden)
+	if err != nil {
+		return conditions, err
+	}
+	conditions.CountryID = location.countryID()
+
+	return conditions, nil
+}
+
+// loadZoneRelations indexes the companion relationships of the plants in a zone by the other plant
+func (s *recommendationService) loadZoneRelations(ctx context.Context, zoneID string, countryID *string) (map[string]*zoneRelations, error) {
+	zonePlants, err := s.gardenPlantRepo.FindByZoneID(ctx, zoneID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load zone plants: %w", err)
+	}
+
+	var distinct []string
+	seen := make(map[string]bool)
+	for _, gp := range zonePlants {
+		if !seen[gp.PlantID] {
+			seen[gp.PlantID] = true
+			distinct = append(distinct, gp.PlantID)
+		}
+	}
+
+	relations := make(map[string]*zoneRelations)
+	if len(distinct) == 0 {
+		return relations, nil
+	}
+
+	names := make(map[string]string)
+	plants, err := s.plantRepo.FindByIDs(ctx, distinct, constants.EnglishLanguageID, countryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load zone plants: %w", err)
+	}
+	for _, plant := range plants {
+		names[plant.PlantID] = plant.GetDisplayName()
+	}
+
+	for _, plantID := range distinct {
+		companions, err := s.plantRepo.GetCompanions(ctx, plantID, constants.EnglishLanguageID, countryID, &plantEntity.CompanionFilter{
+			PlantID:        &plantID,
+			ExcludeNeutral: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load companions for plant %s: %w", plantID, err)
+		}
+
+		name := names[plantID]
+		if name == "" {
+			name = plantID
+		}
+
+		for _, companion := range companions {
+			otherID, err := companion.GetOtherPlantID(plantID)
+			if err != nil {
+				continue
+			}
+			related, ok := relations[otherID]
+			if !ok {
+				related = &zoneRelations{}
+				relations[otherID] = related
+			}
+			if companion.IsAntagonistic() {
+				related.antagonists = append(related.antagonists, name)
+			} else if companion.IsBeneficial() {
+				related.companions = append(related.companions, name)
+			}
+		}
+	}
+
+	return relations, nil
+}
+
+// scoreRecommendation rates how well a plant suits the zone. Factors the zone does not record
+// are left out, so scores stay comparable between well and sparsely described zones.
+func scoreRecommendation(plant *plantEntity.Plant, gc *types.GrowingConditions, conditions *entity.ZoneConditions, related *zoneRelations) *entity.PlantRecommendation {
+	recommendation := &entity.PlantRecommendation{Plant: plant, Matches: []string{}}
+	earned, available := 0, 0
+
+	// Candidates were filtered by hardiness zone
+	if conditions.HardinessZone != nil {
+		available += entity.HardinessMatchPoints
+		earned += entity.HardinessMatchPoints
+		recommendation.Matches = append(recommendation.Matches, entity.MatchHardiness)
+	}
+
+	// Candidates tolerate the zone's light; an exact match scores in full
+	if conditions.SunRequirement != nil {
+		available += entity.SunMatchPoints
+		if gc != nil && gc.HasSunRequirement(*conditions.SunRequirement) {
+			earned += entity.SunMatchPoints
+			recommendation.Matches = append(recommendation.Matches, entity.MatchSun)
+		} else {
+			earned += entity.SunMatchPoints / 2
+		}
+	}
+
+	if conditions.WaterNeeds != nil {
+		available += entity.WaterMatchPoints
+		if gc != nil {
+			distance := -1
+			if gc.WaterNeeds != nil {
+				distance = entity.WaterNeedsDistance(*gc.WaterNeeds, *conditions.WaterNeeds)
+			}
+			droughtProof := *conditions.WaterNeeds == types.WaterDry && gc.DroughtTolerant
+			switch {
+			case distance == 0 || droughtProof:
+				earned += entity.WaterMatchPoints
+				recommendation.Matches = append(recommendation.Matches, entity.MatchWater)
+			case distance == 1:
+				earned += entity.WaterMatchPoints / 2
+			}
+		}
+	}
+
+	if conditions.SoilType != nil {
+		available += entity.SoilMatchPoints
+		if gc != nil && gc.HasSoilType(*conditions.SoilType) {
+			earned += entity.SoilMatchPoints
+			recommendation.Matches = append(recommendation.Matches, entity.MatchSoil)
+		}
+	}
+
+	score := 0
+	if available > 0 {
+		score = earned * 100 / available
+	}
+
+	if related != nil {
+		recommendation.Companions = related.companions
+		recommendation.Antagonists = related.antagonists
+
+		bonus := len(related.companions) * entity.CompanionBonusPoints
+		if bonus > entity.MaxCompanionBonusPoints {
+			bonus = entity.MaxCompanionBonusPoints
+		}
+		score += bonus - len(related.antagonists)*entity.AntagonistPenaltyPoints
+	}
+
+	if score < 0 {
+		score = 0
+	}
+	if score > 100 {
+		score = 100
+	}
+	recommendation.Score = score
+
+	return recommendation
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/planning-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// unitedStates places every point in the United States
+func unitedStates() *mocks.StubCountryRepository {
+	return &mocks.StubCountryRepository{Country: &plantEntity.Country{CountryID: "country-us", CountryCode: "US", CountryName: "United States"}}
+}
+
+func conditions(water types.WaterNeeds, sun []types.SunRequirement, soil ...string) *types.GrowingConditions {
+	return &types.GrowingConditions{SunRequirements: sun, WaterNeeds: &water, SoilTypes: soil}
+}
+
+// setupRecommendationService builds a sunny, drip-irrigated loam bed in zone 7a holding basil.
+// Basil helps tomatoes and hinders fennel.
+func setupRecommendationService() (*recommendationService, *catalogPlantRepository, *mocks.StubGardenRepository) {
+	sunHours := 7
+	drip := gardenEntity.IrrigationDrip
+	fullSun := []types.SunRequirement{types.SunFullSun}
+
+	gardens := mocks.NewStubGardenRepository(&gardenEntity.Garden{GardenID: "garden-123", HardinessZone: mocks.StrPtr("7a")}, []float64{37.77, -122.42})
+	plants := &catalogPlantRepository{
+		candidates: []*plantEntity.Plant{
+			{PlantID: "plant-lavender"},
+			{PlantID: "plant-fennel"},
+			{PlantID: "plant-marigold"},
+			{PlantID: "plant-tomato"},
+		},
+		conditions: map[string]*types.GrowingConditions{
+			"plant-lavender": conditions(types.WaterDry, fullSun, "sandy"),
+			"plant-fennel":   conditions(types.WaterModerate, fullSun, "loam"),
+			"plant-marigold": conditions(types.WaterModerate, []types.SunRequirement{types.SunPartialSun}, "loam"),
+			"plant-tomato":   conditions(types.WaterModerate, fullSun, "loam"),
+		},
+		companions: []*plantEntity.Companion{
+			{PlantAID: "plant-tomato", PlantBID: "plant-basil", RelationshipType: types.RelationshipBeneficial},
+			{PlantAID: "plant-basil", PlantBID: "plant-fennel", RelationshipType: types.RelationshipAntagonistic},
+		},
+	}
+
+	service := &recommendationService{
+		zoneRepo: &mocks.StubGardenZoneRepository{Zones: map[string]*gardenEntity.GardenZone{
+			"zone-bed": {
+				ZoneID:         "zone-bed",
+				GardenID:       "garden-123",
+				SoilType:       mocks.StrPtr("loam"),
+				IrrigationType: &drip,
+				SunHoursSummer: &sunHours,
+			},
+		}},
+		gardenRepo: gardens,
+		gardenPlantRepo: &spatialGardenPlantRepository{StubGardenPlantRepository: mocks.StubGardenPlantRepository{Plants: []*gardenEntity.GardenPlant{
+			{GardenPlantID: "gp-basil", GardenID: "garden-123", ZoneID: mocks.StrPtr("zone-bed"), PlantID: "plant-basil"},
+		}}},
+		plantRepo:   plants,
+		countryRepo: unitedStates(),
+	}
+	return service, plants, gardens
+}
+
+func TestRecommendationService_RecommendForZone_RanksMatches(t *testing.T) {
+	service, plants, _ := setupRecommendationService()
+
+	result, err := service.RecommendForZone(context.Background(), "zone-bed", 10)
+	require.NoError(t, err)
+
+	// Zone constraints become the search filter
+	require.NotNil(t, result.Conditions.SunRequirement)
+	assert.Equal(t, types.SunFullSun, *result.Conditions.SunRequirement)
+	require.NotNil(t, result.Conditions.WaterNeeds)
+	assert.Equal(t, types.WaterModerate, *result.Conditions.WaterNeeds)
+	assert.Equal(t, "7a", *plants.filter.HardinessZone)
+	assert.Equal(t, "country-us", *plants.filter.CountryID)
+	assert.Equal(t, []types.SunRequirement{types.SunFullSun, types.SunPartialSun}, plants.filter.SunRequirements)
+
+	require.Len(t, result.Recommendations, 4)
+	var order []string
+	scores := make(map[string]int)
+	for _, rec := range result.Recommendations {
+		order = append(order, rec.Plant.PlantID)
+		scores[rec.Plant.PlantID] = rec.Score
+	}
+	assert.Equal(t, []string{"plant-tomato", "plant-marigold", "plant-fennel", "plant-lavender"}, order)
+
+	// Tomato matches everything and grows well with basil
+	assert.Equal(t, 100, scores["plant-tomato"])
+	assert.Equal(t, []string{"Botanical plant-basil"}, result.Recommendations[0].Companions)
+	// Marigold only tolerates full sun
+	assert.Equal(t, 85, scores["plant-marigold"])
+	// Fennel matches but is hindered by basil
+	assert.Equal(t, 80, scores["plant-fennel"])
+	assert.Equal(t, []string{"Botanical plant-basil"}, result.Recommendations[2].Antagonists)
+	// Lavender prefers drier, sandier ground
+	assert.Equal(t, 65, scores["plant-lavender"])
+	assert.Equal(t, []string{entity.MatchHardiness, entity.MatchSun}, result.Recommendations[3].Matches)
+}
+
+func TestRecommendationService_RecommendForZone_Limit(t *testing.T) {
+	service, _, _ := setupRecommendationService()
+
+	result, err := service.RecommendForZone(context.Background(), "zone-bed", 2)
+	require.NoError(t, err)
+	require.Len(t, result.Recommendations, 2)
+	assert.Equal(t, "plant-tomato", result.Recommendations[0].Plant.PlantID)
+	assert.Equal(t, "plant-marigold", result.Recommendations[1].Plant.PlantID)
+}
+
+func TestRecommendationService_RecommendForZone_NoCountry(t *testing.T) {
+	service, plants, gardens := setupRecommendationService()
+	gardens.Gardens["garden-123"].BoundaryGeoJSON = nil
+
+	result, err := service.RecommendForZone(context.Background(), "zone-bed", 10)
+	require.NoError(t, err)
+	assert.Nil(t, result.Conditions.CountryID)
+	assert.Nil(t, plants.filter.CountryID)
+
+	// Without per-country conditions only the filtered hardiness and sun tolerance count
+	for _, rec := range result.Recommendations {
+		if rec.Plant.PlantID == "plant-lavender" {
+			assert.Equal(t, 35, rec.Score)
+		}
+	}
+}
+
+func TestRecommendationService_RecommendForZone_Errors(t *testing.T) {
+	service, _, _ := setupRecommendationService()
+
+	_, err := service.RecommendForZone(context.Background(), "", 10)
+	require.Error(t, err)
+
+	_, err = service.RecommendForZone(context.Background(), "zone-missing", 10)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestSunRequirementForHours(t *testing.T) {
+	assert.Equal(t, types.SunFullSun, entity.SunRequirementForHours(8))
+	assert.Equal(t, types.SunFullSun, entity.SunRequirementForHours(6))
+	assert.Equal(t, types.SunPartialSun, entity.SunRequirementForHours(4))
+	assert.Equal(t, types.SunPartialShade, entity.SunRequirementForHours(3))
+	assert.Equal(t, types.SunFullShade, entity.SunRequirementForHours(1))
+}
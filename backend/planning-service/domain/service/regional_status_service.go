@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/planning-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/shared/constants"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// WarningCodeRegionalStatus marks placement warnings raised for restricted or invasive plants
+const WarningCodeRegionalStatus = "regional_status"
+
+// RegionalStatusService guards gardens against plants that are prohibited, restricted or
+// invasive in the garden's country
+type RegionalStatusService interface {
+	// CheckPlacement rejects plants that are prohibited in the garden's country and warns
+	// about restricted or invasive ones. It satisfies the garden service's placement check hook.
+	CheckPlacement(ctx context.Context, gardenPlant *gardenEntity.GardenPlant) ([]gardenEntity.PlacementWarning, error)
+
+	// CheckBulkPlacement checks each plant like CheckPlacement, looking each garden's country
+	// up once. Warnings are indexed like gardenPlants.
+	CheckBulkPlacement(ctx context.Context, gardenPlants []*gardenEntity.GardenPlant) ([][]gardenEntity.PlacementWarning, error)
+
+	// GetRegionalStatusReport lists the active plants in a garden that are prohibited,
+	// restricted or invasive in its country
+	GetRegionalStatusReport(ctx context.Context, gardenID string) (*entity.RegionalStatusReport, error)
+}
+
+// regionalStatusService implements RegionalStatusService
+type regionalStatusService struct {
+	gardenRepo       gardenRepository.GardenRepository
+	gardenPlantRepo  gardenRepository.GardenPlantRepository
+	plantRepo        plantRepository.PlantRepository
+	countryRepo      plantRepository.CountryRepository
+	countryPlantRepo plantRepository.CountryPlantRepository
+}
+
+// NewRegionalStatusService creates a new regional status service instance
+func NewRegionalStatusService(
+	gardenRepo gardenRepository.GardenRepository,
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+	plantRepo plantRepository.PlantRepository,
+	countryRepo plantRepository.CountryRepository,
+	countryPlantRepo plantRepository.CountryPlantRepository,
+) RegionalStatusService {
+	return &regionalStatusService{
+		gardenRepo:       gardenRepo,
+		gardenPlantRepo:  gardenPlantRepo,
+		plantRepo:        plantRepo,
+		countryRepo:      countryRepo,
+		countryPlantRepo: countryPlantRepo,
+	}
+}
+
+// regionalStatuses are the plants of concern in one country, by plant ID
+type regionalStatuses struct {
+	country *plantEntity.Country
+	plants  map[string]*plantEntity.CountryPlant
+}
+
+// CheckPlacement rejects prohibited plants and warns about restricted or invasive ones
+func (s *regionalStatusService) CheckPlacement(ctx context.Context, gardenPlant *gardenEntity.GardenPlant) ([]gardenEntity.PlacementWarning, error) {
+	warnings, err := s.CheckBulkPlacement(ctx, []*gardenEntity.GardenPlant{gardenPlant})
+	if err != nil {
+		return nil, err
+	}
+	return warnings[0], nil
+}
+
+// CheckBulkPlacement checks a batch of placements, rejecting the batch if any plant is prohibited
+func (s *regionalStatusService) CheckBulkPlacement(ctx context.Context, gardenPlants []*gardenEntity.GardenPlant) ([][]gardenEntity.PlacementWarning, error) {
+	warnings := make([][]gardenEntity.PlacementWarning, len(gardenPlants))
+	statusesByGarden := make(map[string]*regionalStatuses)
+
+	// Look up only the plants being placed, one query per garden
+	plantIDsByGarden := make(map[string][]string)
+	for _, gp := range gardenPlants {
+		plantIDsByGarden[gp.GardenID] = append(plantIDsByGarden[gp.GardenID], gp.PlantID)
+	}
+	for gardenID, plantIDs := range plantIDsByGarden {
+		statuses, err := s.placementStatuses(ctx, gardenID, plantIDs)
+		if err != nil {
+			return nil, err
+		}
+		statusesByGarden[gardenID] = statuses
+	}
+
+	var prohibited []gardenEntity.PlacementWarning
+	var prohibitedIn *plantEntity.Country
+	for i, gp := range gardenPlants {
+		statuses := statusesByGarden[gp.GardenID]
+		if statuses == nil {
+			continue
+		}
+
+		countryPlant := statuses.plants[gp.PlantID]
+		status, concern := entity.RegionalStatusOf(countryPlant)
+		if !concern {
+			continue
+		}
+
+		warning := regionalStatusWarning(s.plantName(ctx, gp.PlantID), status, countryPlant, statuses.country)
+		if status == entity.RegionalStatusProhibited {
+			prohibited = append(prohibited, warning)
+			prohibitedIn = statuses.country
+			continue
+		}
+		warnings[i] = append(warnings[i], warning)
+	}
+
+	if len(prohibited) > 0 {
+		return nil, gardenEntity.NewPlacementRejectedError(
+			fmt.Sprintf("%d plant(s) are prohibited in %s", len(prohibited), countryName(prohibitedIn)),
+			prohibited,
+		)
+	}
+
+	return warnings, nil
+}
+
+// GetRegionalStatusReport lists a garden's prohibited, restricted and invasive plants
+func (s *regionalStatusService) GetRegionalStatusReport(ctx context.Context, gardenID string) (*entity.RegionalStatusReport, error) {
+	if gardenID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	garden, err := s.gardenRepo.FindByID(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("garden not found: %w", err)
+	}
+
+	report := &entity.RegionalStatusReport{
+		GardenID:    gardenID,
+		Offenders:   []*entity.RegionalOffender{},
+		GeneratedAt: time.Now(),
+	}
+
+	location, err := locateGarden(ctx, s.gardenRepo, s.countryRepo, garden)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil || location.country == nil {
+		return report, nil
+	}
+	country := location.country
+	report.CountryID = &country.CountryID
+	report.CountryName = &country.CountryName
+
+	statuses, err := s.loadRegionalStatuses(ctx, country)
+	if err != nil {
+		return nil, err
+	}
+
+	gardenPlants, err := s.gardenPlantRepo.FindActiveInGarden(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load garden plants: %w", err)
+	}
+
+	var offendingIDs []string
+	for _, gp := range gardenPlants {
+		countryPlant := statuses.plants[gp.PlantID]
+		status, concern := entity.RegionalStatusOf(countryPlant)
+		if !concern {
+			continue
+		}
+		report.Offenders = append(report.Offenders, &entity.RegionalOffender{
+			GardenPlantID: gp.GardenPlantID,
+			PlantID:       gp.PlantID,
+			ZoneID:        gp.ZoneID,
+			Status:        status,
+			NativeStatus:  countryPlant.NativeStatus,
+			LegalStatus:   countryPlant.LegalStatus,
+		})
+		offendingIDs = append(offendingIDs, gp.PlantID)
+	}
+
+	if len(offendingIDs) > 0 {
+		plants, err := s.plantRepo.FindByIDs(ctx, offendingIDs, constants.EnglishLanguageID, &country.CountryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load plants: %w", err)
+		}
+		names := make(map[string]string)
+		for _, plant := range plants {
+			names[plant.PlantID] = plant.GetDisplayName()
+		}
+		for _, offender := range report.Offenders {
+			offender.PlantName = names[offender.PlantID]
+		}
+	}
+
+	return report, nil
+}
+
+// placementStatuses looks up the regional status of the placed plants in a garden's country.
+// It returns nil when the garden has no location or lies outside every known country.
+func (s *regionalStatusService) placementStatuses(ctx context.Context, gardenID string, plantIDs []string) (*regionalStatuses, error) {
+	garden, err := s.gardenRepo.FindByID(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("garden not found: %w", err)
+	}
+
+	location, err := locateGarden(ctx, s.gardenRepo, s.countryRepo, garden)
+	if err != nil || location == nil || location.country == nil {
+		return nil, err
+	}
+	country := location.country
+
+	countryPlants, err := s.countryPlantRepo.FindByCountryAndPlants(ctx, country.CountryID, plantIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load regional status: %w", err)
+	}
+
+	statuses := &regionalStatuses{
+		country: country,
+		plants:  make(map[string]*plantEntity.CountryPlant, len(countryPlants)),
+	}
+	for _, cp := range countryPlants {
+		statuses.plants[cp.PlantID] = cp
+	}
+	return statuses, nil
+}
+
+// loadRegionalStatuses indexes a country's prohibited, restricted and invasive plants
+func (s *regionalStatusService) loadRegionalStatuses(ctx context.Context, country *plantEntity.Country) (*regionalStatuses, error) {
+	statuses := &regionalStatuses{
+		country: country,
+		plants:  make(map[string]*plantEntity.CountryPlant),
+	}
+
+	for _, legalStatus := range []types.LegalStatus{types.LegalProhibited, types.LegalRestricted} {
+		countryPlants, err := s.countryPlantRepo.FindByLegalStatus(ctx, country.CountryID, string(legalStatus))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s plants: %w", legalStatus, err)
+		}
+		for _, cp := range countryPlants {
+			statuses.plants[cp.PlantID] = cp
+		}
+	}
+
+	invasive, err := s.countryPlantRepo.FindByNativeStatus(ctx, country.CountryID, string(types.NativeStatusInvasive))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load invasive plants: %w", err)
+	}
+	for _, cp := range invasive {
+		// A legal status already loaded for the plant carries its invasiveness too
+		if _, ok := statuses.plants[cp.PlantID]; !ok {
+			statuses.plants[cp.PlantID] = cp
+		}
+	}
+
+	return statuses, nil
+}
+
+// plantName returns a plant's display name, or its ID if it cannot be loaded
+func (s *regionalStatusService) plantName(ctx context.Context, plantID string) string {
+	plant, err := s.plantRepo.FindByID(ctx, plantID, constants.EnglishLanguageID, nil)
+	if err != nil {
+		return plantID
+	}
+	return plant.GetDisplayName()
+}
+
+// countryName returns a country's name, falling back to its code
+func countryName(country *plantEntity.Country) string {
+	if country == nil {
+		return "this country"
+	}
+	if country.CountryName != "" {
+		return country.CountryName
+	}
+	return country.CountryCode
+}
+
+// regionalStatusWarning builds the placement warning for a plant of concern
+func regionalStatusWarning(plantName string, status entity.RegionalStatus, countryPlant *plantEntity.CountryPlant, country *plantEntity.Country) gardenEntity.PlacementWarning {
+	var message string
+	switch status {
+	case entity.RegionalStatusProhibited:
+		message = fmt.Sprintf("%s is prohibited in %s and cannot be planted", plantName, countryName(country))
+	case entity.RegionalStatusRestricted:
+		message = fmt.Sprintf("%s is restricted in %s; planting may need a permit", plantName, countryName(country))
+	default:
+		message = fmt.Sprintf("%s is invasive in %s; keep it contained", plantName, countryName(country))
+	}
+
+	return gardenEntity.PlacementWarning{
+		Code:    WarningCodeRegionalStatus,
+		Message: message,
+		Details: map[string]interface{}{
+			"plant_id":      countryPlant.PlantID,
+			"status":        status,
+			"country_id":    country.CountryID,
+			"native_status": countryPlant.NativeStatus,
+			"legal_status":  countryPlant.LegalStatus,
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/planning-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// setupRegionalStatusService locates garden-123 in the United States, where hogweed is
+// prohibited, buddleja restricted and invasive, and kudzu invasive
+func setupRegionalStatusService() (*regionalStatusService, *mocks.StubGardenRepository, *stubCountryPlantRepository) {
+	gardens := mocks.NewStubGardenRepository(&gardenEntity.Garden{GardenID: "garden-123"}, []float64{37.77, -122.42})
+	gardenPlants := &spatialGardenPlantRepository{nearby: []*gardenRepository.NearbyGardenPlant{
+		nearbyPlant("gp-tomato", "plant-tomato", 1),
+		nearbyPlant("gp-kudzu", "plant-kudzu", 2),
+		nearbyPlant("gp-buddleja", "plant-buddleja", 3),
+	}}
+
+	countryPlants := &stubCountryPlantRepository{statuses: map[string]*plantEntity.CountryPlant{
+		"plant-tomato":   {PlantID: "plant-tomato", LegalStatus: mocks.StrPtr("unrestricted")},
+		"plant-kudzu":    {PlantID: "plant-kudzu", NativeStatus: mocks.StrPtr("invasive")},
+		"plant-buddleja": {PlantID: "plant-buddleja", NativeStatus: mocks.StrPtr("invasive"), LegalStatus: mocks.StrPtr("restricted")},
+		"plant-hogweed":  {PlantID: "plant-hogweed", LegalStatus: mocks.StrPtr("prohibited")},
+	}}
+
+	service := &regionalStatusService{
+		gardenRepo:       gardens,
+		gardenPlantRepo:  gardenPlants,
+		plantRepo:        &catalogPlantRepository{},
+		countryRepo:      unitedStates(),
+		countryPlantRepo: countryPlants,
+	}
+	return service, gardens, countryPlants
+}
+
+func placement(plantID string) *gardenEntity.GardenPlant {
+	return &gardenEntity.GardenPlant{GardenID: "garden-123", PlantID: plantID, Quantity: 1}
+}
+
+func TestRegionalStatusService_CheckPlacement(t *testing.T) {
+	service, _, _ := setupRegionalStatusService()
+	ctx := context.Background()
+
+	t.Run("unrestricted plants pass", func(t *testing.T) {
+		warnings, err := service.CheckPlacement(ctx, placement("plant-tomato"))
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("invasive plants are warned about", func(t *testing.T) {
+		warnings, err := service.CheckPlacement(ctx, placement("plant-kudzu"))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, WarningCodeRegionalStatus, warnings[0].Code)
+		assert.Equal(t, entity.RegionalStatusInvasive, warnings[0].Details["status"])
+		assert.Contains(t, warnings[0].Message, "invasive in United States")
+	})
+
+	t.Run("legal status outranks invasiveness", func(t *testing.T) {
+		warnings, err := service.CheckPlacement(ctx, placement("plant-buddleja"))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, entity.RegionalStatusRestricted, warnings[0].Details["status"])
+	})
+
+	t.Run("prohibited plants are rejected", func(t *testing.T) {
+		_, err := service.CheckPlacement(ctx, placement("plant-hogweed"))
+		require.Error(t, err)
+
+		var rejected *gardenEntity.PlacementRejectedError
+		require.True(t, errors.As(err, &rejected))
+		require.Len(t, rejected.Warnings, 1)
+		assert.Contains(t, rejected.Message, "prohibited in United States")
+	})
+}
+
+func TestRegionalStatusService_CheckBulkPlacement(t *testing.T) {
+	service, _, _ := setupRegionalStatusService()
+	ctx := context.Background()
+
+	warnings, err := service.CheckBulkPlacement(ctx, []*gardenEntity.GardenPlant{
+		placement("plant-tomato"),
+		placement("plant-kudzu"),
+	})
+	require.NoError(t, err)
+	require.Len(t, warnings, 2)
+	assert.Empty(t, warnings[0])
+	assert.Len(t, warnings[1], 1)
+
+	// One prohibited plant rejects the whole batch
+	_, err = service.CheckBulkPlacement(ctx, []*gardenEntity.GardenPlant{
+		placement("plant-tomato"),
+		placement("plant-hogweed"),
+	})
+	var rejected *gardenEntity.PlacementRejectedError
+	require.True(t, errors.As(err, &rejected))
+}
+
+func TestRegionalStatusService_CheckPlacement_NoLocation(t *testing.T) {
+	service, gardens, _ := setupRegionalStatusService()
+	gardens.Gardens["garden-123"].BoundaryGeoJSON = nil
+
+	// Without a country there is nothing to check against
+	warnings, err := service.CheckPlacement(context.Background(), placement("plant-hogweed"))
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+}
+
+func TestRegionalStatusService_CheckPlacement_LookupError(t *testing.T) {
+	service, _, countryPlants := setupRegionalStatusService()
+	countryPlants.err = errors.New("connection refused")
+
+	// A failed lookup must not let a possibly prohibited plant through
+	_, err := service.CheckPlacement(context.Background(), placement("plant-hogweed"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection refused")
+}
+
+func TestRegionalStatusService_GetRegionalStatusReport(t *testing.T) {
+	service, _, _ := setupRegionalStatusService()
+
+	report, err := service.GetRegionalStatusReport(context.Background(), "garden-123")
+	require.NoError(t, err)
+
+	require.NotNil(t, report.CountryID)
+	assert.Equal(t, "country-us", *report.CountryID)
+	require.Len(t, report.Offenders, 2)
+	assert.Equal(t, "gp-kudzu", report.Offenders[0].GardenPlantID)
+	assert.Equal(t, entity.RegionalStatusInvasive, report.Offenders[0].Status)
+	assert.Equal(t, "Botanical plant-kudzu", report.Offenders[0].PlantName)
+	assert.Equal(t, "gp-buddleja", report.Offenders[1].GardenPlantID)
+	assert.Equal(t, entity.RegionalStatusRestricted, report.Offenders[1].Status)
+
+	_, err = service.GetRegionalStatusReport(context.Background(), "garden-missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	"twigger-backend/backend/planning-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/shared/constants"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// SuitabilityService explains how well a plant suits a garden location
+type SuitabilityService interface {
+	// ScoreSuitability scores a plant against a garden, or one of its zones, from 0 to 100
+	// with a per-factor breakdown. Site conditions come from the garden's detected climate,
+	// the zone's sun, soil and irrigation, and any observations in the request.
+	ScoreSuitability(ctx context.Context, request *entity.SuitabilityRequest) (*entity.SuitabilityScore, error)
+}
+
+// suitabilityService implements SuitabilityService
+type suitabilityService struct {
+	gardenRepo       gardenRepository.GardenRepository
+	zoneRepo         gardenRepository.GardenZoneRepository
+	plantRepo        plantRepository.PlantRepository
+	countryRepo      plantRepository.CountryRepository
+	countryPlantRepo plantRepository.CountryPlantRepository
+	climateZoneRepo  plantRepository.ClimateZoneRepository
+}
+
+// NewSuitabilityService creates a new suitability service instance
+func NewSuitabilityService(
+	gardenRepo gardenRepository.GardenRepository,
+	zoneRepo gardenRepository.GardenZoneRepository,
+	plantRepo plantRepository.PlantRepository,
+	countryRepo plantRepository.CountryRepository,
+	countryPlantRepo plantRepository.CountryPlantRepository,
+	climateZoneRepo plantRepository.ClimateZoneRepository,
+) SuitabilityService {
+	return &suitabilityService{
+		gardenRepo:       gardenRepo,
+		zoneRepo:         zoneRepo,
+		plantRepo:        plantRepo,
+		countryRepo:      countryRepo,
+		countryPlantRepo: countryPlantRepo,
+		climateZoneRepo:  climateZoneRepo,
+	}
+}
+
+// ScoreSuitability scores a plant against a garden or zone
+func (s *suitabilityService) ScoreSuitability(ctx context.Context, request *entity.SuitabilityRequest) (*entity.SuitabilityScore, error) {
+	if request == nil {
+		return nil, domainerrors.NewInvalidInputError("request", "suitability request cannot be nil")
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, domainerrors.NewValidationError("suitability", err.Error())
+	}
+
+	var zone *gardenEntity.GardenZone
+	if request.ZoneID != nil {
+		var err error
+		zone, err = s.zoneRepo.FindByID(ctx, *request.ZoneID)
+		if err != nil {
+			return nil, fmt.Errorf("zone not found: %w", err)
+		}
+		if request.GardenID != "" && request.GardenID != zone.GardenID {
+			return nil, domainerrors.NewValidationError("zone_id", "zone does not belong to this garden")
+		}
+		request.GardenID = zone.GardenID
+	}
+
+	garden, err := s.gardenRepo.FindByID(ctx, request.GardenID)
+	if err != nil {
+		return nil, fmt.Errorf("garden not found: %w", err)
+	}
+
+	site, err := s.siteConditions(ctx, garden, zone, request)
+	if err != nil {
+		return nil, err
+	}
+
+	plant, err := s.plantRepo.FindByID(ctx, request.PlantID, constants.EnglishLanguageID, site.CountryID)
+	if err != nil {
+		return nil, fmt.Errorf("plant not found: %w", err)
+	}
+
+	// Growing conditions and regional status are recorded per country; factors without
+	// them are reported as unknown rather than failing the score
+	var gc *types.GrowingConditions
+	var countryPlant *plantEntity.CountryPlant
+	if site.CountryID != nil {
+		gc, err = s.plantRepo.GetGrowingConditions(ctx, plant.PlantID, *site.CountryID, constants.EnglishLanguageID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get growing conditions: %w", err)
+		}
+		countryPlant, err = s.countryPlantRepo.FindByCountryAndPlant(ctx, *site.CountryID, plant.PlantID)
+		if err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("failed to get regional status: %w", err)
+		}
+	}
+
+	score := entity.EvaluateSuitability(site, gc, countryPlant)
+	score.PlantID = plant.PlantID
+	score.GardenID = garden.GardenID
+	score.ZoneID = request.ZoneID
+
+	return score, nil
+}
+
+// siteConditions gathers the conditions at a garden or zone. Climate zones and the country
+// are looked up from the garden's centre; conditions with no data are left unknown.
+func (s *suitabilityService) siteConditions(ctx context.Context, garden *gardenEntity.Garden, zone *gardenEntity.GardenZone, request *entity.SuitabilityRequest) (*entity.SiteConditions, error) {
+	site := &entity.SiteConditions{
+		HardinessZone: garden.HardinessZone,
+		SoilPH:        request.SoilPH,
+		SoilDrainage:  request.SoilDrainage,
+		SaltExposed:   request.SaltExposed,
+		WindExposed:   request.WindExposed,
+	}
+
+	if zone != nil {
+		site.SunHoursSummer = zone.SunHoursSummer
+		site.SoilType = zone.SoilType
+		site.SunRequirement, site.WaterNeeds = zoneLightAndWater(zone)
+		if site.SoilDrainage == nil && zone.SoilType != nil {
+			site.SoilDrainage = entity.SoilDrainageForSoilType(*zone.SoilType)
+		}
+	}
+
+	location, err := locateGarden(ctx, s.gardenRepo, s.countryRepo, garden)
+	if err != nil || location == nil {
+		return site, err
+	}
+	site.CountryID = location.countryID()
+
+	if site.HardinessZone == nil {
+		site.HardinessZone, err = s.climateZoneCode(ctx, location, constants.ClimateSystemUSDA)
+		if err != nil {
+			return nil, err
+		}
+	}
+	site.HeatZone, err = s.climateZoneCode(ctx, location, constants.ClimateSystemAHS)
+	if err != nil {
+		return nil, err
+	}
+
+	return site, nil
+}
+
+// climateZoneCode returns the zone of a climate system at the garden, or nil when the
+// garden lies outside the system's zones
+func (s *suitabilityService) climateZoneCode(ctx context.Context, location *gardenLocation, zoneSystem string) (*string, error) {
+	climate, err := s.climateZoneRepo.FindByPoint(ctx, location.lat, location.lng, zoneSystem)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s zone: %w", zoneSystem, err)
+	}
+	return &climate.ZoneCode, nil
+}
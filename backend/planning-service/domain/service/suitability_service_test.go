@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/planning-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/shared/constants"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// stubCountryPlantRepository serves regional status from memory; other methods are not used
+type stubCountryPlantRepository struct {
+	plantRepository.CountryPlantRepository
+	statuses map[string]*plantEntity.CountryPlant
+	err      error
+}
+
+func (s *stubCountryPlantRepository) FindByCountryAndPlant(ctx context.Context, countryID, plantID string) (*plantEntity.CountryPlant, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if cp, ok := s.statuses[plantID]; ok {
+		return cp, nil
+	}
+	return nil, plantEntity.NewNotFoundError("country plant", plantID)
+}
+
+func (s *stubCountryPlantRepository) FindByCountryAndPlants(ctx context.Context, countryID string, plantIDs []string) ([]*plantEntity.CountryPlant, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	var result []*plantEntity.CountryPlant
+	for _, plantID := range plantIDs {
+		if cp, ok := s.statuses[plantID]; ok {
+			result = append(result, cp)
+		}
+	}
+	return result, nil
+}
+
+func (s *stubCountryPlantRepository) FindByLegalStatus(ctx context.Context, countryID, legalStatus string) ([]*plantEntity.CountryPlant, error) {
+	var result []*plantEntity.CountryPlant
+	for _, cp := range s.statuses {
+		if cp.LegalStatus != nil && *cp.LegalStatus == legalStatus {
+			result = append(result, cp)
+		}
+	}
+	return result, nil
+}
+
+func (s *stubCountryPlantRepository) FindByNativeStatus(ctx context.Context, countryID, nativeStatus string) ([]*plantEntity.CountryPlant, error) {
+	var result []*plantEntity.CountryPlant
+	for _, cp := range s.statuses {
+		if cp.NativeStatus != nil && *cp.NativeStatus == nativeStatus {
+			result = append(result, cp)
+		}
+	}
+	return result, nil
+}
+
+// stubClimateZoneRepository places every point in heat zone 8; other methods are not used
+type stubClimateZoneRepository struct {
+	plantRepository.ClimateZoneRepository
+}
+
+func (s *stubClimateZoneRepository) FindByPoint(ctx context.Context, latitude, longitude float64, zoneSystem string) (*plantEntity.ClimateZone, error) {
+	if zoneSystem == constants.ClimateSystemAHS {
+		return &plantEntity.ClimateZone{ZoneSystem: zoneSystem, ZoneCode: "8"}, nil
+	}
+	return nil, plantEntity.NewNotFoundError("climate zone", zoneSystem)
+}
+
+// setupSuitabilityService builds a sunny, drip-irrigated loam bed in zone 7a, heat zone 8.
+// Tomatoes suit it; kudzu is invasive and needs warmer winters; hogweed is prohibited.
+func setupSuitabilityService() *suitabilityService {
+	sunHours := 7
+	drip := gardenEntity.IrrigationDrip
+	moderate := types.WaterModerate
+	wellDrained := types.DrainageWell
+
+	return &suitabilityService{
+		gardenRepo: mocks.NewStubGardenRepository(&gardenEntity.Garden{GardenID: "garden-123", HardinessZone: mocks.StrPtr("7a")}, []float64{37.77, -122.42}),
+		zoneRepo: &mocks.StubGardenZoneRepository{Zones: map[string]*gardenEntity.GardenZone{
+			"zone-bed": {
+				ZoneID:         "zone-bed",
+				GardenID:       "garden-123",
+				SoilType:       mocks.StrPtr("loam"),
+				IrrigationType: &drip,
+				SunHoursSummer: &sunHours,
+			},
+			"zone-other": {ZoneID: "zone-other", GardenID: "garden-456"},
+		}},
+		plantRepo: &catalogPlantRepository{conditions: map[string]*types.GrowingConditions{
+			"plant-tomato": {
+				HardinessZones:  []string{"6a", "6b", "7a", "7b", "8a"},
+				HeatZones:       []string{"7", "8", "9"},
+				SunRequirements: []types.SunRequirement{types.SunFullSun},
+				WaterNeeds:      &moderate,
+				SoilDrainage:    &wellDrained,
+				PHPreference:    &types.PHRange{MinPH: mocks.FloatPtr(6.0), MaxPH: mocks.FloatPtr(7.0)},
+				WindTolerant:    true,
+			},
+			"plant-kudzu": {
+				HardinessZones: []string{"8b", "9a", "9b"},
+				HeatZones:      []string{"8", "9"},
+			},
+			"plant-hogweed": {
+				HardinessZones: []string{"7a"},
+			},
+		}},
+		countryRepo: unitedStates(),
+		countryPlantRepo: &stubCountryPlantRepository{statuses: map[string]*plantEntity.CountryPlant{
+			"plant-tomato":  {PlantID: "plant-tomato", NativeStatus: mocks.StrPtr("introduced"), LegalStatus: mocks.StrPtr("unrestricted")},
+			"plant-kudzu":   {PlantID: "plant-kudzu", NativeStatus: mocks.StrPtr("invasive")},
+			"plant-hogweed": {PlantID: "plant-hogweed", NativeStatus: mocks.StrPtr("introduced"), LegalStatus: mocks.StrPtr("prohibited")},
+		}},
+		climateZoneRepo: &stubClimateZoneRepository{},
+	}
+}
+
+func factorsByName(score *entity.SuitabilityScore) map[entity.SuitabilityFactor]entity.FactorScore {
+	factors := make(map[entity.SuitabilityFactor]entity.FactorScore)
+	for _, factor := range score.Factors {
+		factors[factor.Factor] = factor
+	}
+	return factors
+}
+
+func TestSuitabilityService_ScoreSuitability_Zone(t *testing.T) {
+	service := setupSuitabilityService()
+
+	score, err := service.ScoreSuitability(context.Background(), &entity.SuitabilityRequest{
+		PlantID:     "plant-tomato",
+		ZoneID:      mocks.StrPtr("zone-bed"),
+		SoilPH:      mocks.FloatPtr(6.5),
+		SaltExposed: mocks.BoolPtr(false),
+		WindExposed: mocks.BoolPtr(true),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "garden-123", score.GardenID)
+	assert.Equal(t, "8", *score.Site.HeatZone)
+	assert.Equal(t, types.DrainageWell, *score.Site.SoilDrainage)
+	assert.Equal(t, 100, score.Coverage)
+	assert.False(t, score.Prohibited)
+
+	factors := factorsByName(score)
+	require.Len(t, factors, len(entity.FactorWeights))
+	for _, factor := range []entity.SuitabilityFactor{
+		entity.FactorHardiness, entity.FactorHeatZone, entity.FactorSun, entity.FactorWater,
+		entity.FactorSoilDrainage, entity.FactorPH, entity.FactorSalt, entity.FactorWind,
+	} {
+		assert.Equal(t, entity.FactorMatch, factors[factor].Status, factor)
+	}
+
+	// Introduced plants score half on regional status
+	assert.Equal(t, entity.FactorPartial, factors[entity.FactorRegionalStatus].Status)
+	assert.Equal(t, 97, score.Score)
+}
+
+func TestSuitabilityService_ScoreSuitability_GardenMismatches(t *testing.T) {
+	service := setupSuitabilityService()
+
+	score, err := service.ScoreSuitability(context.Background(), &entity.SuitabilityRequest{
+		PlantID:  "plant-kudzu",
+		GardenID: "garden-123",
+	})
+	require.NoError(t, err)
+
+	factors := factorsByName(score)
+	assert.Equal(t, entity.FactorMismatch, factors[entity.FactorHardiness].Status)
+	assert.Contains(t, factors[entity.FactorHardiness].Reason, "not hardy in zone 7a")
+	assert.Equal(t, entity.FactorMatch, factors[entity.FactorHeatZone].Status)
+	assert.Equal(t, entity.FactorMismatch, factors[entity.FactorRegionalStatus].Status)
+	assert.Equal(t, "invasive in this country", factors[entity.FactorRegionalStatus].Reason)
+
+	// Without a zone there is no sun or irrigation to score
+	assert.Equal(t, entity.FactorUnknown, factors[entity.FactorSun].Status)
+	assert.Equal(t, entity.FactorUnknown, factors[entity.FactorWater].Status)
+
+	// Heat zone is the only match among hardiness, heat and status
+	assert.Equal(t, 40, score.Coverage)
+	assert.Equal(t, 25, score.Score)
+}
+
+func TestSuitabilityService_ScoreSuitability_Prohibited(t *testing.T) {
+	service := setupSuitabilityService()
+
+	score, err := service.ScoreSuitability(context.Background(), &entity.SuitabilityRequest{
+		PlantID:  "plant-hogweed",
+		GardenID: "garden-123",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, score.Prohibited)
+	assert.Equal(t, 0, score.Score)
+	assert.Equal(t, entity.FactorMatch, factorsByName(score)[entity.FactorHardiness].Status)
+}
+
+func TestSuitabilityService_ScoreSuitability_Errors(t *testing.T) {
+	service := setupSuitabilityService()
+	ctx := context.Background()
+	invalidDrainage := types.SoilDrainage("swampy")
+
+	tests := []struct {
+		name    string
+		request *entity.SuitabilityRequest
+		wantErr string
+	}{
+		{"nil request", nil, "cannot be nil"},
+		{"no location", &entity.SuitabilityRequest{PlantID: "plant-tomato"}, "garden_id or zone_id is required"},
+		{"bad ph", &entity.SuitabilityRequest{PlantID: "plant-tomato", GardenID: "garden-123", SoilPH: mocks.FloatPtr(15)}, "soil_ph"},
+		{"bad drainage", &entity.SuitabilityRequest{PlantID: "plant-tomato", GardenID: "garden-123", SoilDrainage: &invalidDrainage}, "soil_drainage"},
+		{"zone in another garden", &entity.SuitabilityRequest{PlantID: "plant-tomato", GardenID: "garden-123", ZoneID: mocks.StrPtr("zone-other")}, "does not belong"},
+		{"missing plant", &entity.SuitabilityRequest{PlantID: "plant-missing", GardenID: "garden-123"}, "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ScoreSuitability(ctx, tt.request)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSuitabilityService_ScoreSuitability_RegionalStatusLookup(t *testing.T) {
+	ctx := context.Background()
+	request := &entity.SuitabilityRequest{PlantID: "plant-tomato", GardenID: "garden-123"}
+
+	t.Run("no recorded status is unknown", func(t *testing.T) {
+		service := setupSuitabilityService()
+		delete(service.countryPlantRepo.(*stubCountryPlantRepository).statuses, "plant-tomato")
+
+		score, err := service.ScoreSuitability(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, entity.FactorUnknown, factorsByName(score)[entity.FactorRegionalStatus].Status)
+	})
+
+	t.Run("failed lookup is returned", func(t *testing.T) {
+		service := setupSuitabilityService()
+		service.countryPlantRepo.(*stubCountryPlantRepository).err = errors.New("connection refused")
+
+		_, err := service.ScoreSuitability(ctx, request)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "connection refused")
+	})
+}
+
+func TestEvaluateSuitability_HalfZoneIsPartial(t *testing.T) {
+	site := &entity.SiteConditions{HardinessZone: mocks.StrPtr("7a")}
+	gc := &types.GrowingConditions{HardinessZones: []string{"7b", "8"}}
+
+	score := entity.EvaluateSuitability(site, gc, nil)
+
+	factors := factorsByName(score)
+	assert.Equal(t, entity.FactorPartial, factors[entity.FactorHardiness].Status)
+	assert.Equal(t, 12, factors[entity.FactorHardiness].Points)
+	assert.Equal(t, 48, score.Score)
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	gardenService "twigger-backend/backend/garden-service/domain/service"
+	"twigger-backend/backend/planning-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/constants"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// ZoneFillService fills garden zones with grids of plants
+type ZoneFillService interface {
+	// FillZone lays out a planting grid inside a zone, spaced by the plant's mature spread
+	// unless overridden, and places a plant on every point unless it is a dry run
+	FillZone(ctx context.Context, zoneID string, options *entity.ZoneFillOptions) (*entity.ZoneFillPlan, error)
+}
+
+// zoneFillService implements ZoneFillService
+type zoneFillService struct {
+	zoneRepo         gardenRepository.GardenZoneRepository
+	plantRepo        plantRepository.PlantRepository
+	placementService gardenService.PlantPlacementService
+}
+
+// NewZoneFillService creates a new zone fill service instance
+func NewZoneFillService(
+	zoneRepo gardenRepository.GardenZoneRepository,
+	plantRepo plantRepository.PlantRepository,
+	placementService gardenService.PlantPlacementService,
+) ZoneFillService {
+	return &zoneFillService{
+		zoneRepo:         zoneRepo,
+		plantRepo:        plantRepo,
+		placementService: placementService,
+	}
+}
+
+// FillZone lays out a planting grid inside a zone and places it unless it is a dry run
+func (s *zoneFillService) FillZone(ctx context.Context, zoneID string, options *entity.ZoneFillOptions) (*entity.ZoneFillPlan, error) {
+	if zoneID == "" {
+		return nil, domainerrors.NewInvalidInputError("zone_id", "zone ID cannot be empty")
+	}
+
+	if options == nil {
+		return nil, domainerrors.NewInvalidInputError("options", "fill options cannot be nil")
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, domainerrors.NewValidationError("zone_fill", err.Error())
+	}
+
+	zone, err := s.zoneRepo.FindByID(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("zone not found: %w", err)
+	}
+
+	spacingM, err := s.resolveSpacing(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &entity.ZoneFillPlan{
+		ZoneID:      zoneID,
+		GardenID:    zone.GardenID,
+		PlantID:     options.PlantID,
+		Pattern:     options.Pattern,
+		SpacingM:    spacingM,
+		RowSpacingM: spacingM,
+		DryRun:      options.DryRun,
+	}
+
+	// Rows step north by the row spacing; hex rows pack closer and alternate by half a space
+	rowOffset := 0.0
+	switch options.Pattern {
+	case entity.GridPatternHex:
+		plan.RowSpacingM = spacingM * math.Sqrt(3) / 2
+		rowOffset = spacingM / 2
+	case entity.GridPatternRows:
+		if options.RowSpacingM != nil {
+			plan.RowSpacingM = *options.RowSpacingM
+		}
+	}
+
+	polygons, proj, err := parseZonePolygons(zone.GeometryGeoJSON)
+	if err != nil {
+		return nil, domainerrors.NewValidationError("geometry", err.Error())
+	}
+
+	// Keep each mature canopy inside the zone
+	points, err := gridPoints(polygons, spacingM, plan.RowSpacingM, rowOffset, spacingM/2, entity.MaxFillPoints)
+	if err != nil {
+		return nil, domainerrors.NewValidationError("spacing_m", err.Error())
+	}
+
+	plan.Points = toGridPoints(points, proj)
+	plan.Count = len(plan.Points)
+
+	if options.DryRun || plan.Count == 0 {
+		return plan, nil
+	}
+
+	gardenPlants := make([]*gardenEntity.GardenPlant, plan.Count)
+	for i, point := range plan.Points {
+		gardenPlants[i] = &gardenEntity.GardenPlant{
+			GardenID:        zone.GardenID,
+			ZoneID:          &zoneID,
+			PlantID:         options.PlantID,
+			LocationGeoJSON: point.GeoJSON(),
+			Quantity:        1,
+		}
+	}
+
+	plan.Placed, err = s.placementService.BulkPlacePlants(ctx, gardenPlants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to place plants: %w", err)
+	}
+
+	return plan, nil
+}
+
+// resolveSpacing returns the requested spacing, or the plant's typical mature spread but
+// no less than the minimum fill spacing
+func (s *zoneFillService) resolveSpacing(ctx context.Context, options *entity.ZoneFillOptions) (float64, error) {
+	if _, err := s.plantRepo.FindByID(ctx, options.PlantID, constants.EnglishLanguageID, nil); err != nil {
+		return 0, fmt.Errorf("plant not found: %w", err)
+	}
+
+	if options.SpacingM != nil {
+		return *options.SpacingM, nil
+	}
+
+	characteristics, err := s.plantRepo.GetPhysicalCharacteristics(ctx, options.PlantID, constants.EnglishLanguageID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load physical characteristics: %w", err)
+	}
+
+	var spreadM *float64
+	if characteristics != nil {
+		spreadM = entity.TypicalSpreadM(characteristics.MatureSpread)
+	}
+	if spreadM == nil || *spreadM <= 0 {
+		return 0, domainerrors.NewValidationError("spacing_m", "plant has no mature spread; spacing_m is required")
+	}
+
+	return math.Max(*spreadM, entity.MinFillSpacingM), nil
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenService "twigger-backend/backend/garden-service/domain/service"
+	"twigger-backend/backend/planning-service/domain/entity"
+	"twigger-backend/backend/shared/geometry"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// stubPlacementService records bulk placements; other methods are not used by zone fill
+type stubPlacementService struct {
+	gardenService.PlantPlacementService
+	placed []*gardenEntity.GardenPlant
+}
+
+func (s *stubPlacementService) BulkPlacePlants(ctx context.Context, gardenPlants []*gardenEntity.GardenPlant) ([]*gardenEntity.GardenPlant, error) {
+	for i, gp := range gardenPlants {
+		gp.GardenPlantID = fmt.Sprintf("gp-%d", i)
+	}
+	s.placed = append(s.placed, gardenPlants...)
+	return gardenPlants, nil
+}
+
+// rectangleGeoJSON returns a widthM by heightM polygon at the equator, one corner at the origin
+func rectangleGeoJSON(widthM, heightM float64) string {
+	lng := widthM / geometry.MetersPerDegreeLng
+	lat := heightM / geometry.MetersPerDegreeLat
+	return fmt.Sprintf(`{"type":"Polygon","coordinates":[[[0,0],[%[1]g,0],[%[1]g,%[2]g],[0,%[2]g],[0,0]]]}`, lng, lat)
+}
+
+// setupZoneFillService builds a 2 m by 1 m bed and a 500 m square field; lettuce spreads 0.5 m, chard has no recorded spread
+func setupZoneFillService() (*zoneFillService, *stubPlacementService) {
+	placement := &stubPlacementService{}
+	service := &zoneFillService{
+		zoneRepo: &mocks.StubGardenZoneRepository{Zones: map[string]*gardenEntity.GardenZone{
+			"zone-bed":   {ZoneID: "zone-bed", GardenID: "garden-123", GeometryGeoJSON: rectangleGeoJSON(2, 1)},
+			"zone-field": {ZoneID: "zone-field", GardenID: "garden-123", GeometryGeoJSON: rectangleGeoJSON(500, 500)},
+		}},
+		plantRepo:        &catalogPlantRepository{spreads: map[string]float64{"plant-lettuce": 0.5}},
+		placementService: placement,
+	}
+	return service, placement
+}
+
+func TestZoneFillService_FillZone_Patterns(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     *entity.ZoneFillOptions
+		wantCount   int
+		wantRowStep float64
+	}{
+		{
+			// Centres 0.25 m from the edges: 4 across, 2 down
+			name:        "square grid from mature spread",
+			options:     &entity.ZoneFillOptions{PlantID: "plant-lettuce", Pattern: entity.GridPatternSquare},
+			wantCount:   8,
+			wantRowStep: 0.5,
+		},
+		{
+			// Second row shifts 0.25 m and fits 3
+			name:        "hex grid",
+			options:     &entity.ZoneFillOptions{PlantID: "plant-lettuce", Pattern: entity.GridPatternHex},
+			wantCount:   7,
+			wantRowStep: 0.4330127,
+		},
+		{
+			name:        "rows with row spacing",
+			options:     &entity.ZoneFillOptions{PlantID: "plant-chard", Pattern: entity.GridPatternRows, SpacingM: mocks.FloatPtr(0.5), RowSpacingM: mocks.FloatPtr(1.0)},
+			wantCount:   4,
+			wantRowStep: 1.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, placement := setupZoneFillService()
+			tt.options.DryRun = true
+
+			plan, err := service.FillZone(context.Background(), "zone-bed", tt.options)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCount, plan.Count)
+			assert.Len(t, plan.Points, tt.wantCount)
+			assert.InDelta(t, tt.wantRowStep, plan.RowSpacingM, 1e-6)
+			assert.Equal(t, "garden-123", plan.GardenID)
+			assert.Empty(t, placement.placed, "dry run places nothing")
+		})
+	}
+}
+
+func TestZoneFillService_FillZone_PlacesPlants(t *testing.T) {
+	service, placement := setupZoneFillService()
+
+	plan, err := service.FillZone(context.Background(), "zone-bed", &entity.ZoneFillOptions{
+		PlantID: "plant-lettuce",
+		Pattern: entity.GridPatternSquare,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, placement.placed, 8)
+	assert.Len(t, plan.Placed, 8)
+	for i, gp := range placement.placed {
+		assert.Equal(t, "garden-123", gp.GardenID)
+		assert.Equal(t, "zone-bed", *gp.ZoneID)
+		assert.Equal(t, 1, gp.Quantity)
+		assert.Equal(t, plan.Points[i].GeoJSON(), gp.LocationGeoJSON)
+	}
+}
+
+func TestZoneFillService_FillZone_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		zoneID  string
+		options *entity.ZoneFillOptions
+		wantErr string
+	}{
+		{name: "unknown pattern", zoneID: "zone-bed", options: &entity.ZoneFillOptions{PlantID: "plant-lettuce", Pattern: "spiral"}, wantErr: "pattern"},
+		{name: "row spacing outside rows", zoneID: "zone-bed", options: &entity.ZoneFillOptions{PlantID: "plant-lettuce", Pattern: entity.GridPatternSquare, RowSpacingM: mocks.FloatPtr(1)}, wantErr: "rows pattern"},
+		{name: "no spread or spacing", zoneID: "zone-bed", options: &entity.ZoneFillOptions{PlantID: "plant-chard", Pattern: entity.GridPatternSquare}, wantErr: "spacing_m is required"},
+		{name: "too many plants", zoneID: "zone-bed", options: &entity.ZoneFillOptions{PlantID: "plant-lettuce", Pattern: entity.GridPatternSquare, SpacingM: mocks.FloatPtr(0.05)}, wantErr: "more than"},
+		{name: "spacing below minimum", zoneID: "zone-bed", options: &entity.ZoneFillOptions{PlantID: "plant-lettuce", Pattern: entity.GridPatternSquare, SpacingM: mocks.FloatPtr(1e-9)}, wantErr: "spacing_m must be at least"},
+		{name: "row spacing below minimum", zoneID: "zone-bed", options: &entity.ZoneFillOptions{PlantID: "plant-lettuce", Pattern: entity.GridPatternRows, SpacingM: mocks.FloatPtr(0.5), RowSpacingM: mocks.FloatPtr(1e-300)}, wantErr: "row_spacing_m must be at least"},
+		{name: "grid too large for zone", zoneID: "zone-field", options: &entity.ZoneFillOptions{PlantID: "plant-lettuce", Pattern: entity.GridPatternSquare, SpacingM: mocks.FloatPtr(0.5)}, wantErr: "too large"},
+		{name: "unknown zone", zoneID: "zone-missing", options: &entity.ZoneFillOptions{PlantID: "plant-lettuce", Pattern: entity.GridPatternSquare}, wantErr: "not found"},
+		{name: "unknown plant", zoneID: "zone-bed", options: &entity.ZoneFillOptions{PlantID: "plant-missing", Pattern: entity.GridPatternSquare}, wantErr: "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, placement := setupZoneFillService()
+
+			_, err := service.FillZone(context.Background(), tt.zoneID, tt.options)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, placement.placed)
+		})
+	}
+}
+
+func TestGridPoints_RejectsOversizedGrid(t *testing.T) {
+	field := []geometry.Point{{X: 0, Y: 0}, {X: 1000, Y: 0}, {X: 1000, Y: 1000}, {X: 0, Y: 1000}, {X: 0, Y: 0}}
+
+	_, err := gridPoints([]localPolygon{{outer: field}}, 1, 1, 0, 0.5, entity.MaxFillPoints)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too large")
+}
+
+func TestGridPoints_SkipsHolesAndEdges(t *testing.T) {
+	square := []geometry.Point{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 3}, {X: 0, Y: 3}, {X: 0, Y: 0}}
+	hole := []geometry.Point{{X: 1, Y: 1}, {X: 2, Y: 1}, {X: 2, Y: 2}, {X: 1, Y: 2}, {X: 1, Y: 1}}
+
+	points, err := gridPoints([]localPolygon{{outer: square, holes: [][]geometry.Point{hole}}}, 1, 1, 0, 0.5, 100)
+
+	require.NoError(t, err)
+	// A 3 by 3 grid loses its centre to the hole
+	assert.Len(t, points, 8)
+	for _, pt := range points {
+		assert.False(t, pt.X == 1.5 && pt.Y == 1.5)
+	}
+}
@@ -123,3 +123,28 @@ func NewValidationError(field, message string) *ValidationError {
 		Message: message,
 	}
 }
+
+// ConflictError represents an operation refused because of existing data, such as a
+// duplicate name or records that still depend on the resource
+type ConflictError struct {
+	Resource string
+	ID       string
+	Message  string
+}
+
+// Error implements the error interface
+func (e *ConflictError) Error() string {
+	if e.ID != "" {
+		return fmt.Sprintf("%s %s conflict: %s", e.Resource, e.ID, e.Message)
+	}
+	return fmt.Sprintf("%s conflict: %s", e.Resource, e.Message)
+}
+
+// NewConflictError creates a new ConflictError
+func NewConflictError(resource, id, message string) *ConflictError {
+	return &ConflictError{
+		Resource: resource,
+		ID:       id,
+		Message:  message,
+	}
+}
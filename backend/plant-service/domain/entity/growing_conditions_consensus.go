@@ -0,0 +1,542 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"twigger-backend/backend/plant-service/pkg/types"
+)
+
+const (
+	// DefaultSourceReliability is assumed for sources that cannot be loaded, matching the data_sources default
+	DefaultSourceReliability = 3
+
+	// ConsensusSupportThreshold is the share of weight a value needs to enter the merged view.
+	// At one half, two equally trusted sources union their zone lists while three or more
+	// keep only the zones most of them agree on.
+	ConsensusSupportThreshold = 0.5
+
+	// HumiditySpreadLimit is how far apart, in percentage points, humidity preferences can be
+	// before the sources are in conflict
+	HumiditySpreadLimit = 20.0
+)
+
+// Consensus fields, as reported in conflicts and dissents
+const (
+	FieldHardinessZones     = "hardiness_zones"
+	FieldHeatZones          = "heat_zones"
+	FieldSunRequirements    = "sun_requirements"
+	FieldShadeTolerance     = "shade_tolerance"
+	FieldWaterNeeds         = "water_needs"
+	FieldHumidityPreference = "humidity_preference"
+	FieldDroughtTolerant    = "drought_tolerant"
+	FieldSoilTypes          = "soil_types"
+	FieldSoilDrainage       = "soil_drainage"
+	FieldPHPreference       = "ph_preference"
+	FieldSaltTolerant       = "salt_tolerant"
+	FieldWindTolerant       = "wind_tolerant"
+	FieldFloweringMonths    = "flowering_months"
+	FieldFruitingMonths     = "fruiting_months"
+)
+
+// ConditionsAssertion is one source's growing conditions for a plant in a country, with the
+// weight it carries in the consensus
+type ConditionsAssertion struct {
+	Source      *DataSource              `json:"source"`
+	Conditions  *types.GrowingConditions `json:"conditions"`
+	Reliability int                      `json:"reliability"`        // Source reliability used for the weight, 1-5
+	Weight      float64                  `json:"weight"`             // Reliability share (score / 5) x confidence share (score / 100)
+	Dissents    []string                 `json:"dissents,omitempty"` // Fields where this source disagrees with the consensus
+}
+
+// NewConditionsAssertion weighs a source's growing conditions by the source's reliability and
+// the assertion's confidence. Out-of-range reliability counts as the default and unknown
+// confidence as very low, so every assertion carries some weight.
+func NewConditionsAssertion(source *DataSource, conditions *types.GrowingConditions) *ConditionsAssertion {
+	reliability := DefaultSourceReliability
+	if source != nil && source.ReliabilityScore >= 1 && source.ReliabilityScore <= 5 {
+		reliability = source.ReliabilityScore
+	}
+
+	confidence := conditions.ConfidenceScore()
+	if confidence == 0 {
+		confidence = 10
+	}
+
+	return &ConditionsAssertion{
+		Source:      source,
+		Conditions:  conditions,
+		Reliability: reliability,
+		Weight:      float64(reliability) / 5 * float64(confidence) / 100,
+	}
+}
+
+// sourceID returns the ID of the source behind an assertion
+func (a *ConditionsAssertion) sourceID() string {
+	if a.Source != nil {
+		return a.Source.SourceID
+	}
+	if a.Conditions.SourceID != nil {
+		return *a.Conditions.SourceID
+	}
+	return ""
+}
+
+// ConsensusValue is one value asserted for a field, with the sources asserting it
+type ConsensusValue struct {
+	Value     interface{} `json:"value"`
+	SourceIDs []string    `json:"source_ids"`
+	Support   float64     `json:"support"` // Share of the weight of sources stating the field, 0-1
+}
+
+// ConditionsConflict records sources disagreeing about a field
+type ConditionsConflict struct {
+	Field  string           `json:"field"`
+	Values []ConsensusValue `json:"values"` // Strongest support first
+}
+
+// GrowingConditionsConsensus is the merged view of every source's growing conditions for a
+// plant in a country
+type GrowingConditionsConsensus struct {
+	PlantID     string                   `json:"plant_id"`
+	CountryID   string                   `json:"country_id"`
+	Conditions  *types.GrowingConditions `json:"conditions,omitempty"` // Nil when no source covers the plant here
+	SourceCount int                      `json:"source_count"`
+	Agreement   int                      `json:"agreement"` // Percentage of stated fields the sources agree on
+	Conflicts   []ConditionsConflict     `json:"conflicts"`
+}
+
+// HasConflicts returns true if any sources disagree
+func (c *GrowingConditionsConsensus) HasConflicts() bool {
+	return len(c.Conflicts) > 0
+}
+
+// GrowingConditionsProvenance is the per-source view behind a consensus
+type GrowingConditionsProvenance struct {
+	PlantID    string                 `json:"plant_id"`
+	CountryID  string                 `json:"country_id"`
+	Assertions []*ConditionsAssertion `json:"assertions"` // Heaviest first
+}
+
+// MergeGrowingConditions merges weighted assertions into a consensus. Lists such as zones keep
+// the values backed by at least ConsensusSupportThreshold of the weight; single values go to
+// the weighted majority; pH ranges are intersected and humidity averaged. Any disagreement is
+// reported as a conflict and marked on the dissenting assertions, which are sorted heaviest
+// first.
+func MergeGrowingConditions(plantID, countryID string, assertions []*ConditionsAssertion) *GrowingConditionsConsensus {
+	sort.SliceStable(assertions, func(i, j int) bool {
+		return assertions[i].Weight > assertions[j].Weight
+	})
+
+	consensus := &GrowingConditionsConsensus{
+		PlantID:     plantID,
+		CountryID:   countryID,
+		SourceCount: len(assertions),
+		Conflicts:   []ConditionsConflict{},
+	}
+	if len(assertions) == 0 {
+		return consensus
+	}
+
+	m := &conditionsMerger{assertions: assertions, consensus: consensus}
+	merged := &types.GrowingConditions{
+		PlantID:   plantID,
+		CountryID: &countryID,
+	}
+
+	merged.HardinessZones = mergeList(m, FieldHardinessZones, func(gc *types.GrowingConditions) []string { return gc.HardinessZones })
+	sortZones(merged.HardinessZones)
+	merged.HeatZones = mergeList(m, FieldHeatZones, func(gc *types.GrowingConditions) []string { return gc.HeatZones })
+	sortZones(merged.HeatZones)
+	merged.SunRequirements = mergeList(m, FieldSunRequirements, func(gc *types.GrowingConditions) []types.SunRequirement { return gc.SunRequirements })
+	merged.SoilTypes = mergeList(m, FieldSoilTypes, func(gc *types.GrowingConditions) []string { return gc.SoilTypes })
+	merged.FloweringMonths = mergeList(m, FieldFloweringMonths, func(gc *types.GrowingConditions) []int { return gc.FloweringMonths })
+	sort.Ints(merged.FloweringMonths)
+	merged.FruitingMonths = mergeList(m, FieldFruitingMonths, func(gc *types.GrowingConditions) []int { return gc.FruitingMonths })
+	sort.Ints(merged.FruitingMonths)
+
+	merged.WaterNeeds = mergeChoice(m, FieldWaterNeeds, func(gc *types.GrowingConditions) *types.WaterNeeds { return gc.WaterNeeds })
+	merged.SoilDrainage = mergeChoice(m, FieldSoilDrainage, func(gc *types.GrowingConditions) *types.SoilDrainage { return gc.SoilDrainage })
+
+	merged.ShadeTolerance = m.mergeFlag(FieldShadeTolerance, func(gc *types.GrowingConditions) bool { return gc.ShadeTolerance })
+	merged.DroughtTolerant = m.mergeFlag(FieldDroughtTolerant, func(gc *types.GrowingConditions) bool { return gc.DroughtTolerant })
+	merged.SaltTolerant = m.mergeFlag(FieldSaltTolerant, func(gc *types.GrowingConditions) bool { return gc.SaltTolerant })
+	merged.WindTolerant = m.mergeFlag(FieldWindTolerant, func(gc *types.GrowingConditions) bool { return gc.WindTolerant })
+
+	merged.HumidityPreference = m.mergeHumidity()
+	merged.PHPreference = m.mergePH()
+
+	// Confidence is the reliability-weighted mean of the sources' confidence
+	var confidenceSum, reliabilitySum float64
+	for _, a := range assertions {
+		confidenceSum += float64(a.Reliability * a.Conditions.ConfidenceScore())
+		reliabilitySum += float64(a.Reliability)
+		if a.Conditions.CreatedAt.After(merged.CreatedAt) {
+			merged.CreatedAt = a.Conditions.CreatedAt
+		}
+	}
+	merged.Confidence = confidenceLevelForScore(confidenceSum / reliabilitySum)
+
+	consensus.Conditions = merged
+	consensus.Agreement = 100
+	if m.stated > 0 {
+		consensus.Agreement = (m.stated - len(consensus.Conflicts)) * 100 / m.stated
+	}
+
+	return consensus
+}
+
+// conditionsMerger accumulates conflicts and dissents while merging fields
+type conditionsMerger struct {
+	assertions []*ConditionsAssertion
+	consensus  *GrowingConditionsConsensus
+	stated     int // Fields stated by at least one source
+}
+
+// vote is the weight behind one value of a field
+type vote[T comparable] struct {
+	value     T
+	sourceIDs []string
+	weight    float64
+}
+
+// tally adds an assertion's weight to a value's vote, keeping votes in order of first appearance
+func tally[T comparable](votes []*vote[T], value T, a *ConditionsAssertion) []*vote[T] {
+	for _, v := range votes {
+		if v.value == value {
+			v.sourceIDs = append(v.sourceIDs, a.sourceID())
+			v.weight += a.Weight
+			return votes
+		}
+	}
+	return append(votes, &vote[T]{value: value, sourceIDs: []string{a.sourceID()}, weight: a.Weight})
+}
+
+// supported returns true if a vote carries enough of the total weight to enter the merged view
+func supported[T comparable](v *vote[T], total float64) bool {
+	return v.weight >= total*ConsensusSupportThreshold-1e-9
+}
+
+// conflict records a disagreement over a field, strongest value first
+func conflict[T comparable](m *conditionsMerger, field string, votes []*vote[T], total float64) {
+	values := make([]ConsensusValue, 0, len(votes))
+	for _, v := range votes {
+		values = append(values, ConsensusValue{Value: v.value, SourceIDs: v.sourceIDs, Support: roundSupport(v.weight / total)})
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Support > values[j].Support
+	})
+	m.consensus.Conflicts = append(m.consensus.Conflicts, ConditionsConflict{Field: field, Values: values})
+}
+
+// mergeList keeps the list values supported by enough weight among the sources stating the
+// field. Sources whose list differs from the result dissent.
+func mergeList[T comparable](m *conditionsMerger, field string, get func(*types.GrowingConditions) []T) []T {
+	var votes []*vote[T]
+	var stating []*ConditionsAssertion
+	var total float64
+	for _, a := range m.assertions {
+		values := get(a.Conditions)
+		if len(values) == 0 {
+			continue
+		}
+		stating = append(stating, a)
+		total += a.Weight
+		for _, value := range dedupe(values) {
+			votes = tally(votes, value, a)
+		}
+	}
+	if len(stating) == 0 {
+		return nil
+	}
+	m.stated++
+
+	merged := make([]T, 0, len(votes))
+	contested := false
+	for _, v := range votes {
+		if supported(v, total) {
+			merged = append(merged, v.value)
+		}
+		if len(v.sourceIDs) < len(stating) {
+			contested = true
+		}
+	}
+
+	if contested {
+		conflict(m, field, votes, total)
+		for _, a := range stating {
+			if !sameSet(dedupe(get(a.Conditions)), merged) {
+				a.Dissents = append(a.Dissents, field)
+			}
+		}
+	}
+
+	return merged
+}
+
+// mergeChoice picks the value with the most weight among the sources stating the field, the
+// heaviest source breaking ties. Sources stating another value dissent.
+func mergeChoice[T comparable](m *conditionsMerger, field string, get func(*types.GrowingConditions) *T) *T {
+	var votes []*vote[T]
+	var total float64
+	for _, a := range m.assertions {
+		if value := get(a.Conditions); value != nil {
+			votes = tally(votes, *value, a)
+			total += a.Weight
+		}
+	}
+	if len(votes) == 0 {
+		return nil
+	}
+	m.stated++
+
+	winner := votes[0]
+	for _, v := range votes[1:] {
+		if v.weight > winner.weight {
+			winner = v
+		}
+	}
+
+	if len(votes) > 1 {
+		conflict(m, field, votes, total)
+		for _, a := range m.assertions {
+			if value := get(a.Conditions); value != nil && *value != winner.value {
+				a.Dissents = append(a.Dissents, field)
+			}
+		}
+	}
+
+	value := winner.value
+	return &value
+}
+
+// mergeFlag sets a tolerance when enough weight asserts it. Every source states tolerances;
+// sources on the other side dissent.
+func (m *conditionsMerger) mergeFlag(field string, get func(*types.GrowingConditions) bool) bool {
+	var votes []*vote[bool]
+	var total float64
+	for _, a := range m.assertions {
+		votes = tally(votes, get(a.Conditions), a)
+		total += a.Weight
+	}
+	m.stated++
+
+	result := false
+	for _, v := range votes {
+		if v.value && supported(v, total) {
+			result = true
+		}
+	}
+
+	if len(votes) > 1 {
+		conflict(m, field, votes, total)
+		for _, a := range m.assertions {
+			if get(a.Conditions) != result {
+				a.Dissents = append(a.Dissents, field)
+			}
+		}
+	}
+
+	return result
+}
+
+// mergeHumidity averages humidity preferences by weight. Sources conflict when their
+// preferences spread wider than HumiditySpreadLimit; those furthest from the mean dissent.
+func (m *conditionsMerger) mergeHumidity() *float64 {
+	var votes []*vote[float64]
+	var sum, total float64
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, a := range m.assertions {
+		humidity := a.Conditions.HumidityPreference
+		if humidity == nil {
+			continue
+		}
+		votes = tally(votes, *humidity, a)
+		sum += *humidity * a.Weight
+		total += a.Weight
+		low = math.Min(low, *humidity)
+		high = math.Max(high, *humidity)
+	}
+	if len(votes) == 0 {
+		return nil
+	}
+	m.stated++
+
+	mean := math.Round(sum/total*10) / 10
+	if high-low > HumiditySpreadLimit {
+		conflict(m, FieldHumidityPreference, votes, total)
+		for _, a := range m.assertions {
+			if humidity := a.Conditions.HumidityPreference; humidity != nil && math.Abs(*humidity-mean) > HumiditySpreadLimit/2 {
+				a.Dissents = append(a.Dissents, FieldHumidityPreference)
+			}
+		}
+	}
+
+	return &mean
+}
+
+// mergePH intersects the sources' pH ranges and averages their optimal pH by weight. When the
+// ranges do not overlap the heaviest source's range stands and the sources conflict; those
+// whose range misses it dissent.
+func (m *conditionsMerger) mergePH() *types.PHRange {
+	var votes []*vote[string]
+	var stating []*ConditionsAssertion
+	var total, optimalSum, optimalWeight float64
+	var low, high *float64
+	for _, a := range m.assertions {
+		ph := a.Conditions.PHPreference
+		if ph == nil || (ph.MinPH == nil && ph.MaxPH == nil && ph.OptimalPH == nil) {
+			continue
+		}
+		stating = append(stating, a)
+		votes = tally(votes, formatPHRange(ph), a)
+		total += a.Weight
+		if ph.MinPH != nil && (low == nil || *ph.MinPH > *low) {
+			low = ph.MinPH
+		}
+		if ph.MaxPH != nil && (high == nil || *ph.MaxPH < *high) {
+			high = ph.MaxPH
+		}
+		if ph.OptimalPH != nil {
+			optimalSum += *ph.OptimalPH * a.Weight
+			optimalWeight += a.Weight
+		}
+	}
+	if len(stating) == 0 {
+		return nil
+	}
+	m.stated++
+
+	if low != nil && high != nil && *low > *high {
+		conflict(m, FieldPHPreference, votes, total)
+		heaviest := stating[0].Conditions.PHPreference
+		for _, a := range stating[1:] {
+			if !phRangesOverlap(a.Conditions.PHPreference, heaviest) {
+				a.Dissents = append(a.Dissents, FieldPHPreference)
+			}
+		}
+		return heaviest
+	}
+
+	merged := &types.PHRange{MinPH: copyFloat(low), MaxPH: copyFloat(high)}
+	if optimalWeight > 0 {
+		optimal := math.Round(optimalSum/optimalWeight*10) / 10
+		if (low == nil || optimal >= *low) && (high == nil || optimal <= *high) {
+			merged.OptimalPH = &optimal
+		}
+	}
+	return merged
+}
+
+// confidenceLevelForScore maps a 0-100 confidence score onto the confidence level bands
+func confidenceLevelForScore(score float64) types.ConfidenceLevel {
+	switch {
+	case score >= 95:
+		return types.ConfidenceConfirmed
+	case score >= 80:
+		return types.ConfidenceVeryHigh
+	case score >= 60:
+		return types.ConfidenceProbable
+	case score >= 40:
+		return types.ConfidenceModerate
+	case score >= 20:
+		return types.ConfidenceLow
+	default:
+		return types.ConfidenceVeryLow
+	}
+}
+
+// sortZones orders zones by number, then by subzone ("9b" before "10a")
+func sortZones(zones []string) {
+	sort.SliceStable(zones, func(i, j int) bool {
+		ni, si := splitZone(zones[i])
+		nj, sj := splitZone(zones[j])
+		if ni != nj {
+			return ni < nj
+		}
+		return si < sj
+	})
+}
+
+// splitZone splits a zone such as "7b" into its number and subzone
+func splitZone(zone string) (int, string) {
+	digits := strings.TrimRightFunc(zone, func(r rune) bool { return r < '0' || r > '9' })
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return math.MaxInt, zone
+	}
+	return n, zone[len(digits):]
+}
+
+// dedupe removes repeated values, keeping the first occurrence
+func dedupe[T comparable](values []T) []T {
+	seen := make(map[T]bool, len(values))
+	result := make([]T, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// sameSet returns true if two duplicate-free lists hold the same values
+func sameSet[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[T]bool, len(a))
+	for _, value := range a {
+		set[value] = true
+	}
+	for _, value := range b {
+		if !set[value] {
+			return false
+		}
+	}
+	return true
+}
+
+// phRangesOverlap returns true if two pH ranges share any pH, open ends overlapping everything
+func phRangesOverlap(a, b *types.PHRange) bool {
+	if a.MinPH != nil && b.MaxPH != nil && *a.MinPH > *b.MaxPH {
+		return false
+	}
+	if b.MinPH != nil && a.MaxPH != nil && *b.MinPH > *a.MaxPH {
+		return false
+	}
+	return true
+}
+
+// formatPHRange describes a pH range for conflict reports, e.g. "6.0-7.0"
+func formatPHRange(ph *types.PHRange) string {
+	bound := func(value *float64) string {
+		if value == nil {
+			return "?"
+		}
+		return strconv.FormatFloat(*value, 'f', 1, 64)
+	}
+	description := fmt.Sprintf("%s-%s", bound(ph.MinPH), bound(ph.MaxPH))
+	if ph.OptimalPH != nil {
+		description += fmt.Sprintf(" (optimal %s)", bound(ph.OptimalPH))
+	}
+	return description
+}
+
+// roundSupport rounds a support share to two decimal places
+func roundSupport(support float64) float64 {
+	return math.Round(support*100) / 100
+}
+
+// copyFloat returns a copy of a float pointer so merged values do not alias source values
+func copyFloat(value *float64) *float64 {
+	if value == nil {
+		return nil
+	}
+	v := *value
+	return &v
+}
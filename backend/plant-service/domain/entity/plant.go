@@ -26,6 +26,13 @@ type Plant struct {
 	// Full botanical name (generated from taxonomy)
 	FullBotanicalName string `json:"full_botanical_name"`
 
+	// Deprecated name this plant was found by, set when a lookup matched a synonym
+	// rather than the current name
+	MatchedSynonym *string `json:"matched_synonym,omitempty"`
+
+	// Localized description and care guide, loaded on request
+	Guide *PlantGuide `json:"guide,omitempty"`
+
 	// Aggregated data from related tables
 	GrowingConditions      *types.GrowingConditions      `json:"growing_conditions,omitempty"`
 	PhysicalCharacteristics *types.PhysicalCharacteristics `json:"physical_characteristics,omitempty"`
@@ -161,6 +168,16 @@ func (p *Plant) SearchScore(query string) int {
 		}
 	}
 
+	// Synonym match - the accepted plant for a deprecated name the user typed
+	if p.MatchedSynonym != nil {
+		synonymLower := strings.ToLower(*p.MatchedSynonym)
+		if synonymLower == query {
+			score += 95
+		} else if strings.Contains(synonymLower, query) {
+			score += 45
+		}
+	}
+
 	// Family match (lower priority)
 	if strings.ToLower(p.FamilyName) == query {
 		score += 20
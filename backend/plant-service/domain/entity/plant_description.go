@@ -0,0 +1,195 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Guide sections of a plant description
+const (
+	GuideSectionSummary     = "summary"
+	GuideSectionOverview    = "overview"
+	GuideSectionPlanting    = "planting"
+	GuideSectionCare        = "care"
+	GuideSectionPropagation = "propagation"
+	GuideSectionUses        = "uses"
+)
+
+// GuideSections lists the guide sections in reading order
+var GuideSections = []string{
+	GuideSectionSummary,
+	GuideSectionOverview,
+	GuideSectionPlanting,
+	GuideSectionCare,
+	GuideSectionPropagation,
+	GuideSectionUses,
+}
+
+// Maximum section lengths in characters
+const (
+	MaxGuideSummaryLength = 500
+	MaxGuideSectionLength = 20000
+)
+
+// PlantDescription is a plant's description and care guide in one language,
+// optionally specific to one country
+type PlantDescription struct {
+	DescriptionID string  `json:"description_id"`
+	PlantID       string  `json:"plant_id"`
+	LanguageID    string  `json:"language_id"`
+	LanguageCode  string  `json:"language_code,omitempty"` // Read-only
+	CountryID     *string `json:"country_id,omitempty"`    // nil applies to all countries
+
+	Summary     *string `json:"summary,omitempty"` // 1-2 sentences
+	Overview    *string `json:"overview,omitempty"`
+	Planting    *string `json:"planting,omitempty"`
+	Care        *string `json:"care,omitempty"`
+	Propagation *string `json:"propagation,omitempty"`
+	Uses        *string `json:"uses,omitempty"`
+
+	IsPrimary bool    `json:"is_primary"`
+	SourceID  *string `json:"source_id,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Section returns the text of a guide section, nil when missing or blank
+func (d *PlantDescription) Section(section string) *string {
+	var text *string
+	switch section {
+	case GuideSectionSummary:
+		text = d.Summary
+	case GuideSectionOverview:
+		text = d.Overview
+	case GuideSectionPlanting:
+		text = d.Planting
+	case GuideSectionCare:
+		text = d.Care
+	case GuideSectionPropagation:
+		text = d.Propagation
+	case GuideSectionUses:
+		text = d.Uses
+	}
+	if text == nil || strings.TrimSpace(*text) == "" {
+		return nil
+	}
+	return text
+}
+
+// Validate validates the plant description
+func (d *PlantDescription) Validate() error {
+	if d.PlantID == "" {
+		return fmt.Errorf("plant_id is required")
+	}
+	if d.LanguageID == "" {
+		return fmt.Errorf("language_id is required")
+	}
+
+	hasContent := false
+	for _, section := range GuideSections {
+		text := d.Section(section)
+		if text == nil {
+			continue
+		}
+		hasContent = true
+
+		limit := MaxGuideSectionLength
+		if section == GuideSectionSummary {
+			limit = MaxGuideSummaryLength
+		}
+		if len([]rune(*text)) > limit {
+			return fmt.Errorf("%s must be at most %d characters", section, limit)
+		}
+	}
+	if !hasContent {
+		return fmt.Errorf("at least one section is required")
+	}
+
+	return nil
+}
+
+// GuideSection is one section of a plant guide in the best available language
+type GuideSection struct {
+	Content    string  `json:"content"`
+	LanguageID string  `json:"language_id"`
+	CountryID  *string `json:"country_id,omitempty"`
+	IsFallback bool    `json:"is_fallback"` // Served in English because the requested language has no text
+}
+
+// PlantGuide is a plant's localized description and care guide. Each section falls back
+// to English independently when the requested language doesn't cover it.
+type PlantGuide struct {
+	PlantID    string  `json:"plant_id"`
+	LanguageID string  `json:"language_id"` // Requested language
+	CountryID  *string `json:"country_id,omitempty"`
+
+	Summary     *GuideSection `json:"summary,omitempty"`
+	Overview    *GuideSection `json:"overview,omitempty"`
+	Planting    *GuideSection `json:"planting,omitempty"`
+	Care        *GuideSection `json:"care,omitempty"`
+	Propagation *GuideSection `json:"propagation,omitempty"`
+	Uses        *GuideSection `json:"uses,omitempty"`
+}
+
+// BuildPlantGuide picks each section from the best description: the requested language
+// before the fallback language, and the requested country before country-independent text.
+// Descriptions in other languages or for other countries are ignored.
+func BuildPlantGuide(plantID string, descriptions []*PlantDescription, languageID string, countryID *string, fallbackLanguageID string) *PlantGuide {
+	guide := &PlantGuide{PlantID: plantID, LanguageID: languageID, CountryID: countryID}
+
+	// Lower ranks are preferred; -1 means the description doesn't apply
+	rank := func(d *PlantDescription) int {
+		r := 0
+		switch d.LanguageID {
+		case languageID:
+		case fallbackLanguageID:
+			r += 2
+		default:
+			return -1
+		}
+		switch {
+		case d.CountryID == nil:
+			r++
+		case countryID == nil || *d.CountryID != *countryID:
+			return -1
+		}
+		return r
+	}
+
+	targets := map[string]**GuideSection{
+		GuideSectionSummary:     &guide.Summary,
+		GuideSectionOverview:    &guide.Overview,
+		GuideSectionPlanting:    &guide.Planting,
+		GuideSectionCare:        &guide.Care,
+		GuideSectionPropagation: &guide.Propagation,
+		GuideSectionUses:        &guide.Uses,
+	}
+
+	for _, section := range GuideSections {
+		bestRank := -1
+		var best *PlantDescription
+		for _, d := range descriptions {
+			r := rank(d)
+			if r < 0 || d.Section(section) == nil {
+				continue
+			}
+			if best == nil || r < bestRank {
+				best, bestRank = d, r
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		*targets[section] = &GuideSection{
+			Content:    *best.Section(section),
+			LanguageID: best.LanguageID,
+			CountryID:  best.CountryID,
+			IsFallback: best.LanguageID != languageID,
+		}
+	}
+
+	return guide
+}
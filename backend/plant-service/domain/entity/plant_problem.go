@@ -5,14 +5,100 @@ import (
 	"time"
 )
 
-// PlantProblem represents a pest, disease, or deficiency affecting a plant
+// Plant parts a problem can affect
+const (
+	PlantPartLeaves     = "leaves"
+	PlantPartStems      = "stems"
+	PlantPartRoots      = "roots"
+	PlantPartFlowers    = "flowers"
+	PlantPartFruit      = "fruit"
+	PlantPartSeeds      = "seeds"
+	PlantPartBulbs      = "bulbs"
+	PlantPartBark       = "bark"
+	PlantPartWholePlant = "whole_plant"
+)
+
+// IsValidPlantPart reports whether part is a known affected plant part
+func IsValidPlantPart(part string) bool {
+	switch part {
+	case PlantPartLeaves, PlantPartStems, PlantPartRoots, PlantPartFlowers, PlantPartFruit,
+		PlantPartSeeds, PlantPartBulbs, PlantPartBark, PlantPartWholePlant:
+		return true
+	}
+	return false
+}
+
+// Problem types
+const (
+	ProblemTypePest       = "pest"
+	ProblemTypeDisease    = "disease"
+	ProblemTypeDeficiency = "deficiency"
+	ProblemTypeToxicity   = "toxicity"
+)
+
+// Problem severities
+const (
+	ProblemSeverityLow    = "low"
+	ProblemSeverityMedium = "medium"
+	ProblemSeverityHigh   = "high"
+)
+
+// IsValidProblemType reports whether problemType is a known problem type
+func IsValidProblemType(problemType string) bool {
+	switch problemType {
+	case ProblemTypePest, ProblemTypeDisease, ProblemTypeDeficiency, ProblemTypeToxicity:
+		return true
+	}
+	return false
+}
+
+// IsValidProblemSeverity reports whether severity is a known problem severity
+func IsValidProblemSeverity(severity string) bool {
+	switch severity {
+	case ProblemSeverityLow, ProblemSeverityMedium, ProblemSeverityHigh:
+		return true
+	}
+	return false
+}
+
+// PlantProblem represents a pest, disease, or deficiency affecting a plant.
+// Name, symptoms, treatments, prevention and regional notes are localized;
+// affected parts and active months are language independent.
 type PlantProblem struct {
-	ProblemID   string    `json:"problem_id"`
-	PlantID     string    `json:"plant_id"`
-	ProblemType string    `json:"problem_type"` // pest, disease, deficiency, toxicity
-	Severity    string    `json:"severity"`     // low, medium, high
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ProblemID   string `json:"problem_id"`
+	PlantID     string `json:"plant_id"`
+	PlantName   string `json:"plant_name,omitempty"` // Botanical name of the affected plant (read-only)
+	ProblemType string `json:"problem_type"`         // pest, disease, deficiency, toxicity
+	Severity    string `json:"severity"`             // low, medium, high
+	ProblemName string `json:"problem_name"`
+
+	// Identification
+	Symptoms      []string `json:"symptoms,omitempty"`
+	AffectedParts []string `json:"affected_parts,omitempty"`
+	ActiveMonths  []int    `json:"active_months,omitempty"` // 1-12, empty means year-round
+
+	// Treatment and prevention
+	Treatments         []string `json:"treatments,omitempty"` // General and cultural measures
+	OrganicTreatments  []string `json:"organic_treatments,omitempty"`
+	ChemicalTreatments []string `json:"chemical_treatments,omitempty"`
+	Prevention         []string `json:"prevention,omitempty"`
+	RegionalNotes      *string  `json:"regional_notes,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsActiveIn reports whether the problem typically occurs in the given month (1-12)
+func (pp *PlantProblem) IsActiveIn(month int) bool {
+	if len(pp.ActiveMonths) == 0 {
+		return true
+	}
+	for _, m := range pp.ActiveMonths {
+		if m == month {
+			return true
+		}
+	}
+	return false
 }
 
 // Validate validates the plant problem entity
@@ -25,27 +111,30 @@ func (pp *PlantProblem) Validate() error {
 		return fmt.Errorf("problem_type is required")
 	}
 
-	// Validate problem type
-	validTypes := map[string]bool{
-		"pest":        true,
-		"disease":     true,
-		"deficiency":  true,
-		"toxicity":    true,
+	if pp.ProblemName == "" {
+		return fmt.Errorf("problem_name is required")
 	}
 
-	if !validTypes[pp.ProblemType] {
+	// Validate problem type
+	if !IsValidProblemType(pp.ProblemType) {
 		return fmt.Errorf("invalid problem_type: %s (must be pest, disease, deficiency, or toxicity)", pp.ProblemType)
 	}
 
 	// Validate severity
-	validSeverities := map[string]bool{
-		"low":    true,
-		"medium": true,
-		"high":   true,
+	if !IsValidProblemSeverity(pp.Severity) {
+		return fmt.Errorf("invalid severity: %s (must be low, medium, or high)", pp.Severity)
 	}
 
-	if !validSeverities[pp.Severity] {
-		return fmt.Errorf("invalid severity: %s (must be low, medium, or high)", pp.Severity)
+	for _, part := range pp.AffectedParts {
+		if !IsValidPlantPart(part) {
+			return fmt.Errorf("invalid affected part: %s", part)
+		}
+	}
+
+	for _, month := range pp.ActiveMonths {
+		if month < 1 || month > 12 {
+			return fmt.Errorf("invalid active month: %d (must be 1-12)", month)
+		}
 	}
 
 	return nil
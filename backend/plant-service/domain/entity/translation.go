@@ -0,0 +1,261 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Translation domains, one per translatable table
+const (
+	TranslationDomainCharacteristic    = "characteristic"     // characteristic_translations
+	TranslationDomainPhysicalTraits    = "physical_traits"    // physical_traits_i18n
+	TranslationDomainGrowingConditions = "growing_conditions" // growing_conditions_i18n
+	TranslationDomainCompanionBenefits = "companion_benefits" // companion_benefits_i18n
+	TranslationDomainCommonNames       = "common_names"       // plant_common_names
+)
+
+// TranslationDomains lists the translation domains in reporting order
+var TranslationDomains = []string{
+	TranslationDomainCharacteristic,
+	TranslationDomainPhysicalTraits,
+	TranslationDomainGrowingConditions,
+	TranslationDomainCompanionBenefits,
+	TranslationDomainCommonNames,
+}
+
+// TranslatableCharacteristics are the enum characteristics shown to users through
+// CharacteristicTranslator. Each is also the name of its PostgreSQL enum type.
+var TranslatableCharacteristics = []string{
+	"sun_requirement",
+	"water_needs",
+	"soil_drainage",
+	"growth_rate",
+}
+
+// Translated fields per domain. Physical traits use the trait key as the field.
+const (
+	TranslationFieldLabel               = "label"
+	TranslationFieldDescription         = "description"
+	TranslationFieldSoilTypes           = "soil_types"
+	TranslationFieldSpecialRequirements = "special_requirements"
+	TranslationFieldRegionalTips        = "regional_tips"
+	TranslationFieldName                = "name"
+)
+
+// TranslationListSeparator separates the items of list values such as soil types
+const TranslationListSeparator = "|"
+
+// MaxTranslationValueLength is the longest translated value in characters
+const MaxTranslationValueLength = 5000
+
+// IsValidTranslationDomain checks if a translation domain is valid
+func IsValidTranslationDomain(domain string) bool {
+	for _, d := range TranslationDomains {
+		if d == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// Translation is one translated value, as authored by translators and imported in bulk.
+// Key identifies the translated record: "category:value" for characteristics
+// (e.g. "sun_requirement:full_sun"), otherwise the ID of the characteristic, assertion,
+// companion relationship or plant.
+type Translation struct {
+	Domain   string  `json:"domain"`
+	Key      string  `json:"key"`
+	Field    string  `json:"field,omitempty"`   // Defaults per domain; required for traits and growing conditions
+	Language string  `json:"language"`          // Language code
+	Country  *string `json:"country,omitempty"` // Country code, common names only
+	Value    string  `json:"value"`             // List values are separated by TranslationListSeparator
+
+	// Resolved by the service before saving
+	LanguageID string  `json:"-"`
+	CountryID  *string `json:"-"`
+}
+
+// Normalize trims the translation and fills in the domain's default field
+func (t *Translation) Normalize() {
+	t.Domain = strings.ToLower(strings.TrimSpace(t.Domain))
+	t.Key = strings.TrimSpace(t.Key)
+	t.Field = strings.TrimSpace(t.Field)
+	t.Language = strings.ToLower(strings.TrimSpace(t.Language))
+	t.Value = strings.TrimSpace(t.Value)
+	if t.Country != nil {
+		if country := strings.ToUpper(strings.TrimSpace(*t.Country)); country != "" {
+			t.Country = &country
+		} else {
+			t.Country = nil
+		}
+	}
+
+	if t.Field != "" {
+		return
+	}
+	switch t.Domain {
+	case TranslationDomainCharacteristic:
+		t.Field = TranslationFieldLabel
+	case TranslationDomainCompanionBenefits:
+		t.Field = TranslationFieldDescription
+	case TranslationDomainCommonNames:
+		t.Field = TranslationFieldName
+	}
+}
+
+// Validate validates a normalized translation
+func (t *Translation) Validate() error {
+	if !IsValidTranslationDomain(t.Domain) {
+		return fmt.Errorf("domain must be one of %s", strings.Join(TranslationDomains, ", "))
+	}
+	if t.Key == "" {
+		return fmt.Errorf("key is required")
+	}
+	if t.Language == "" {
+		return fmt.Errorf("language is required")
+	}
+	if t.Value == "" {
+		return fmt.Errorf("value is required")
+	}
+	if len([]rune(t.Value)) > MaxTranslationValueLength {
+		return fmt.Errorf("value must be at most %d characters", MaxTranslationValueLength)
+	}
+	if t.Country != nil && t.Domain != TranslationDomainCommonNames {
+		return fmt.Errorf("country only applies to common names")
+	}
+
+	switch t.Domain {
+	case TranslationDomainCharacteristic:
+		if _, _, ok := t.CharacteristicKey(); !ok {
+			return fmt.Errorf("key must be category:value with category one of %s", strings.Join(TranslatableCharacteristics, ", "))
+		}
+		if t.Field != TranslationFieldLabel && t.Field != TranslationFieldDescription {
+			return fmt.Errorf("field must be label or description")
+		}
+		if t.Field == TranslationFieldLabel && len([]rune(t.Value)) > 200 {
+			return fmt.Errorf("label must be at most 200 characters")
+		}
+		return nil
+	case TranslationDomainPhysicalTraits:
+		if t.Field == "" {
+			return fmt.Errorf("field must name the trait")
+		}
+	case TranslationDomainGrowingConditions:
+		switch t.Field {
+		case TranslationFieldSoilTypes, TranslationFieldSpecialRequirements, TranslationFieldRegionalTips:
+		default:
+			return fmt.Errorf("field must be soil_types, special_requirements, or regional_tips")
+		}
+	case TranslationDomainCompanionBenefits:
+		if t.Field != TranslationFieldDescription {
+			return fmt.Errorf("field must be description")
+		}
+	case TranslationDomainCommonNames:
+		if t.Field != TranslationFieldName {
+			return fmt.Errorf("field must be name")
+		}
+		if len([]rune(t.Value)) > 200 {
+			return fmt.Errorf("name must be at most 200 characters")
+		}
+	}
+
+	if _, err := uuid.Parse(t.Key); err != nil {
+		return fmt.Errorf("key must be a valid UUID")
+	}
+	return nil
+}
+
+// CharacteristicKey splits a characteristic key into its category and value
+func (t *Translation) CharacteristicKey() (string, string, bool) {
+	category, value, found := strings.Cut(t.Key, ":")
+	if !found || value == "" {
+		return "", "", false
+	}
+	for _, c := range TranslatableCharacteristics {
+		if c == category {
+			return category, value, true
+		}
+	}
+	return "", "", false
+}
+
+// ListValues splits a list value into its trimmed, non-empty items
+func (t *Translation) ListValues() []string {
+	var items []string
+	for _, item := range strings.Split(t.Value, TranslationListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// MissingTranslation is a record with no translation in a language
+type MissingTranslation struct {
+	Domain  string  `json:"domain"`
+	Key     string  `json:"key"`
+	PlantID *string `json:"plant_id,omitempty"` // Plant the record belongs to, if any
+	Source  string  `json:"source"`             // English text, or the original value when there is none
+}
+
+// DomainCoverage is how much of a domain is translated in one language
+type DomainCoverage struct {
+	Domain     string  `json:"domain"`
+	Total      int     `json:"total"`
+	Translated int     `json:"translated"`
+	Percent    float64 `json:"percent"`
+}
+
+// LanguageCoverage is a language's translation coverage across all domains
+type LanguageCoverage struct {
+	LanguageID   string            `json:"language_id"`
+	LanguageCode string            `json:"language_code"`
+	LanguageName string            `json:"language_name"`
+	Domains      []*DomainCoverage `json:"domains"`
+	Total        int               `json:"total"`
+	Translated   int               `json:"translated"`
+	Percent      float64           `json:"percent"`
+}
+
+// CoveragePercent returns translated as a percentage of total, to one decimal place.
+// Nothing to translate counts as fully covered.
+func CoveragePercent(translated, total int) float64 {
+	if total <= 0 {
+		return 100
+	}
+	return math.Round(float64(translated)/float64(total)*1000) / 10
+}
+
+// NewLanguageCoverage totals a language's domain coverage
+func NewLanguageCoverage(language *Language, domains []*DomainCoverage) *LanguageCoverage {
+	coverage := &LanguageCoverage{
+		LanguageID:   language.LanguageID,
+		LanguageCode: language.LanguageCode,
+		LanguageName: language.LanguageName,
+		Domains:      domains,
+	}
+	for _, d := range domains {
+		d.Percent = CoveragePercent(d.Translated, d.Total)
+		coverage.Total += d.Total
+		coverage.Translated += d.Translated
+	}
+	coverage.Percent = CoveragePercent(coverage.Translated, coverage.Total)
+	return coverage
+}
+
+// TranslationImportError describes a rejected row of a bulk import
+type TranslationImportError struct {
+	Row     int    `json:"row"` // 1-based, excluding any CSV header
+	Message string `json:"message"`
+}
+
+// TranslationImportResult summarizes a bulk import. Valid rows are saved even when
+// other rows are rejected.
+type TranslationImportResult struct {
+	Imported int                       `json:"imported"`
+	Failed   int                       `json:"failed"`
+	Errors   []*TranslationImportError `json:"errors"`
+}
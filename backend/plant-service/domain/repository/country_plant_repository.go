@@ -19,6 +19,10 @@ type CountryPlantRepository interface {
 	// FindByCountryAndPlant retrieves a specific country-plant relationship
 	FindByCountryAndPlant(ctx context.Context, countryID, plantID string) (*entity.CountryPlant, error)
 
+	// FindByCountryAndPlants retrieves the relationships of several plants in a country;
+	// plants with no relationship are omitted
+	FindByCountryAndPlants(ctx context.Context, countryID string, plantIDs []string) ([]*entity.CountryPlant, error)
+
 	// FindByNativeStatus retrieves all plants with a specific native status in a country
 	FindByNativeStatus(ctx context.Context, countryID, nativeStatus string) ([]*entity.CountryPlant, error)
 
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+)
+
+// PlantDescriptionRepository defines the interface for localized plant description and
+// care guide data access. A plant has at most one description per language and country;
+// a nil country is the country-independent description.
+type PlantDescriptionRepository interface {
+	// FindByPlant retrieves all of a plant's descriptions, in every language and country
+	FindByPlant(ctx context.Context, plantID string) ([]*entity.PlantDescription, error)
+
+	// FindForLocale retrieves a plant's descriptions in the language or English, either
+	// country-independent or for the country
+	FindForLocale(ctx context.Context, plantID, languageID string, countryID *string) ([]*entity.PlantDescription, error)
+
+	// Find retrieves the description for one plant, language and country
+	Find(ctx context.Context, plantID, languageID string, countryID *string) (*entity.PlantDescription, error)
+
+	// Save creates the description, or replaces the one for the same plant, language and country
+	Save(ctx context.Context, description *entity.PlantDescription) error
+
+	// Delete deletes the description for one plant, language and country
+	Delete(ctx context.Context, plantID, languageID string, countryID *string) error
+}
@@ -5,19 +5,31 @@ import (
 	"twigger-backend/backend/plant-service/domain/entity"
 )
 
-// PlantProblemRepository defines the interface for plant problem data access
+// PlantProblemRepository defines the interface for plant problem data access.
+// Methods taking a language return localized content, falling back to English
+// and then to the problem's default text.
 type PlantProblemRepository interface {
-	// FindByID retrieves a plant problem by its ID
+	// FindByID retrieves a plant problem by its ID (English content)
 	FindByID(ctx context.Context, problemID string) (*entity.PlantProblem, error)
 
+	// FindLocalized retrieves a plant problem by its ID with localized content
+	FindLocalized(ctx context.Context, problemID, languageID string, countryID *string) (*entity.PlantProblem, error)
+
 	// FindByPlant retrieves problems for a plant with pagination
-	FindByPlant(ctx context.Context, plantID string, limit, offset int) ([]*entity.PlantProblem, error)
+	FindByPlant(ctx context.Context, plantID, languageID string, countryID *string, limit, offset int) ([]*entity.PlantProblem, error)
 
 	// FindByType retrieves problems of a specific type for a plant with pagination
-	FindByType(ctx context.Context, plantID, problemType string, limit, offset int) ([]*entity.PlantProblem, error)
+	FindByType(ctx context.Context, plantID, problemType, languageID string, countryID *string, limit, offset int) ([]*entity.PlantProblem, error)
 
 	// FindBySeverity retrieves problems of a specific severity for a plant with pagination
-	FindBySeverity(ctx context.Context, plantID, severity string, limit, offset int) ([]*entity.PlantProblem, error)
+	FindBySeverity(ctx context.Context, plantID, severity, languageID string, countryID *string, limit, offset int) ([]*entity.PlantProblem, error)
+
+	// FindSusceptiblePlants returns the plants among plantIDs that the catalogue records
+	// the same problem for: a problem of the same type and name
+	FindSusceptiblePlants(ctx context.Context, problemID string, plantIDs []string) ([]string, error)
+
+	// Search searches the problem catalogue across all plants
+	Search(ctx context.Context, filter *ProblemSearchFilter, languageID string, countryID *string) (*ProblemSearchResult, error)
 
 	// Create creates a new plant problem
 	Create(ctx context.Context, problem *entity.PlantProblem) error
@@ -28,3 +40,26 @@ type PlantProblemRepository interface {
 	// Delete deletes a plant problem by ID
 	Delete(ctx context.Context, problemID string) error
 }
+
+// ProblemSearchFilter represents filter criteria for the problem catalogue
+type ProblemSearchFilter struct {
+	// Query matches problem names, symptoms and the affected plant's botanical or common names
+	Query        string
+	PlantID      *string
+	ProblemType  *string
+	Severity     *string
+	AffectedPart *string
+	Month        *int // 1-12, matches problems active that month (or year-round)
+
+	Limit  int
+	Offset int
+}
+
+// ProblemSearchResult represents problem catalogue results with metadata
+type ProblemSearchResult struct {
+	Problems []*entity.PlantProblem `json:"problems"`
+	Total    int64                  `json:"total"`
+	Limit    int                    `json:"limit"`
+	Offset   int                    `json:"offset"`
+	HasMore  bool                   `json:"has_more"`
+}
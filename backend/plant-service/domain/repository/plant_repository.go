@@ -28,6 +28,7 @@ type PlantRepository interface {
 
 	// Growing conditions queries
 	GetGrowingConditions(ctx context.Context, plantID, countryID, languageID string) (*types.GrowingConditions, error)
+	GetGrowingConditionsAssertions(ctx context.Context, plantID, countryID, languageID string) ([]*types.GrowingConditions, error)
 	FindByGrowingConditions(ctx context.Context, filter *GrowingConditionsFilter) ([]*entity.Plant, error)
 
 	// Physical characteristics queries
@@ -117,6 +118,9 @@ type GrowingConditionsFilter struct {
 	HardinessZone *string
 	HeatZone      *string
 
+	// Only plants with assertions for this country
+	CountryID *string
+
 	// Environmental requirements
 	SunRequirements []types.SunRequirement
 	WaterNeeds      *types.WaterNeeds
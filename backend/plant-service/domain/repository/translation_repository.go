@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+)
+
+// TranslationRepository defines data access for translation management across the
+// characteristic, trait, growing condition, companion benefit and common name tables
+type TranslationRepository interface {
+	// FindMissing lists the records of a domain with no translation in a language
+	FindMissing(ctx context.Context, domain, languageID string, limit, offset int) (*MissingTranslationResult, error)
+
+	// Coverage counts translatable and translated records per domain for a language
+	Coverage(ctx context.Context, languageID string) ([]*entity.DomainCoverage, error)
+
+	// Save creates or updates one translated value. The translation's language
+	// (and country, for common names) must already be resolved.
+	Save(ctx context.Context, translation *entity.Translation) error
+}
+
+// MissingTranslationResult is a page of untranslated records
+type MissingTranslationResult struct {
+	Translations []*entity.MissingTranslation `json:"translations"`
+	Total        int64                        `json:"total"`
+	Limit        int                          `json:"limit"`
+	Offset       int                          `json:"offset"`
+	HasMore      bool                         `json:"has_more"`
+}
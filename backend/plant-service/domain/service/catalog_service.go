@@ -0,0 +1,486 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+)
+
+// CatalogService manages the reference data plants are built from: data sources, the
+// family > genus > species > cultivar taxonomy, and synonyms. Parents must exist before
+// children are added, names are unique within their parent, and nothing is deleted while
+// other records still depend on it.
+type CatalogService struct {
+	sourceRepo   repository.DataSourceRepository
+	familyRepo   repository.PlantFamilyRepository
+	genusRepo    repository.PlantGenusRepository
+	speciesRepo  repository.PlantSpeciesRepository
+	cultivarRepo repository.CultivarRepository
+	synonymRepo  repository.PlantSynonymRepository
+	plantRepo    repository.PlantRepository
+}
+
+// NewCatalogService creates a new catalog service
+func NewCatalogService(
+	sourceRepo repository.DataSourceRepository,
+	familyRepo repository.PlantFamilyRepository,
+	genusRepo repository.PlantGenusRepository,
+	speciesRepo repository.PlantSpeciesRepository,
+	cultivarRepo repository.CultivarRepository,
+	synonymRepo repository.PlantSynonymRepository,
+	plantRepo repository.PlantRepository,
+) *CatalogService {
+	return &CatalogService{
+		sourceRepo:   sourceRepo,
+		familyRepo:   familyRepo,
+		genusRepo:    genusRepo,
+		speciesRepo:  speciesRepo,
+		cultivarRepo: cultivarRepo,
+		synonymRepo:  synonymRepo,
+		plantRepo:    plantRepo,
+	}
+}
+
+// Data sources
+
+// ListDataSources retrieves all data sources, or those of one type
+func (s *CatalogService) ListDataSources(ctx context.Context, sourceType string) ([]*entity.DataSource, error) {
+	if sourceType = strings.TrimSpace(sourceType); sourceType != "" {
+		return s.sourceRepo.FindByType(ctx, sourceType)
+	}
+	return s.sourceRepo.FindAll(ctx)
+}
+
+// GetDataSource retrieves a data source by ID
+func (s *CatalogService) GetDataSource(ctx context.Context, sourceID string) (*entity.DataSource, error) {
+	return s.sourceRepo.FindByID(ctx, sourceID)
+}
+
+// CreateDataSource adds a data source. Reliability defaults to the data_sources default.
+func (s *CatalogService) CreateDataSource(ctx context.Context, source *entity.DataSource) error {
+	source.SourceID = uuid.New().String()
+	source.SourceName = strings.TrimSpace(source.SourceName)
+	if source.ReliabilityScore == 0 {
+		source.ReliabilityScore = entity.DefaultSourceReliability
+	}
+
+	if err := source.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	return s.sourceRepo.Create(ctx, source)
+}
+
+// UpdateDataSource replaces a data source's details
+func (s *CatalogService) UpdateDataSource(ctx context.Context, sourceID string, source *entity.DataSource) error {
+	existing, err := s.sourceRepo.FindByID(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	source.SourceID = sourceID
+	source.SourceName = strings.TrimSpace(source.SourceName)
+	source.CreatedAt = existing.CreatedAt
+	if err := source.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	return s.sourceRepo.Update(ctx, source)
+}
+
+// DeleteDataSource removes a data source no assertion still cites
+func (s *CatalogService) DeleteDataSource(ctx context.Context, sourceID string) error {
+	return s.sourceRepo.Delete(ctx, sourceID)
+}
+
+// Families
+
+// ListFamilies retrieves all plant families, or those matching a name query
+func (s *CatalogService) ListFamilies(ctx context.Context, query string, limit int) ([]*entity.PlantFamily, error) {
+	if query = strings.TrimSpace(query); query != "" {
+		return s.familyRepo.Search(ctx, query, limit)
+	}
+	return s.familyRepo.FindAll(ctx)
+}
+
+// GetFamily retrieves a plant family by ID
+func (s *CatalogService) GetFamily(ctx context.Context, familyID string) (*entity.PlantFamily, error) {
+	return s.familyRepo.FindByID(ctx, familyID)
+}
+
+// CreateFamily adds a plant family with a unique name
+func (s *CatalogService) CreateFamily(ctx context.Context, family *entity.PlantFamily) error {
+	family.FamilyID = uuid.New().String()
+	if err := s.validateFamily(ctx, family); err != nil {
+		return err
+	}
+	return s.familyRepo.Create(ctx, family)
+}
+
+// UpdateFamily renames a plant family
+func (s *CatalogService) UpdateFamily(ctx context.Context, familyID string, family *entity.PlantFamily) error {
+	existing, err := s.familyRepo.FindByID(ctx, familyID)
+	if err != nil {
+		return err
+	}
+
+	family.FamilyID = familyID
+	family.CreatedAt = existing.CreatedAt
+	if err := s.validateFamily(ctx, family); err != nil {
+		return err
+	}
+	return s.familyRepo.Update(ctx, family)
+}
+
+// DeleteFamily removes a plant family that has no genera
+func (s *CatalogService) DeleteFamily(ctx context.Context, familyID string) error {
+	genera, err := s.genusRepo.FindByFamily(ctx, familyID)
+	if err != nil {
+		return err
+	}
+	if len(genera) > 0 {
+		return entity.NewConflictError("plant family", familyID, fmt.Sprintf("still has %d genera", len(genera)))
+	}
+	return s.familyRepo.Delete(ctx, familyID)
+}
+
+// validateFamily checks a family's fields and that no other family has its name
+func (s *CatalogService) validateFamily(ctx context.Context, family *entity.PlantFamily) error {
+	family.FamilyName = strings.TrimSpace(family.FamilyName)
+	if err := family.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	existing, err := s.familyRepo.FindByName(ctx, family.FamilyName)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if existing.FamilyID != family.FamilyID {
+		return entity.NewConflictError("plant family", "", fmt.Sprintf("family %s already exists", family.FamilyName))
+	}
+	return nil
+}
+
+// Genera
+
+// ListGenera retrieves the genera in a family, those matching a name query, or all genera
+func (s *CatalogService) ListGenera(ctx context.Context, familyID, query string, limit int) ([]*entity.PlantGenus, error) {
+	if familyID != "" {
+		return s.genusRepo.FindByFamily(ctx, familyID)
+	}
+	if query = strings.TrimSpace(query); query != "" {
+		return s.genusRepo.Search(ctx, query, limit)
+	}
+	return s.genusRepo.FindAll(ctx)
+}
+
+// GetGenus retrieves a plant genus by ID
+func (s *CatalogService) GetGenus(ctx context.Context, genusID string) (*entity.PlantGenus, error) {
+	return s.genusRepo.FindByID(ctx, genusID)
+}
+
+// CreateGenus adds a genus to an existing family
+func (s *CatalogService) CreateGenus(ctx context.Context, genus *entity.PlantGenus) error {
+	genus.GenusID = uuid.New().String()
+	if err := s.validateGenus(ctx, genus); err != nil {
+		return err
+	}
+	return s.genusRepo.Create(ctx, genus)
+}
+
+// UpdateGenus renames a genus or moves it to another family
+func (s *CatalogService) UpdateGenus(ctx context.Context, genusID string, genus *entity.PlantGenus) error {
+	existing, err := s.genusRepo.FindByID(ctx, genusID)
+	if err != nil {
+		return err
+	}
+
+	genus.GenusID = genusID
+	genus.CreatedAt = existing.CreatedAt
+	if err := s.validateGenus(ctx, genus); err != nil {
+		return err
+	}
+	return s.genusRepo.Update(ctx, genus)
+}
+
+// DeleteGenus removes a genus that has no species
+func (s *CatalogService) DeleteGenus(ctx context.Context, genusID string) error {
+	species, err := s.speciesRepo.FindByGenus(ctx, genusID)
+	if err != nil {
+		return err
+	}
+	if len(species) > 0 {
+		return entity.NewConflictError("plant genus", genusID, fmt.Sprintf("still has %d species", len(species)))
+	}
+	return s.genusRepo.Delete(ctx, genusID)
+}
+
+// validateGenus checks a genus's fields, its family, and that the name is unique in the family
+func (s *CatalogService) validateGenus(ctx context.Context, genus *entity.PlantGenus) error {
+	genus.GenusName = strings.TrimSpace(genus.GenusName)
+	if err := genus.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if _, err := s.familyRepo.FindByID(ctx, genus.FamilyID); err != nil {
+		if isNotFound(err) {
+			return entity.NewValidationError("family_id", "unknown plant family")
+		}
+		return err
+	}
+
+	siblings, err := s.genusRepo.FindByFamily(ctx, genus.FamilyID)
+	if err != nil {
+		return err
+	}
+	for _, sibling := range siblings {
+		if sibling.GenusID != genus.GenusID && strings.EqualFold(sibling.GenusName, genus.GenusName) {
+			return entity.NewConflictError("plant genus", "", fmt.Sprintf("genus %s already exists in this family", genus.GenusName))
+		}
+	}
+	return nil
+}
+
+// Species
+
+// ListSpecies retrieves the species in a genus, those matching a name query, or all species
+func (s *CatalogService) ListSpecies(ctx context.Context, genusID, query string, limit int) ([]*entity.PlantSpecies, error) {
+	if genusID != "" {
+		return s.speciesRepo.FindByGenus(ctx, genusID)
+	}
+	if query = strings.TrimSpace(query); query != "" {
+		return s.speciesRepo.Search(ctx, query, limit)
+	}
+	return s.speciesRepo.FindAll(ctx)
+}
+
+// GetSpecies retrieves a plant species by ID
+func (s *CatalogService) GetSpecies(ctx context.Context, speciesID string) (*entity.PlantSpecies, error) {
+	return s.speciesRepo.FindByID(ctx, speciesID)
+}
+
+// CreateSpecies adds a species to an existing genus
+func (s *CatalogService) CreateSpecies(ctx context.Context, species *entity.PlantSpecies) error {
+	species.SpeciesID = uuid.New().String()
+	if err := s.validateSpecies(ctx, species); err != nil {
+		return err
+	}
+	return s.speciesRepo.Create(ctx, species)
+}
+
+// UpdateSpecies renames a species, changes its plant type or moves it to another genus
+func (s *CatalogService) UpdateSpecies(ctx context.Context, speciesID string, species *entity.PlantSpecies) error {
+	existing, err := s.speciesRepo.FindByID(ctx, speciesID)
+	if err != nil {
+		return err
+	}
+
+	species.SpeciesID = speciesID
+	species.CreatedAt = existing.CreatedAt
+	if err := s.validateSpecies(ctx, species); err != nil {
+		return err
+	}
+	return s.speciesRepo.Update(ctx, species)
+}
+
+// DeleteSpecies removes a species that has no cultivars. Plants of the species also block
+// the delete, which the database enforces.
+func (s *CatalogService) DeleteSpecies(ctx context.Context, speciesID string) error {
+	cultivars, err := s.cultivarRepo.FindBySpecies(ctx, speciesID)
+	if err != nil {
+		return err
+	}
+	if len(cultivars) > 0 {
+		return entity.NewConflictError("plant species", speciesID, fmt.Sprintf("still has %d cultivars", len(cultivars)))
+	}
+	return s.speciesRepo.Delete(ctx, speciesID)
+}
+
+// validateSpecies checks a species's fields, its genus, and that the name is unique in the genus
+func (s *CatalogService) validateSpecies(ctx context.Context, species *entity.PlantSpecies) error {
+	species.SpeciesName = strings.TrimSpace(species.SpeciesName)
+	if err := species.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if _, err := s.genusRepo.FindByID(ctx, species.GenusID); err != nil {
+		if isNotFound(err) {
+			return entity.NewValidationError("genus_id", "unknown plant genus")
+		}
+		return err
+	}
+
+	siblings, err := s.speciesRepo.FindByGenus(ctx, species.GenusID)
+	if err != nil {
+		return err
+	}
+	for _, sibling := range siblings {
+		if sibling.SpeciesID != species.SpeciesID && strings.EqualFold(sibling.SpeciesName, species.SpeciesName) {
+			return entity.NewConflictError("plant species", "", fmt.Sprintf("species %s already exists in this genus", species.SpeciesName))
+		}
+	}
+	return nil
+}
+
+// Cultivars
+
+// ListCultivars retrieves the cultivars of a species or those matching a name query
+func (s *CatalogService) ListCultivars(ctx context.Context, speciesID, query string, limit int) ([]*entity.Cultivar, error) {
+	if speciesID != "" {
+		return s.cultivarRepo.FindBySpecies(ctx, speciesID)
+	}
+	if query = strings.TrimSpace(query); query != "" {
+		return s.cultivarRepo.Search(ctx, query, limit)
+	}
+	return nil, entity.NewValidationError("species_id", "species_id or q is required")
+}
+
+// GetCultivar retrieves a cultivar by ID
+func (s *CatalogService) GetCultivar(ctx context.Context, cultivarID string) (*entity.Cultivar, error) {
+	return s.cultivarRepo.FindByID(ctx, cultivarID)
+}
+
+// CreateCultivar adds a cultivar to an existing species
+func (s *CatalogService) CreateCultivar(ctx context.Context, cultivar *entity.Cultivar) error {
+	cultivar.CultivarID = uuid.New().String()
+	if err := s.validateCultivar(ctx, cultivar); err != nil {
+		return err
+	}
+	return s.cultivarRepo.Create(ctx, cultivar)
+}
+
+// UpdateCultivar replaces a cultivar's details
+func (s *CatalogService) UpdateCultivar(ctx context.Context, cultivarID string, cultivar *entity.Cultivar) error {
+	existing, err := s.cultivarRepo.FindByID(ctx, cultivarID)
+	if err != nil {
+		return err
+	}
+
+	cultivar.CultivarID = cultivarID
+	cultivar.CreatedAt = existing.CreatedAt
+	if err := s.validateCultivar(ctx, cultivar); err != nil {
+		return err
+	}
+	return s.cultivarRepo.Update(ctx, cultivar)
+}
+
+// DeleteCultivar removes a cultivar. Plants of the cultivar block the delete, which the
+// database enforces.
+func (s *CatalogService) DeleteCultivar(ctx context.Context, cultivarID string) error {
+	return s.cultivarRepo.Delete(ctx, cultivarID)
+}
+
+// validateCultivar checks a cultivar's fields, its species, and that the name is unique in the species
+func (s *CatalogService) validateCultivar(ctx context.Context, cultivar *entity.Cultivar) error {
+	cultivar.CultivarName = strings.TrimSpace(cultivar.CultivarName)
+	if err := cultivar.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if cultivar.PatentExpiry != nil && cultivar.PatentNumber == nil {
+		return entity.NewValidationError("patent_expiry", "patent_expiry requires patent_number")
+	}
+
+	if _, err := s.speciesRepo.FindByID(ctx, cultivar.SpeciesID); err != nil {
+		if isNotFound(err) {
+			return entity.NewValidationError("species_id", "unknown plant species")
+		}
+		return err
+	}
+
+	siblings, err := s.cultivarRepo.FindBySpecies(ctx, cultivar.SpeciesID)
+	if err != nil {
+		return err
+	}
+	for _, sibling := range siblings {
+		if sibling.CultivarID != cultivar.CultivarID && strings.EqualFold(sibling.CultivarName, cultivar.CultivarName) {
+			return entity.NewConflictError("cultivar", "", fmt.Sprintf("cultivar %s already exists in this species", cultivar.CultivarName))
+		}
+	}
+	return nil
+}
+
+// Synonyms
+
+// ListSynonyms retrieves the synonyms of a plant or those with an old name
+func (s *CatalogService) ListSynonyms(ctx context.Context, plantID, oldName string) ([]*entity.PlantSynonym, error) {
+	if plantID != "" {
+		return s.synonymRepo.FindByCurrentPlant(ctx, plantID)
+	}
+	if oldName = strings.TrimSpace(oldName); oldName != "" {
+		return s.synonymRepo.FindByOldName(ctx, oldName)
+	}
+	return nil, entity.NewValidationError("plant_id", "plant_id or old_name is required")
+}
+
+// GetSynonym retrieves a plant synonym by ID
+func (s *CatalogService) GetSynonym(ctx context.Context, synonymID string) (*entity.PlantSynonym, error) {
+	return s.synonymRepo.FindByID(ctx, synonymID)
+}
+
+// CreateSynonym records an old name for an existing plant
+func (s *CatalogService) CreateSynonym(ctx context.Context, synonym *entity.PlantSynonym) error {
+	synonym.SynonymID = uuid.New().String()
+	if err := s.validateSynonym(ctx, synonym); err != nil {
+		return err
+	}
+	return s.synonymRepo.Create(ctx, synonym)
+}
+
+// UpdateSynonym replaces a synonym's details
+func (s *CatalogService) UpdateSynonym(ctx context.Context, synonymID string, synonym *entity.PlantSynonym) error {
+	existing, err := s.synonymRepo.FindByID(ctx, synonymID)
+	if err != nil {
+		return err
+	}
+
+	synonym.SynonymID = synonymID
+	synonym.CreatedAt = existing.CreatedAt
+	if err := s.validateSynonym(ctx, synonym); err != nil {
+		return err
+	}
+	return s.synonymRepo.Update(ctx, synonym)
+}
+
+// DeleteSynonym removes a plant synonym
+func (s *CatalogService) DeleteSynonym(ctx context.Context, synonymID string) error {
+	return s.synonymRepo.Delete(ctx, synonymID)
+}
+
+// validateSynonym checks a synonym's fields, its plant, and that the old name is neither the
+// plant's current name nor already recorded for it
+func (s *CatalogService) validateSynonym(ctx context.Context, synonym *entity.PlantSynonym) error {
+	synonym.OldName = strings.TrimSpace(synonym.OldName)
+	if err := synonym.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	plant, err := s.plantRepo.FindByID(ctx, synonym.CurrentPlantID, defaultLanguageID, nil)
+	if err != nil {
+		if isNotFound(err) {
+			return entity.NewValidationError("current_plant_id", "unknown plant")
+		}
+		return err
+	}
+	if strings.EqualFold(plant.FullBotanicalName, synonym.OldName) {
+		return entity.NewValidationError("old_name", "old_name is the plant's current name")
+	}
+
+	existing, err := s.synonymRepo.FindByCurrentPlant(ctx, synonym.CurrentPlantID)
+	if err != nil {
+		return err
+	}
+	for _, other := range existing {
+		if other.SynonymID != synonym.SynonymID && strings.EqualFold(other.OldName, synonym.OldName) {
+			return entity.NewConflictError("plant synonym", "", fmt.Sprintf("%s is already a synonym of this plant", synonym.OldName))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/shared/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubCatalogDataSourceRepository records created data sources; other methods are not used
+type stubCatalogDataSourceRepository struct {
+	repository.DataSourceRepository
+	created []*entity.DataSource
+}
+
+func (s *stubCatalogDataSourceRepository) Create(ctx context.Context, source *entity.DataSource) error {
+	s.created = append(s.created, source)
+	return nil
+}
+
+// stubFamilyRepository serves plant families from memory
+type stubFamilyRepository struct {
+	repository.PlantFamilyRepository
+	families map[string]*entity.PlantFamily
+	deleted  []string
+	err      error
+}
+
+func (s *stubFamilyRepository) FindByID(ctx context.Context, familyID string) (*entity.PlantFamily, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if family, ok := s.families[familyID]; ok {
+		return family, nil
+	}
+	return nil, entity.NewNotFoundError("plant family", familyID)
+}
+
+func (s *stubFamilyRepository) FindByName(ctx context.Context, familyName string) (*entity.PlantFamily, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for _, family := range s.families {
+		if family.FamilyName == familyName {
+			return family, nil
+		}
+	}
+	return nil, entity.NewNotFoundError("plant family", familyName)
+}
+
+func (s *stubFamilyRepository) Create(ctx context.Context, family *entity.PlantFamily) error {
+	s.families[family.FamilyID] = family
+	return nil
+}
+
+func (s *stubFamilyRepository) Delete(ctx context.Context, familyID string) error {
+	s.deleted = append(s.deleted, familyID)
+	return nil
+}
+
+// stubGenusRepository serves plant genera from memory
+type stubGenusRepository struct {
+	repository.PlantGenusRepository
+	genera map[string]*entity.PlantGenus
+}
+
+func (s *stubGenusRepository) FindByID(ctx context.Context, genusID string) (*entity.PlantGenus, error) {
+	if genus, ok := s.genera[genusID]; ok {
+		return genus, nil
+	}
+	return nil, entity.NewNotFoundError("plant genus", genusID)
+}
+
+func (s *stubGenusRepository) FindByFamily(ctx context.Context, familyID string) ([]*entity.PlantGenus, error) {
+	var result []*entity.PlantGenus
+	for _, genus := range s.genera {
+		if genus.FamilyID == familyID {
+			result = append(result, genus)
+		}
+	}
+	return result, nil
+}
+
+func (s *stubGenusRepository) Create(ctx context.Context, genus *entity.PlantGenus) error {
+	s.genera[genus.GenusID] = genus
+	return nil
+}
+
+func (s *stubGenusRepository) Update(ctx context.Context, genus *entity.PlantGenus) error {
+	s.genera[genus.GenusID] = genus
+	return nil
+}
+
+// stubSpeciesRepository serves plant species from memory
+type stubSpeciesRepository struct {
+	repository.PlantSpeciesRepository
+	species map[string]*entity.PlantSpecies
+}
+
+func (s *stubSpeciesRepository) FindByID(ctx context.Context, speciesID string) (*entity.PlantSpecies, error) {
+	if species, ok := s.species[speciesID]; ok {
+		return species, nil
+	}
+	return nil, entity.NewNotFoundError("plant species", speciesID)
+}
+
+func (s *stubSpeciesRepository) FindByGenus(ctx context.Context, genusID string) ([]*entity.PlantSpecies, error) {
+	var result []*entity.PlantSpecies
+	for _, species := range s.species {
+		if species.GenusID == genusID {
+			result = append(result, species)
+		}
+	}
+	return result, nil
+}
+
+// stubCultivarRepository serves cultivars from memory
+type stubCultivarRepository struct {
+	repository.CultivarRepository
+	cultivars map[string]*entity.Cultivar
+}
+
+func (s *stubCultivarRepository) FindBySpecies(ctx context.Context, speciesID string) ([]*entity.Cultivar, error) {
+	var result []*entity.Cultivar
+	for _, cultivar := range s.cultivars {
+		if cultivar.SpeciesID == speciesID {
+			result = append(result, cultivar)
+		}
+	}
+	return result, nil
+}
+
+func (s *stubCultivarRepository) Create(ctx context.Context, cultivar *entity.Cultivar) error {
+	s.cultivars[cultivar.CultivarID] = cultivar
+	return nil
+}
+
+// stubSynonymRepository serves plant synonyms from memory
+type stubSynonymRepository struct {
+	repository.PlantSynonymRepository
+	synonyms []*entity.PlantSynonym
+}
+
+func (s *stubSynonymRepository) FindByCurrentPlant(ctx context.Context, currentPlantID string) ([]*entity.PlantSynonym, error) {
+	var result []*entity.PlantSynonym
+	for _, synonym := range s.synonyms {
+		if synonym.CurrentPlantID == currentPlantID {
+			result = append(result, synonym)
+		}
+	}
+	return result, nil
+}
+
+func (s *stubSynonymRepository) Create(ctx context.Context, synonym *entity.PlantSynonym) error {
+	s.synonyms = append(s.synonyms, synonym)
+	return nil
+}
+
+// setupCatalogService builds Rosaceae > Rosa > rugosa with one cultivar, and an empty
+// Solanaceae family
+func setupCatalogService() (*CatalogService, *stubFamilyRepository, *stubCatalogDataSourceRepository) {
+	sources := &stubCatalogDataSourceRepository{}
+	families := &stubFamilyRepository{families: map[string]*entity.PlantFamily{
+		"family-rosaceae":   {FamilyID: "family-rosaceae", FamilyName: "Rosaceae"},
+		"family-solanaceae": {FamilyID: "family-solanaceae", FamilyName: "Solanaceae"},
+	}}
+	genera := &stubGenusRepository{genera: map[string]*entity.PlantGenus{
+		"genus-rosa": {GenusID: "genus-rosa", FamilyID: "family-rosaceae", GenusName: "Rosa"},
+	}}
+	species := &stubSpeciesRepository{species: map[string]*entity.PlantSpecies{
+		"species-rugosa": {SpeciesID: "species-rugosa", GenusID: "genus-rosa", SpeciesName: "rugosa", PlantType: types.PlantTypeShrub},
+	}}
+	cultivars := &stubCultivarRepository{cultivars: map[string]*entity.Cultivar{
+		"cultivar-alba": {CultivarID: "cultivar-alba", SpeciesID: "species-rugosa", CultivarName: "Alba"},
+	}}
+	synonyms := &stubSynonymRepository{}
+
+	plants := mocks.NewMockPlantRepository()
+	plants.On("FindByID", mock.Anything, "plant-rugosa", mock.Anything, (*string)(nil)).
+		Return(&entity.Plant{PlantID: "plant-rugosa", FullBotanicalName: "Rosa rugosa"}, nil)
+	plants.On("FindByID", mock.Anything, mock.Anything, mock.Anything, (*string)(nil)).
+		Return(nil, entity.NewNotFoundError("plant", "unknown"))
+
+	service := NewCatalogService(sources, families, genera, species, cultivars, synonyms, plants)
+	return service, families, sources
+}
+
+func isConflict(err error) bool {
+	var conflict *entity.ConflictError
+	return errors.As(err, &conflict)
+}
+
+func TestCatalogService_CreateDataSource(t *testing.T) {
+	service, _, sources := setupCatalogService()
+	ctx := context.Background()
+
+	source := &entity.DataSource{SourceName: " Kew Gardens ", SourceType: "botanical_garden"}
+	require.NoError(t, service.CreateDataSource(ctx, source))
+	require.Len(t, sources.created, 1)
+	assert.NotEmpty(t, source.SourceID)
+	assert.Equal(t, "Kew Gardens", source.SourceName)
+	assert.Equal(t, entity.DefaultSourceReliability, source.ReliabilityScore)
+
+	err := service.CreateDataSource(ctx, &entity.DataSource{SourceName: "Blog", SourceType: "website", ReliabilityScore: 9})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "validation failed")
+}
+
+func TestCatalogService_Families(t *testing.T) {
+	service, families, _ := setupCatalogService()
+	ctx := context.Background()
+
+	err := service.CreateFamily(ctx, &entity.PlantFamily{FamilyName: "Rosaceae"})
+	assert.True(t, isConflict(err))
+
+	family := &entity.PlantFamily{FamilyName: "Lamiaceae"}
+	require.NoError(t, service.CreateFamily(ctx, family))
+	assert.Contains(t, families.families, family.FamilyID)
+
+	// Families with genera are kept
+	err = service.DeleteFamily(ctx, "family-rosaceae")
+	assert.True(t, isConflict(err))
+	assert.Contains(t, err.Error(), "still has 1 genera")
+
+	require.NoError(t, service.DeleteFamily(ctx, "family-solanaceae"))
+	assert.Equal(t, []string{"family-solanaceae"}, families.deleted)
+
+	// Failed lookups are returned as they are, not reported as invalid input
+	families.err = errors.New("connection refused")
+	err = service.CreateFamily(ctx, &entity.PlantFamily{FamilyName: "Apiaceae"})
+	require.Error(t, err)
+	assert.Equal(t, "connection refused", err.Error())
+
+	err = service.CreateGenus(ctx, &entity.PlantGenus{FamilyID: "family-rosaceae", GenusName: "Prunus"})
+	require.Error(t, err)
+	assert.Equal(t, "connection refused", err.Error())
+}
+
+func TestCatalogService_Genera(t *testing.T) {
+	service, _, _ := setupCatalogService()
+	ctx := context.Background()
+
+	err := service.CreateGenus(ctx, &entity.PlantGenus{FamilyID: "family-missing", GenusName: "Ghost"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown plant family")
+
+	err = service.CreateGenus(ctx, &entity.PlantGenus{FamilyID: "family-rosaceae", GenusName: "rosa"})
+	assert.True(t, isConflict(err))
+
+	// The same name may be used in another family
+	require.NoError(t, service.CreateGenus(ctx, &entity.PlantGenus{FamilyID: "family-solanaceae", GenusName: "Rosa"}))
+
+	// Renaming keeps the original creation time
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	genus, _ := service.GetGenus(ctx, "genus-rosa")
+	genus.CreatedAt = created
+	updated := &entity.PlantGenus{FamilyID: "family-rosaceae", GenusName: "Rosa"}
+	require.NoError(t, service.UpdateGenus(ctx, "genus-rosa", updated))
+	assert.Equal(t, created, updated.CreatedAt)
+
+	err = service.DeleteGenus(ctx, "genus-rosa")
+	assert.True(t, isConflict(err))
+}
+
+func TestCatalogService_Cultivars(t *testing.T) {
+	service, _, _ := setupCatalogService()
+	ctx := context.Background()
+	expiry := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	err := service.CreateCultivar(ctx, &entity.Cultivar{SpeciesID: "species-rugosa", CultivarName: "Hansa", PatentExpiry: &expiry})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "patent_expiry requires patent_number")
+
+	err = service.CreateCultivar(ctx, &entity.Cultivar{SpeciesID: "species-rugosa", CultivarName: "ALBA"})
+	assert.True(t, isConflict(err))
+
+	require.NoError(t, service.CreateCultivar(ctx, &entity.Cultivar{SpeciesID: "species-rugosa", CultivarName: "Hansa"}))
+
+	// Species with cultivars are kept
+	err = service.DeleteSpecies(ctx, "species-rugosa")
+	assert.True(t, isConflict(err))
+	assert.Contains(t, err.Error(), "still has 2 cultivars")
+
+	_, err = service.ListCultivars(ctx, "", "", 50)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "species_id or q is required")
+}
+
+func TestCatalogService_Synonyms(t *testing.T) {
+	service, _, _ := setupCatalogService()
+	ctx := context.Background()
+
+	require.NoError(t, service.CreateSynonym(ctx, &entity.PlantSynonym{CurrentPlantID: "plant-rugosa", OldName: "Rosa regeliana"}))
+
+	tests := []struct {
+		name    string
+		synonym *entity.PlantSynonym
+		wantErr string
+	}{
+		{"unknown plant", &entity.PlantSynonym{CurrentPlantID: "plant-missing", OldName: "Rosa x"}, "unknown plant"},
+		{"current name", &entity.PlantSynonym{CurrentPlantID: "plant-rugosa", OldName: "rosa rugosa"}, "current name"},
+		{"duplicate", &entity.PlantSynonym{CurrentPlantID: "plant-rugosa", OldName: "Rosa Regeliana"}, "already a synonym"},
+		{"missing name", &entity.PlantSynonym{CurrentPlantID: "plant-rugosa", OldName: "  "}, "old_name is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.CreateSynonym(ctx, tt.synonym)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
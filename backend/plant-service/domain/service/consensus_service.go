@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+)
+
+// ConsensusService merges the growing conditions asserted by different data sources
+type ConsensusService struct {
+	repo       repository.PlantRepository
+	sourceRepo repository.DataSourceRepository
+}
+
+// NewConsensusService creates a new consensus service
+func NewConsensusService(repo repository.PlantRepository, sourceRepo repository.DataSourceRepository) *ConsensusService {
+	return &ConsensusService{
+		repo:       repo,
+		sourceRepo: sourceRepo,
+	}
+}
+
+// GetConsensus merges every source's growing conditions for a plant in a country, weighted
+// by source reliability and confidence, and flags where the sources disagree
+func (s *ConsensusService) GetConsensus(ctx context.Context, plantID, countryID string) (*entity.GrowingConditionsConsensus, error) {
+	consensus, _, err := s.merge(ctx, plantID, countryID)
+	if err != nil {
+		return nil, err
+	}
+	return consensus, nil
+}
+
+// GetProvenance lists each source's growing conditions for a plant in a country with its
+// weight and the fields where it disagrees with the consensus
+func (s *ConsensusService) GetProvenance(ctx context.Context, plantID, countryID string) (*entity.GrowingConditionsProvenance, error) {
+	_, assertions, err := s.merge(ctx, plantID, countryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.GrowingConditionsProvenance{
+		PlantID:    plantID,
+		CountryID:  countryID,
+		Assertions: assertions,
+	}, nil
+}
+
+// merge loads and weighs the assertions for a plant in a country and merges them
+func (s *ConsensusService) merge(ctx context.Context, plantID, countryID string) (*entity.GrowingConditionsConsensus, []*entity.ConditionsAssertion, error) {
+	if plantID == "" {
+		return nil, nil, entity.ErrInvalidPlantID
+	}
+	countryID = strings.TrimSpace(countryID)
+	if countryID == "" {
+		return nil, nil, entity.NewValidationError("country_id", "country_id is required")
+	}
+
+	if _, err := s.repo.FindByID(ctx, plantID, defaultLanguageID, nil); err != nil {
+		return nil, nil, fmt.Errorf("failed to get plant: %w", err)
+	}
+
+	conditions, err := s.repo.GetGrowingConditionsAssertions(ctx, plantID, countryID, defaultLanguageID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get growing conditions: %w", err)
+	}
+
+	// Sources that no longer exist are weighed at the default reliability
+	sources := make(map[string]*entity.DataSource)
+	assertions := make([]*entity.ConditionsAssertion, 0, len(conditions))
+	for _, gc := range conditions {
+		var source *entity.DataSource
+		if gc.SourceID != nil {
+			var ok bool
+			if source, ok = sources[*gc.SourceID]; !ok {
+				loaded, err := s.sourceRepo.FindByID(ctx, *gc.SourceID)
+				if err != nil && !isNotFound(err) {
+					return nil, nil, fmt.Errorf("failed to get data source %s: %w", *gc.SourceID, err)
+				}
+				source = loaded
+				sources[*gc.SourceID] = source
+			}
+		}
+		assertions = append(assertions, entity.NewConditionsAssertion(source, gc))
+	}
+
+	return entity.MergeGrowingConditions(plantID, countryID, assertions), assertions, nil
+}
+
+// isNotFound reports whether a repository lookup found nothing
+func isNotFound(err error) bool {
+	var notFound *entity.NotFoundError
+	return errors.As(err, &notFound) || errors.Is(err, entity.ErrPlantNotFound)
+}
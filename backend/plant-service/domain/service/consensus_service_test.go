@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/shared/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDataSourceRepository serves data sources from memory; other methods are not used
+type stubDataSourceRepository struct {
+	repository.DataSourceRepository
+	sources map[string]*entity.DataSource
+	err     error
+}
+
+func (s *stubDataSourceRepository) FindByID(ctx context.Context, sourceID string) (*entity.DataSource, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if source, ok := s.sources[sourceID]; ok {
+		return source, nil
+	}
+	return nil, entity.NewNotFoundError("data source", sourceID)
+}
+
+func sourcedConditions(sourceID string, confidence types.ConfidenceLevel) *types.GrowingConditions {
+	return &types.GrowingConditions{
+		PlantID:    "plant-123",
+		SourceID:   &sourceID,
+		Confidence: confidence,
+	}
+}
+
+// setupConsensusService serves three assertions for plant-123 in country-123: a very reliable
+// botanical garden, a fairly reliable university and an unreliable nursery
+func setupConsensusService() (*ConsensusService, *mocks.MockPlantRepository) {
+	moderate := types.WaterModerate
+	wet := types.WaterWet
+
+	garden := sourcedConditions("source-garden", types.ConfidenceVeryHigh)
+	garden.HardinessZones = []string{"5a", "5b", "6a", "6b", "7a"}
+	garden.SunRequirements = []types.SunRequirement{types.SunFullSun}
+	garden.WaterNeeds = &moderate
+	garden.PHPreference = &types.PHRange{MinPH: mocks.FloatPtr(6.0), MaxPH: mocks.FloatPtr(7.0), OptimalPH: mocks.FloatPtr(6.5)}
+
+	university := sourcedConditions("source-university", types.ConfidenceProbable)
+	university.HardinessZones = []string{"6a", "6b", "7a", "7b"}
+	university.SunRequirements = []types.SunRequirement{types.SunFullSun, types.SunPartialSun}
+	university.WaterNeeds = &moderate
+	university.DroughtTolerant = true
+	university.PHPreference = &types.PHRange{MinPH: mocks.FloatPtr(6.5), MaxPH: mocks.FloatPtr(7.5)}
+
+	nursery := sourcedConditions("source-nursery", types.ConfidenceModerate)
+	nursery.HardinessZones = []string{"8a"}
+	nursery.SunRequirements = []types.SunRequirement{types.SunFullSun}
+	nursery.WaterNeeds = &wet
+
+	mockRepo := mocks.NewMockPlantRepository()
+	mockRepo.On("FindByID", mock.Anything, "plant-123", mock.Anything, (*string)(nil)).Return(&entity.Plant{PlantID: "plant-123"}, nil)
+	mockRepo.On("FindByID", mock.Anything, "plant-missing", mock.Anything, (*string)(nil)).Return(nil, entity.NewNotFoundError("plant", "plant-missing"))
+	mockRepo.On("GetGrowingConditionsAssertions", mock.Anything, "plant-123", "country-123", mock.Anything).
+		Return([]*types.GrowingConditions{nursery, university, garden}, nil)
+
+	sources := &stubDataSourceRepository{sources: map[string]*entity.DataSource{
+		"source-garden":     {SourceID: "source-garden", SourceName: "Royal Gardens", ReliabilityScore: 5},
+		"source-university": {SourceID: "source-university", SourceName: "State University", ReliabilityScore: 4},
+		"source-nursery":    {SourceID: "source-nursery", SourceName: "Corner Nursery", ReliabilityScore: 2},
+	}}
+
+	return NewConsensusService(mockRepo, sources), mockRepo
+}
+
+func conflictsByField(consensus *entity.GrowingConditionsConsensus) map[string]entity.ConditionsConflict {
+	conflicts := make(map[string]entity.ConditionsConflict)
+	for _, c := range consensus.Conflicts {
+		conflicts[c.Field] = c
+	}
+	return conflicts
+}
+
+func TestConsensusService_GetConsensus(t *testing.T) {
+	service, _ := setupConsensusService()
+
+	consensus, err := service.GetConsensus(context.Background(), "plant-123", "country-123")
+	require.NoError(t, err)
+	require.NotNil(t, consensus.Conditions)
+	gc := consensus.Conditions
+
+	assert.Equal(t, 3, consensus.SourceCount)
+
+	// Zones need half the weight: the university's 7b and the nursery's 8a fall short
+	assert.Equal(t, []string{"5a", "5b", "6a", "6b", "7a"}, gc.HardinessZones)
+	assert.Equal(t, []types.SunRequirement{types.SunFullSun}, gc.SunRequirements)
+	assert.Equal(t, types.WaterModerate, *gc.WaterNeeds)
+	assert.False(t, gc.DroughtTolerant)
+
+	// pH ranges intersect
+	require.NotNil(t, gc.PHPreference)
+	assert.Equal(t, 6.5, *gc.PHPreference.MinPH)
+	assert.Equal(t, 7.0, *gc.PHPreference.MaxPH)
+	assert.Equal(t, 6.5, *gc.PHPreference.OptimalPH)
+
+	// Reliability-weighted confidence of 90, 70 and 50 is probable
+	assert.Equal(t, types.ConfidenceProbable, gc.Confidence)
+
+	conflicts := conflictsByField(consensus)
+	assert.Len(t, conflicts, 4)
+	for _, field := range []string{entity.FieldHardinessZones, entity.FieldSunRequirements, entity.FieldWaterNeeds, entity.FieldDroughtTolerant} {
+		assert.Contains(t, conflicts, field)
+	}
+	water := conflicts[entity.FieldWaterNeeds]
+	require.Len(t, water.Values, 2)
+	assert.Equal(t, types.WaterModerate, water.Values[0].Value)
+	assert.Equal(t, []string{"source-garden", "source-university"}, water.Values[0].SourceIDs)
+	assert.Equal(t, 0.88, water.Values[0].Support)
+
+	// Hardiness, sun, water, drought, pH and three undisputed tolerances are stated
+	assert.Equal(t, 50, consensus.Agreement)
+}
+
+func TestConsensusService_GetProvenance(t *testing.T) {
+	service, _ := setupConsensusService()
+
+	provenance, err := service.GetProvenance(context.Background(), "plant-123", "country-123")
+	require.NoError(t, err)
+	require.Len(t, provenance.Assertions, 3)
+
+	garden, university, nursery := provenance.Assertions[0], provenance.Assertions[1], provenance.Assertions[2]
+	assert.Equal(t, "Royal Gardens", garden.Source.SourceName)
+	assert.InDelta(t, 0.9, garden.Weight, 1e-9)
+	assert.Empty(t, garden.Dissents)
+
+	assert.Equal(t, "State University", university.Source.SourceName)
+	assert.InDelta(t, 0.56, university.Weight, 1e-9)
+	assert.Equal(t, []string{entity.FieldHardinessZones, entity.FieldSunRequirements, entity.FieldDroughtTolerant}, university.Dissents)
+
+	assert.Equal(t, "Corner Nursery", nursery.Source.SourceName)
+	assert.Equal(t, []string{entity.FieldHardinessZones, entity.FieldWaterNeeds}, nursery.Dissents)
+}
+
+func TestConsensusService_Errors(t *testing.T) {
+	service, mockRepo := setupConsensusService()
+	ctx := context.Background()
+
+	_, err := service.GetConsensus(ctx, "", "country-123")
+	assert.ErrorIs(t, err, entity.ErrInvalidPlantID)
+
+	_, err = service.GetConsensus(ctx, "plant-123", " ")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "country_id")
+
+	_, err = service.GetConsensus(ctx, "plant-missing", "country-123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+
+	// A plant no source covers has no merged conditions
+	mockRepo.On("GetGrowingConditionsAssertions", mock.Anything, "plant-123", "country-456", mock.Anything).
+		Return([]*types.GrowingConditions{}, nil)
+	consensus, err := service.GetConsensus(ctx, "plant-123", "country-456")
+	require.NoError(t, err)
+	assert.Nil(t, consensus.Conditions)
+	assert.Equal(t, 0, consensus.SourceCount)
+
+	// Sources that cannot be loaded fail the merge rather than being weighed at the default
+	service.sourceRepo.(*stubDataSourceRepository).err = errors.New("connection refused")
+	_, err = service.GetConsensus(ctx, "plant-123", "country-123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get data source")
+}
+
+func TestMergeGrowingConditions_EqualSourcesUnionZones(t *testing.T) {
+	north := sourcedConditions("source-north", types.ConfidenceProbable)
+	north.HardinessZones = []string{"9b", "10a"}
+	north.PHPreference = &types.PHRange{MinPH: mocks.FloatPtr(5.0), MaxPH: mocks.FloatPtr(5.5)}
+
+	south := sourcedConditions("source-south", types.ConfidenceProbable)
+	south.HardinessZones = []string{"8a", "8b"}
+	south.PHPreference = &types.PHRange{MinPH: mocks.FloatPtr(7.0), MaxPH: mocks.FloatPtr(8.0)}
+
+	// An unknown source counts at the default reliability
+	consensus := entity.MergeGrowingConditions("plant-123", "country-123", []*entity.ConditionsAssertion{
+		entity.NewConditionsAssertion(&entity.DataSource{SourceID: "source-north", ReliabilityScore: 3}, north),
+		entity.NewConditionsAssertion(nil, south),
+	})
+
+	assert.Equal(t, []string{"8a", "8b", "9b", "10a"}, consensus.Conditions.HardinessZones)
+
+	// Disjoint pH ranges keep the first of the equally heavy sources and conflict
+	assert.Equal(t, 5.0, *consensus.Conditions.PHPreference.MinPH)
+	conflicts := conflictsByField(consensus)
+	assert.Contains(t, conflicts, entity.FieldHardinessZones)
+	assert.Contains(t, conflicts, entity.FieldPHPreference)
+	assert.Equal(t, "source-south", conflicts[entity.FieldPHPreference].Values[1].SourceIDs[0])
+}
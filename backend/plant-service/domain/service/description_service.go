@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+)
+
+// DescriptionService serves localized plant descriptions and care guides, and lets
+// administrators author them per language and country
+type DescriptionService struct {
+	descriptionRepo repository.PlantDescriptionRepository
+	plantRepo       repository.PlantRepository
+	locales         localeResolver
+}
+
+// NewDescriptionService creates a new plant description service
+func NewDescriptionService(
+	descriptionRepo repository.PlantDescriptionRepository,
+	plantRepo repository.PlantRepository,
+	languageRepo repository.LanguageRepository,
+	countryRepo repository.CountryRepository,
+) *DescriptionService {
+	return &DescriptionService{
+		descriptionRepo: descriptionRepo,
+		plantRepo:       plantRepo,
+		locales:         localeResolver{languageRepo: languageRepo, countryRepo: countryRepo},
+	}
+}
+
+// GetGuide returns a plant's description and care guide in the requested locale.
+// Sections missing in the requested language are served in English.
+func (s *DescriptionService) GetGuide(ctx context.Context, plantID string, locale Locale) (*entity.PlantGuide, error) {
+	if err := s.requirePlant(ctx, plantID); err != nil {
+		return nil, err
+	}
+
+	languageID, countryID := s.locales.resolve(ctx, locale)
+
+	descriptions, err := s.descriptionRepo.FindForLocale(ctx, plantID, languageID, countryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plant descriptions: %w", err)
+	}
+
+	return entity.BuildPlantGuide(plantID, descriptions, languageID, countryID, defaultLanguageID), nil
+}
+
+// ListDescriptions lists every translation of a plant's description
+func (s *DescriptionService) ListDescriptions(ctx context.Context, plantID string) ([]*entity.PlantDescription, error) {
+	if err := s.requirePlant(ctx, plantID); err != nil {
+		return nil, err
+	}
+
+	descriptions, err := s.descriptionRepo.FindByPlant(ctx, plantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plant descriptions: %w", err)
+	}
+	if descriptions == nil {
+		descriptions = []*entity.PlantDescription{}
+	}
+
+	return descriptions, nil
+}
+
+// SaveDescription creates or replaces a plant's description in one language, optionally
+// specific to one country. Unknown language or country codes are rejected rather than
+// falling back, so translations are never stored under the wrong locale.
+func (s *DescriptionService) SaveDescription(ctx context.Context, plantID, languageCode string, countryCode *string, description *entity.PlantDescription) (*entity.PlantDescription, error) {
+	if description == nil {
+		return nil, entity.NewValidationError("description", "description is required")
+	}
+	if err := s.requirePlant(ctx, plantID); err != nil {
+		return nil, err
+	}
+
+	languageID, countryID, err := s.locales.lookup(ctx, languageCode, countryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	description.PlantID = plantID
+	description.LanguageID = languageID
+	description.CountryID = countryID
+	if err := description.Validate(); err != nil {
+		return nil, entity.NewValidationError("description", err.Error())
+	}
+
+	if err := s.descriptionRepo.Save(ctx, description); err != nil {
+		return nil, err
+	}
+
+	return s.descriptionRepo.Find(ctx, plantID, languageID, countryID)
+}
+
+// DeleteDescription deletes a plant's description in one language and country
+func (s *DescriptionService) DeleteDescription(ctx context.Context, plantID, languageCode string, countryCode *string) error {
+	if plantID == "" {
+		return entity.ErrInvalidPlantID
+	}
+
+	languageID, countryID, err := s.locales.lookup(ctx, languageCode, countryCode)
+	if err != nil {
+		return err
+	}
+
+	return s.descriptionRepo.Delete(ctx, plantID, languageID, countryID)
+}
+
+// requirePlant checks that a plant exists
+func (s *DescriptionService) requirePlant(ctx context.Context, plantID string) error {
+	if plantID == "" {
+		return entity.ErrInvalidPlantID
+	}
+
+	if _, err := s.plantRepo.FindByID(ctx, plantID, defaultLanguageID, nil); err != nil {
+		return fmt.Errorf("failed to get plant: %w", err)
+	}
+
+	return nil
+}
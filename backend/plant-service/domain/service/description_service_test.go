@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDescriptionRepository holds descriptions in memory and filters them like the database
+type stubDescriptionRepository struct {
+	repository.PlantDescriptionRepository
+	descriptions []*entity.PlantDescription
+	saved        *entity.PlantDescription
+}
+
+func (s *stubDescriptionRepository) FindForLocale(ctx context.Context, plantID, languageID string, countryID *string) ([]*entity.PlantDescription, error) {
+	var result []*entity.PlantDescription
+	for _, d := range s.descriptions {
+		if d.PlantID != plantID || (d.LanguageID != languageID && d.LanguageID != defaultLanguageID) {
+			continue
+		}
+		if d.CountryID != nil && (countryID == nil || *d.CountryID != *countryID) {
+			continue
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+func (s *stubDescriptionRepository) Save(ctx context.Context, description *entity.PlantDescription) error {
+	s.saved = description
+	return nil
+}
+
+func (s *stubDescriptionRepository) Find(ctx context.Context, plantID, languageID string, countryID *string) (*entity.PlantDescription, error) {
+	if s.saved == nil {
+		return nil, entity.NewNotFoundError("plant_description", plantID)
+	}
+	return s.saved, nil
+}
+
+func setupDescriptionService(descriptions ...*entity.PlantDescription) (*DescriptionService, *stubDescriptionRepository) {
+	repo := &stubDescriptionRepository{descriptions: descriptions}
+	languages := &stubLanguageRepository{languages: map[string]*entity.Language{
+		"en": {LanguageID: defaultLanguageID, LanguageCode: "en", IsActive: true},
+		"es": {LanguageID: "lang-es", LanguageCode: "es", IsActive: true},
+	}}
+	countries := &stubCountryRepository{countries: map[string]*entity.Country{
+		"MX": {CountryID: "country-mx", CountryCode: "MX"},
+	}}
+
+	plants := mocks.NewMockPlantRepository()
+	plants.On("FindByID", mock.Anything, "plant-tomato", mock.Anything, (*string)(nil)).
+		Return(&entity.Plant{PlantID: "plant-tomato", FullBotanicalName: "Solanum lycopersicum"}, nil)
+	plants.On("FindByID", mock.Anything, mock.Anything, mock.Anything, (*string)(nil)).
+		Return(nil, entity.ErrPlantNotFound)
+
+	return NewDescriptionService(repo, plants, languages, countries), repo
+}
+
+func TestDescriptionService_GetGuide(t *testing.T) {
+	ctx := context.Background()
+	mx := "MX"
+
+	english := &entity.PlantDescription{
+		PlantID: "plant-tomato", LanguageID: defaultLanguageID,
+		Summary: mocks.StrPtr("A warm-season fruiting vegetable."), Care: mocks.StrPtr("Water deeply and evenly."),
+		Uses: mocks.StrPtr("Eaten fresh or cooked."),
+	}
+	spanish := &entity.PlantDescription{
+		PlantID: "plant-tomato", LanguageID: "lang-es",
+		Summary: mocks.StrPtr("Una hortaliza de fruto de temporada cálida."), Care: mocks.StrPtr("Riegue de forma profunda y regular."),
+	}
+	mexican := &entity.PlantDescription{
+		PlantID: "plant-tomato", LanguageID: "lang-es", CountryID: mocks.StrPtr("country-mx"),
+		Care: mocks.StrPtr("Riegue temprano por la mañana."),
+	}
+
+	t.Run("sections fall back to English independently", func(t *testing.T) {
+		service, _ := setupDescriptionService(english, spanish)
+
+		guide, err := service.GetGuide(ctx, "plant-tomato", Locale{LanguageCode: "es"})
+		require.NoError(t, err)
+
+		require.NotNil(t, guide.Summary)
+		assert.Equal(t, *spanish.Summary, guide.Summary.Content)
+		assert.False(t, guide.Summary.IsFallback)
+
+		require.NotNil(t, guide.Uses)
+		assert.Equal(t, *english.Uses, guide.Uses.Content)
+		assert.True(t, guide.Uses.IsFallback)
+
+		assert.Nil(t, guide.Planting)
+	})
+
+	t.Run("country-specific text wins", func(t *testing.T) {
+		service, _ := setupDescriptionService(english, spanish, mexican)
+
+		guide, err := service.GetGuide(ctx, "plant-tomato", Locale{LanguageCode: "es", CountryCode: &mx})
+		require.NoError(t, err)
+
+		require.NotNil(t, guide.Care)
+		assert.Equal(t, *mexican.Care, guide.Care.Content)
+		assert.Equal(t, mexican.CountryID, guide.Care.CountryID)
+		assert.Equal(t, *spanish.Summary, guide.Summary.Content)
+	})
+
+	t.Run("unknown language is served in English", func(t *testing.T) {
+		service, _ := setupDescriptionService(english, spanish)
+
+		guide, err := service.GetGuide(ctx, "plant-tomato", Locale{LanguageCode: "xx"})
+		require.NoError(t, err)
+
+		assert.Equal(t, defaultLanguageID, guide.LanguageID)
+		require.NotNil(t, guide.Care)
+		assert.Equal(t, *english.Care, guide.Care.Content)
+		assert.False(t, guide.Care.IsFallback)
+	})
+
+	t.Run("missing plant", func(t *testing.T) {
+		service, _ := setupDescriptionService()
+
+		_, err := service.GetGuide(ctx, "plant-missing", Locale{})
+		assert.Error(t, err)
+	})
+}
+
+func TestDescriptionService_SaveDescription(t *testing.T) {
+	ctx := context.Background()
+	mx, unknown := "mx", "ZZ"
+
+	t.Run("resolves the exact locale", func(t *testing.T) {
+		service, repo := setupDescriptionService()
+
+		saved, err := service.SaveDescription(ctx, "plant-tomato", "ES", &mx, &entity.PlantDescription{
+			Care: mocks.StrPtr("Riegue temprano por la mañana."),
+		})
+		require.NoError(t, err)
+
+		assert.Same(t, repo.saved, saved)
+		assert.Equal(t, "plant-tomato", saved.PlantID)
+		assert.Equal(t, "lang-es", saved.LanguageID)
+		assert.Equal(t, mocks.StrPtr("country-mx"), saved.CountryID)
+	})
+
+	t.Run("unknown language is rejected", func(t *testing.T) {
+		service, repo := setupDescriptionService()
+
+		_, err := service.SaveDescription(ctx, "plant-tomato", "xx", nil, &entity.PlantDescription{Care: mocks.StrPtr("Water.")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "validation")
+		assert.Nil(t, repo.saved)
+	})
+
+	t.Run("unknown country is rejected", func(t *testing.T) {
+		service, _ := setupDescriptionService()
+
+		_, err := service.SaveDescription(ctx, "plant-tomato", "es", &unknown, &entity.PlantDescription{Care: mocks.StrPtr("Riegue.")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "validation")
+	})
+
+	t.Run("empty description is rejected", func(t *testing.T) {
+		service, repo := setupDescriptionService()
+
+		_, err := service.SaveDescription(ctx, "plant-tomato", "es", nil, &entity.PlantDescription{Care: mocks.StrPtr("  ")})
+		require.Error(t, err)
+		assert.Nil(t, repo.saved)
+	})
+}
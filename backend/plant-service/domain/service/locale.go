@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/constants"
+)
+
+// defaultLanguageID is English, used when no language is requested or the requested one
+// isn't available
+const defaultLanguageID = constants.EnglishLanguageID
+
+// Locale identifies the language and optional country content is requested in,
+// as codes from the API layer (e.g. "es" and "MX")
+type Locale struct {
+	LanguageCode string
+	CountryCode  *string
+}
+
+// localeResolver maps locale codes to language and country IDs
+type localeResolver struct {
+	languageRepo repository.LanguageRepository
+	countryRepo  repository.CountryRepository
+}
+
+// resolve returns the language ID for the locale, falling back to English when the language
+// is missing, unknown or inactive, and the country ID when the country is known
+func (l localeResolver) resolve(ctx context.Context, locale Locale) (string, *string) {
+	languageID := defaultLanguageID
+	if code := strings.ToLower(strings.TrimSpace(locale.LanguageCode)); code != "" {
+		if language, err := l.languageRepo.FindByCode(ctx, code); err == nil && language.IsActive {
+			languageID = language.LanguageID
+		}
+	}
+
+	var countryID *string
+	if locale.CountryCode != nil {
+		if code := strings.ToUpper(strings.TrimSpace(*locale.CountryCode)); code != "" {
+			if country, err := l.countryRepo.FindByCode(ctx, code); err == nil {
+				countryID = &country.CountryID
+			}
+		}
+	}
+
+	return languageID, countryID
+}
+
+// lookup returns the language ID and optional country ID for exact codes, without
+// falling back, for callers that write content in a specific locale
+func (l localeResolver) lookup(ctx context.Context, languageCode string, countryCode *string) (string, *string, error) {
+	code := strings.ToLower(strings.TrimSpace(languageCode))
+	if code == "" {
+		return "", nil, entity.NewValidationError("language", "language code is required")
+	}
+	language, err := l.languageRepo.FindByCode(ctx, code)
+	if err != nil {
+		return "", nil, entity.NewValidationError("language", fmt.Sprintf("unknown language %q", code))
+	}
+
+	var countryID *string
+	if countryCode != nil {
+		if code := strings.ToUpper(strings.TrimSpace(*countryCode)); code != "" {
+			country, err := l.countryRepo.FindByCode(ctx, code)
+			if err != nil {
+				return "", nil, entity.NewValidationError("country", fmt.Sprintf("unknown country %q", code))
+			}
+			countryID = &country.CountryID
+		}
+	}
+
+	return language.LanguageID, countryID, nil
+}
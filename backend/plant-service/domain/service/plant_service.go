@@ -99,7 +99,8 @@ func (s *PlantService) SearchPlants(ctx context.Context, query string, filter *r
 	return result, nil
 }
 
-// FindByBotanicalName finds a plant by exact botanical name
+// FindByBotanicalName finds a plant by exact botanical name. Deprecated names resolve to the
+// current plant, which then carries the matched synonym.
 func (s *PlantService) FindByBotanicalName(ctx context.Context, botanicalName string) (*entity.Plant, error) {
 	botanicalName = strings.TrimSpace(botanicalName)
 	if botanicalName == "" {
@@ -243,8 +244,9 @@ func (s *PlantService) CreatePlant(ctx context.Context, plant *entity.Plant) err
 
 	// Check if plant with same botanical name already exists
 	// TODO: Extract language from context (Part 6)
+	// A synonym match isn't a duplicate: reclassified names may be reused by another plant
 	existing, err := s.repo.FindByBotanicalName(ctx, plant.FullBotanicalName, "8a86d436-e58f-4e2c-aac1-2e3c5a7b10cf", nil)
-	if err == nil && existing != nil {
+	if err == nil && existing != nil && existing.MatchedSynonym == nil {
 		return entity.ErrPlantAlreadyExists
 	}
 
@@ -289,7 +291,7 @@ func (s *PlantService) UpdatePlant(ctx context.Context, plantID string, updates
 	if updates.FullBotanicalName != existing.FullBotanicalName {
 		// TODO: Extract language from context (Part 6)
 		conflict, err := s.repo.FindByBotanicalName(ctx, updates.FullBotanicalName, "8a86d436-e58f-4e2c-aac1-2e3c5a7b10cf", nil)
-		if err == nil && conflict != nil && conflict.PlantID != plantID {
+		if err == nil && conflict != nil && conflict.MatchedSynonym == nil && conflict.PlantID != plantID {
 			return entity.ErrPlantAlreadyExists
 		}
 	}
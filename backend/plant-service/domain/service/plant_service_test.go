@@ -365,6 +365,62 @@ func TestCreatePlant(t *testing.T) {
 	})
 }
 
+func TestCreatePlant_SynonymMatch(t *testing.T) {
+	mockRepo := mocks.NewMockPlantRepository()
+	service := NewPlantService(mockRepo)
+	ctx := context.Background()
+
+	// The old name now resolves to its current plant, but may still be used by a new plant
+	oldName := "Aster novae-angliae"
+	currentPlant := &entity.Plant{
+		PlantID:           "plant-symphyotrichum",
+		FullBotanicalName: "Symphyotrichum novae-angliae",
+		MatchedSynonym:    &oldName,
+	}
+
+	newPlant := &entity.Plant{
+		PlantID:           "plant-aster",
+		SpeciesID:         "species-aster",
+		FamilyName:        "Asteraceae",
+		GenusName:         "Aster",
+		SpeciesName:       "novae-angliae",
+		PlantType:         types.PlantTypePerennial,
+		FullBotanicalName: oldName,
+	}
+
+	mockRepo.On("FindByBotanicalName", ctx, oldName, mock.Anything, (*string)(nil)).Return(currentPlant, nil).Once()
+	mockRepo.On("Create", ctx, newPlant).Return(nil).Once()
+
+	err := service.CreatePlant(ctx, newPlant)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSearchPlants_SynonymMatch(t *testing.T) {
+	mockRepo := mocks.NewMockPlantRepository()
+	service := NewPlantService(mockRepo)
+	ctx := context.Background()
+
+	oldName := "Aster novae-angliae"
+	result := &repository.SearchResult{
+		Plants: []*entity.Plant{
+			{PlantID: "plant-alpinus", FullBotanicalName: "Aster alpinus", GenusName: "Aster", SpeciesName: "alpinus"},
+			{PlantID: "plant-symphyotrichum", FullBotanicalName: "Symphyotrichum novae-angliae", GenusName: "Symphyotrichum", SpeciesName: "novae-angliae", MatchedSynonym: &oldName},
+		},
+		Total: 2,
+		Limit: 20,
+	}
+	mockRepo.On("Search", ctx, oldName, mock.Anything, mock.Anything, (*string)(nil)).Return(result, nil).Once()
+
+	found, err := service.SearchPlants(ctx, oldName, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "plant-symphyotrichum", found.Plants[0].PlantID)
+	assert.Equal(t, oldName, *found.Plants[0].MatchedSynonym)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestGetPlantWithConditions(t *testing.T) {
 	mockRepo := mocks.NewMockPlantRepository()
 	service := NewPlantService(mockRepo)
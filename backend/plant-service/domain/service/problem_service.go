@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+)
+
+// ProblemService exposes the localized pest, disease and deficiency catalogue
+type ProblemService struct {
+	problemRepo repository.PlantProblemRepository
+	plantRepo   repository.PlantRepository
+	locales     localeResolver
+}
+
+// NewProblemService creates a new plant problem service
+func NewProblemService(
+	problemRepo repository.PlantProblemRepository,
+	plantRepo repository.PlantRepository,
+	languageRepo repository.LanguageRepository,
+	countryRepo repository.CountryRepository,
+) *ProblemService {
+	return &ProblemService{
+		problemRepo: problemRepo,
+		plantRepo:   plantRepo,
+		locales:     localeResolver{languageRepo: languageRepo, countryRepo: countryRepo},
+	}
+}
+
+// ListPlantProblems lists the problems known to affect a plant, most severe first,
+// optionally limited to one problem type
+func (s *ProblemService) ListPlantProblems(ctx context.Context, plantID, problemType string, locale Locale, limit, offset int) ([]*entity.PlantProblem, error) {
+	if plantID == "" {
+		return nil, entity.ErrInvalidPlantID
+	}
+	if problemType != "" && !entity.IsValidProblemType(problemType) {
+		return nil, entity.NewValidationError("type", "must be pest, disease, deficiency, or toxicity")
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	if _, err := s.plantRepo.FindByID(ctx, plantID, defaultLanguageID, nil); err != nil {
+		return nil, fmt.Errorf("failed to get plant: %w", err)
+	}
+
+	languageID, countryID := s.locales.resolve(ctx, locale)
+
+	var problems []*entity.PlantProblem
+	var err error
+	if problemType != "" {
+		problems, err = s.problemRepo.FindByType(ctx, plantID, problemType, languageID, countryID, limit, offset)
+	} else {
+		problems, err = s.problemRepo.FindByPlant(ctx, plantID, languageID, countryID, limit, offset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plant problems: %w", err)
+	}
+
+	if problems == nil {
+		problems = []*entity.PlantProblem{}
+	}
+	return problems, nil
+}
+
+// GetProblem retrieves a single problem with localized content
+func (s *ProblemService) GetProblem(ctx context.Context, problemID string, locale Locale) (*entity.PlantProblem, error) {
+	if problemID == "" {
+		return nil, entity.NewValidationError("id", "problem id is required")
+	}
+
+	languageID, countryID := s.locales.resolve(ctx, locale)
+	return s.problemRepo.FindLocalized(ctx, problemID, languageID, countryID)
+}
+
+// SearchProblems searches the problem catalogue across all plants by name, symptom or
+// affected plant, with optional type, severity, plant part and month filters
+func (s *ProblemService) SearchProblems(ctx context.Context, filter *repository.ProblemSearchFilter, locale Locale) (*repository.ProblemSearchResult, error) {
+	if filter == nil {
+		filter = &repository.ProblemSearchFilter{}
+	}
+
+	filter.Query = strings.TrimSpace(filter.Query)
+	if len(filter.Query) > 200 {
+		return nil, entity.ErrInvalidSearchQuery
+	}
+	if filter.ProblemType != nil && !entity.IsValidProblemType(*filter.ProblemType) {
+		return nil, entity.NewValidationError("type", "must be pest, disease, deficiency, or toxicity")
+	}
+	if filter.Severity != nil && !entity.IsValidProblemSeverity(*filter.Severity) {
+		return nil, entity.NewValidationError("severity", "must be low, medium, or high")
+	}
+	if filter.AffectedPart != nil && !entity.IsValidPlantPart(*filter.AffectedPart) {
+		return nil, entity.NewValidationError("part", "unknown plant part")
+	}
+	if filter.Month != nil && (*filter.Month < 1 || *filter.Month > 12) {
+		return nil, entity.NewValidationError("month", "must be between 1 and 12")
+	}
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		filter.Limit = 20
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	languageID, countryID := s.locales.resolve(ctx, locale)
+
+	result, err := s.problemRepo.Search(ctx, filter, languageID, countryID)
+	if err != nil {
+		return nil, fmt.Errorf("problem search failed: %w", err)
+	}
+	if result.Problems == nil {
+		result.Problems = []*entity.PlantProblem{}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubProblemRepository records the language and filters it was queried with
+type stubProblemRepository struct {
+	repository.PlantProblemRepository
+	problems []*entity.PlantProblem
+
+	languageID  string
+	countryID   *string
+	problemType string
+	filter      *repository.ProblemSearchFilter
+}
+
+func (s *stubProblemRepository) FindByPlant(ctx context.Context, plantID, languageID string, countryID *string, limit, offset int) ([]*entity.PlantProblem, error) {
+	s.languageID, s.countryID = languageID, countryID
+	return s.problems, nil
+}
+
+func (s *stubProblemRepository) FindByType(ctx context.Context, plantID, problemType, languageID string, countryID *string, limit, offset int) ([]*entity.PlantProblem, error) {
+	s.languageID, s.countryID, s.problemType = languageID, countryID, problemType
+	return s.problems, nil
+}
+
+func (s *stubProblemRepository) Search(ctx context.Context, filter *repository.ProblemSearchFilter, languageID string, countryID *string) (*repository.ProblemSearchResult, error) {
+	s.languageID, s.countryID, s.filter = languageID, countryID, filter
+	return &repository.ProblemSearchResult{Problems: s.problems, Total: int64(len(s.problems)), Limit: filter.Limit}, nil
+}
+
+// stubLanguageRepository serves languages by code
+type stubLanguageRepository struct {
+	repository.LanguageRepository
+	languages map[string]*entity.Language
+}
+
+func (s *stubLanguageRepository) FindByCode(ctx context.Context, languageCode string) (*entity.Language, error) {
+	if language, ok := s.languages[languageCode]; ok {
+		return language, nil
+	}
+	return nil, entity.NewNotFoundError("language", languageCode)
+}
+
+// stubCountryRepository serves countries by code
+type stubCountryRepository struct {
+	repository.CountryRepository
+	countries map[string]*entity.Country
+}
+
+func (s *stubCountryRepository) FindByCode(ctx context.Context, countryCode string) (*entity.Country, error) {
+	if country, ok := s.countries[countryCode]; ok {
+		return country, nil
+	}
+	return nil, entity.NewNotFoundError("country", countryCode)
+}
+
+func setupProblemService() (*ProblemService, *stubProblemRepository) {
+	problems := &stubProblemRepository{problems: []*entity.PlantProblem{
+		{ProblemID: "problem-hornworm", PlantID: "plant-tomato", ProblemType: entity.ProblemTypePest, Severity: entity.ProblemSeverityHigh, ProblemName: "Tomato hornworm"},
+	}}
+	languages := &stubLanguageRepository{languages: map[string]*entity.Language{
+		"es": {LanguageID: "lang-es", LanguageCode: "es", IsActive: true},
+		"de": {LanguageID: "lang-de", LanguageCode: "de", IsActive: false},
+	}}
+	countries := &stubCountryRepository{countries: map[string]*entity.Country{
+		"MX": {CountryID: "country-mx", CountryCode: "MX"},
+	}}
+
+	plants := mocks.NewMockPlantRepository()
+	plants.On("FindByID", mock.Anything, "plant-tomato", mock.Anything, (*string)(nil)).
+		Return(&entity.Plant{PlantID: "plant-tomato", FullBotanicalName: "Solanum lycopersicum"}, nil)
+	plants.On("FindByID", mock.Anything, mock.Anything, mock.Anything, (*string)(nil)).
+		Return(nil, entity.ErrPlantNotFound)
+
+	return NewProblemService(problems, plants, languages, countries), problems
+}
+
+func TestProblemService_LocaleResolution(t *testing.T) {
+	ctx := context.Background()
+	mx, unknownCountry := "mx", "ZZ"
+
+	tests := []struct {
+		name          string
+		locale        Locale
+		wantLanguage  string
+		wantCountryID *string
+	}{
+		{"requested language and country", Locale{LanguageCode: "ES", CountryCode: &mx}, "lang-es", mocks.StrPtr("country-mx")},
+		{"no language falls back to English", Locale{}, defaultLanguageID, nil},
+		{"unknown language falls back to English", Locale{LanguageCode: "xx"}, defaultLanguageID, nil},
+		{"inactive language falls back to English", Locale{LanguageCode: "de"}, defaultLanguageID, nil},
+		{"unknown country is ignored", Locale{LanguageCode: "es", CountryCode: &unknownCountry}, "lang-es", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, problems := setupProblemService()
+
+			_, err := service.ListPlantProblems(ctx, "plant-tomato", "", tt.locale, 0, 0)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLanguage, problems.languageID)
+			assert.Equal(t, tt.wantCountryID, problems.countryID)
+		})
+	}
+}
+
+func TestProblemService_ListPlantProblems(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("filters by type", func(t *testing.T) {
+		service, problems := setupProblemService()
+
+		result, err := service.ListPlantProblems(ctx, "plant-tomato", entity.ProblemTypePest, Locale{}, 20, 0)
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, entity.ProblemTypePest, problems.problemType)
+	})
+
+	t.Run("empty list instead of nil", func(t *testing.T) {
+		service, problems := setupProblemService()
+		problems.problems = nil
+
+		result, err := service.ListPlantProblems(ctx, "plant-tomato", "", Locale{}, 20, 0)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		service, _ := setupProblemService()
+
+		_, err := service.ListPlantProblems(ctx, "plant-tomato", "weeds", Locale{}, 20, 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "validation")
+	})
+
+	t.Run("unknown plant", func(t *testing.T) {
+		service, _ := setupProblemService()
+
+		_, err := service.ListPlantProblems(ctx, "plant-missing", "", Locale{}, 20, 0)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, entity.ErrPlantNotFound)
+	})
+}
+
+func TestProblemService_SearchProblems(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("normalizes query and limit", func(t *testing.T) {
+		service, problems := setupProblemService()
+
+		result, err := service.SearchProblems(ctx, &repository.ProblemSearchFilter{Query: "  tomato  ", Limit: 500}, Locale{})
+		require.NoError(t, err)
+		assert.Len(t, result.Problems, 1)
+		assert.Equal(t, "tomato", problems.filter.Query)
+		assert.Equal(t, 20, problems.filter.Limit)
+	})
+
+	month, badMonth := 7, 13
+	part, badPart := entity.PlantPartLeaves, "tentacles"
+	severity := "catastrophic"
+
+	tests := []struct {
+		name    string
+		filter  *repository.ProblemSearchFilter
+		wantErr string
+	}{
+		{"valid filters", &repository.ProblemSearchFilter{Month: &month, AffectedPart: &part}, ""},
+		{"month out of range", &repository.ProblemSearchFilter{Month: &badMonth}, "month"},
+		{"unknown plant part", &repository.ProblemSearchFilter{AffectedPart: &badPart}, "part"},
+		{"unknown severity", &repository.ProblemSearchFilter{Severity: &severity}, "severity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := setupProblemService()
+
+			_, err := service.SearchProblems(ctx, tt.filter, Locale{})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
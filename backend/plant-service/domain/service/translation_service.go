@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+)
+
+// MaxTranslationImportRows is the most translations accepted in one bulk import
+const MaxTranslationImportRows = 5000
+
+// translationCSVColumns are the columns of a translation CSV upload. domain, key,
+// language and value are required; field and country are optional.
+var translationCSVColumns = []string{"domain", "key", "field", "language", "country", "value"}
+
+// TranslationService manages translations of characteristics, traits, growing conditions,
+// companion benefits and common names for translators
+type TranslationService struct {
+	translationRepo repository.TranslationRepository
+	languageRepo    repository.LanguageRepository
+	locales         localeResolver
+}
+
+// NewTranslationService creates a new translation service
+func NewTranslationService(
+	translationRepo repository.TranslationRepository,
+	languageRepo repository.LanguageRepository,
+	countryRepo repository.CountryRepository,
+) *TranslationService {
+	return &TranslationService{
+		translationRepo: translationRepo,
+		languageRepo:    languageRepo,
+		locales:         localeResolver{languageRepo: languageRepo, countryRepo: countryRepo},
+	}
+}
+
+// ListMissing lists the records of a domain that have no translation in a language
+func (s *TranslationService) ListMissing(ctx context.Context, domain, languageCode string, limit, offset int) (*repository.MissingTranslationResult, error) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if !entity.IsValidTranslationDomain(domain) {
+		return nil, entity.NewValidationError("domain", fmt.Sprintf("must be one of %s", strings.Join(entity.TranslationDomains, ", ")))
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	languageID, _, err := s.locales.lookup(ctx, languageCode, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.translationRepo.FindMissing(ctx, domain, languageID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list missing translations: %w", err)
+	}
+	if result.Translations == nil {
+		result.Translations = []*entity.MissingTranslation{}
+	}
+
+	return result, nil
+}
+
+// Coverage reports translation coverage per domain for one language, or for every
+// active language when no language code is given. Least translated language first.
+func (s *TranslationService) Coverage(ctx context.Context, languageCode string) ([]*entity.LanguageCoverage, error) {
+	var languages []*entity.Language
+	if strings.TrimSpace(languageCode) != "" {
+		languageID, _, err := s.locales.lookup(ctx, languageCode, nil)
+		if err != nil {
+			return nil, err
+		}
+		language, err := s.languageRepo.FindByID(ctx, languageID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get language: %w", err)
+		}
+		languages = []*entity.Language{language}
+	} else {
+		var err error
+		languages, err = s.languageRepo.FindActive(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list languages: %w", err)
+		}
+	}
+
+	coverage := make([]*entity.LanguageCoverage, 0, len(languages))
+	for _, language := range languages {
+		domains, err := s.translationRepo.Coverage(ctx, language.LanguageID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get coverage for %s: %w", language.LanguageCode, err)
+		}
+		coverage = append(coverage, entity.NewLanguageCoverage(language, domains))
+	}
+
+	sort.SliceStable(coverage, func(i, j int) bool {
+		if coverage[i].Percent != coverage[j].Percent {
+			return coverage[i].Percent < coverage[j].Percent
+		}
+		return coverage[i].LanguageCode < coverage[j].LanguageCode
+	})
+
+	return coverage, nil
+}
+
+// Import saves translations in bulk, one row at a time. Rows that fail are reported and
+// skipped, whatever the cause, so the result always accounts for every row: the remaining
+// rows are saved. Failures other than invalid, unknown or conflicting data are reported
+// without their details.
+func (s *TranslationService) Import(ctx context.Context, translations []*entity.Translation) (*entity.TranslationImportResult, error) {
+	if len(translations) == 0 {
+		return nil, entity.NewValidationError("translations", "at least one translation is required")
+	}
+	if len(translations) > MaxTranslationImportRows {
+		return nil, entity.NewValidationError("translations", fmt.Sprintf("at most %d translations per import", MaxTranslationImportRows))
+	}
+
+	result := &entity.TranslationImportResult{Errors: []*entity.TranslationImportError{}}
+	reject := func(row int, err error) {
+		result.Failed++
+		result.Errors = append(result.Errors, &entity.TranslationImportError{Row: row, Message: err.Error()})
+	}
+
+	// Most imports cover a handful of locales, so resolve each code once
+	type locale struct {
+		languageID string
+		countryID  *string
+		err        error
+	}
+	locales := map[string]locale{}
+
+	for i, t := range translations {
+		row := i + 1
+		if t == nil {
+			reject(row, errors.New("translation is required"))
+			continue
+		}
+
+		t.Normalize()
+		if err := t.Validate(); err != nil {
+			reject(row, err)
+			continue
+		}
+
+		cacheKey := t.Language
+		if t.Country != nil {
+			cacheKey += "-" + *t.Country
+		}
+		resolved, ok := locales[cacheKey]
+		if !ok {
+			resolved.languageID, resolved.countryID, resolved.err = s.locales.lookup(ctx, t.Language, t.Country)
+			locales[cacheKey] = resolved
+		}
+		if resolved.err != nil {
+			reject(row, resolved.err)
+			continue
+		}
+		t.LanguageID, t.CountryID = resolved.languageID, resolved.countryID
+
+		if err := s.translationRepo.Save(ctx, t); err != nil {
+			var notFound *entity.NotFoundError
+			var validation *entity.ValidationError
+			var conflict *entity.ConflictError
+			if !errors.As(err, &notFound) && !errors.As(err, &validation) && !errors.As(err, &conflict) {
+				err = errors.New("failed to save translation")
+			}
+			reject(row, err)
+			continue
+		}
+		result.Imported++
+	}
+
+	return result, nil
+}
+
+// ParseTranslationCSV reads translations from CSV with a header row naming the columns
+// domain, key, field, language, country and value, in any order
+func ParseTranslationCSV(r io.Reader) ([]*entity.Translation, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, entity.NewValidationError("csv", "file is empty")
+	}
+	if err != nil {
+		return nil, entity.NewValidationError("csv", err.Error())
+	}
+
+	// Spreadsheet exports often start with a byte order mark
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[name] = i
+	}
+	for _, required := range []string{"domain", "key", "language", "value"} {
+		if _, ok := columns[required]; !ok {
+			return nil, entity.NewValidationError("csv", fmt.Sprintf("missing %s column; expected %s", required, strings.Join(translationCSVColumns, ", ")))
+		}
+	}
+
+	cell := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	var translations []*entity.Translation
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, entity.NewValidationError("csv", err.Error())
+		}
+		if len(translations) == MaxTranslationImportRows {
+			return nil, entity.NewValidationError("csv", fmt.Sprintf("at most %d translations per import", MaxTranslationImportRows))
+		}
+
+		t := &entity.Translation{
+			Domain:   cell(record, "domain"),
+			Key:      cell(record, "key"),
+			Field:    cell(record, "field"),
+			Language: cell(record, "language"),
+			Value:    cell(record, "value"),
+		}
+		if country := cell(record, "country"); strings.TrimSpace(country) != "" {
+			t.Country = &country
+		}
+		translations = append(translations, t)
+	}
+
+	return translations, nil
+}
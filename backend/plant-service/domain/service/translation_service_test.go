@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTranslationRepository records saved translations and serves fixed coverage
+type stubTranslationRepository struct {
+	repository.TranslationRepository
+	coverage map[string][]*entity.DomainCoverage // by language ID
+	unknown  map[string]bool                     // keys with no matching record
+	taken    map[string]bool                     // values that conflict with existing data
+	broken   map[string]bool                     // values whose save fails in the database
+	saved    []*entity.Translation
+}
+
+func (s *stubTranslationRepository) Coverage(ctx context.Context, languageID string) ([]*entity.DomainCoverage, error) {
+	var domains []*entity.DomainCoverage
+	for _, d := range s.coverage[languageID] {
+		copied := *d
+		domains = append(domains, &copied)
+	}
+	return domains, nil
+}
+
+func (s *stubTranslationRepository) Save(ctx context.Context, translation *entity.Translation) error {
+	if s.unknown[translation.Key] {
+		return entity.NewNotFoundError("plant", translation.Key)
+	}
+	if s.taken[translation.Value] {
+		return entity.NewConflictError("common name", translation.Key, "name already exists")
+	}
+	if s.broken[translation.Value] {
+		return entity.NewDatabaseError("save translation", errors.New("connection reset"))
+	}
+	s.saved = append(s.saved, translation)
+	return nil
+}
+
+// stubActiveLanguageRepository serves languages by code and ID, and lists the active ones
+type stubActiveLanguageRepository struct {
+	stubLanguageRepository
+}
+
+func (s *stubActiveLanguageRepository) FindByID(ctx context.Context, languageID string) (*entity.Language, error) {
+	for _, language := range s.languages {
+		if language.LanguageID == languageID {
+			return language, nil
+		}
+	}
+	return nil, entity.NewNotFoundError("language", languageID)
+}
+
+func (s *stubActiveLanguageRepository) FindActive(ctx context.Context) ([]*entity.Language, error) {
+	var active []*entity.Language
+	for _, code := range []string{"en", "es", "fr"} {
+		if language, ok := s.languages[code]; ok && language.IsActive {
+			active = append(active, language)
+		}
+	}
+	return active, nil
+}
+
+const tomatoPlantID = "7f3e9a52-1c44-4b8e-9f0a-2d6b5c8e1a93"
+
+func setupTranslationService() (*TranslationService, *stubTranslationRepository) {
+	repo := &stubTranslationRepository{
+		coverage: map[string][]*entity.DomainCoverage{
+			defaultLanguageID: {
+				{Domain: entity.TranslationDomainCharacteristic, Total: 10, Translated: 10},
+				{Domain: entity.TranslationDomainCommonNames, Total: 4, Translated: 4},
+			},
+			"lang-es": {
+				{Domain: entity.TranslationDomainCharacteristic, Total: 10, Translated: 5},
+				{Domain: entity.TranslationDomainCommonNames, Total: 4, Translated: 1},
+			},
+			"lang-fr": {
+				{Domain: entity.TranslationDomainCharacteristic, Total: 10, Translated: 0},
+				{Domain: entity.TranslationDomainCommonNames, Total: 0, Translated: 0},
+			},
+		},
+		unknown: map[string]bool{},
+		taken:   map[string]bool{},
+		broken:  map[string]bool{},
+	}
+	languages := &stubActiveLanguageRepository{stubLanguageRepository{languages: map[string]*entity.Language{
+		"en": {LanguageID: defaultLanguageID, LanguageCode: "en", LanguageName: "English", IsActive: true},
+		"es": {LanguageID: "lang-es", LanguageCode: "es", LanguageName: "Spanish", IsActive: true},
+		"fr": {LanguageID: "lang-fr", LanguageCode: "fr", LanguageName: "French", IsActive: true},
+	}}}
+	countries := &stubCountryRepository{countries: map[string]*entity.Country{
+		"MX": {CountryID: "country-mx", CountryCode: "MX"},
+	}}
+
+	return NewTranslationService(repo, languages, countries), repo
+}
+
+func TestTranslationService_Coverage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all active languages, least translated first", func(t *testing.T) {
+		service, _ := setupTranslationService()
+
+		coverage, err := service.Coverage(ctx, "")
+		require.NoError(t, err)
+		require.Len(t, coverage, 3)
+
+		assert.Equal(t, "fr", coverage[0].LanguageCode)
+		assert.Equal(t, 0.0, coverage[0].Percent)
+		assert.Equal(t, 100.0, coverage[0].Domains[1].Percent, "nothing to translate counts as covered")
+
+		assert.Equal(t, "es", coverage[1].LanguageCode)
+		assert.Equal(t, 14, coverage[1].Total)
+		assert.Equal(t, 6, coverage[1].Translated)
+		assert.Equal(t, 42.9, coverage[1].Percent)
+		assert.Equal(t, 50.0, coverage[1].Domains[0].Percent)
+		assert.Equal(t, 25.0, coverage[1].Domains[1].Percent)
+
+		assert.Equal(t, "en", coverage[2].LanguageCode)
+		assert.Equal(t, 100.0, coverage[2].Percent)
+	})
+
+	t.Run("one language", func(t *testing.T) {
+		service, _ := setupTranslationService()
+
+		coverage, err := service.Coverage(ctx, "ES")
+		require.NoError(t, err)
+		require.Len(t, coverage, 1)
+		assert.Equal(t, "Spanish", coverage[0].LanguageName)
+	})
+
+	t.Run("unknown language is rejected", func(t *testing.T) {
+		service, _ := setupTranslationService()
+
+		_, err := service.Coverage(ctx, "xx")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "validation")
+	})
+}
+
+func TestTranslationService_ListMissing_Validation(t *testing.T) {
+	ctx := context.Background()
+	service, _ := setupTranslationService()
+
+	_, err := service.ListMissing(ctx, "descriptions", "es", 0, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "domain")
+
+	_, err = service.ListMissing(ctx, entity.TranslationDomainCommonNames, "", 0, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "language")
+}
+
+func TestTranslationService_Import(t *testing.T) {
+	ctx := context.Background()
+	mx := "mx"
+	unknownPlant := "0b1c2d3e-4f50-4617-8293-a4b5c6d7e8f9"
+
+	service, repo := setupTranslationService()
+	repo.unknown[unknownPlant] = true
+	repo.taken["Tomatera"] = true
+	repo.broken["Pomodoro"] = true
+
+	result, err := service.Import(ctx, []*entity.Translation{
+		{Domain: "characteristic", Key: "sun_requirement:full_sun", Language: "es", Value: "Pleno sol"},
+		{Domain: "common_names", Key: tomatoPlantID, Language: "ES", Country: &mx, Value: "Jitomate"},
+		{Domain: "characteristic", Key: "leaf_shape:palmate", Language: "es", Value: "Palmada"},
+		{Domain: "common_names", Key: tomatoPlantID, Language: "xx", Value: "Tomate"},
+		{Domain: "common_names", Key: unknownPlant, Language: "es", Value: "Tomate"},
+		{Domain: "growing_conditions", Key: tomatoPlantID, Language: "es", Value: "Franco"},
+		{Domain: "common_names", Key: tomatoPlantID, Language: "es", Value: "Tomatera"},
+		{Domain: "common_names", Key: tomatoPlantID, Language: "es", Value: "Pomodoro"},
+		{Domain: "characteristic", Key: "water_needs:low", Language: "es", Value: "Bajo"},
+	})
+	require.NoError(t, err)
+
+	// A failed save is reported like any other bad row and the rest still import
+	assert.Equal(t, 3, result.Imported)
+	assert.Equal(t, 6, result.Failed)
+	require.Len(t, result.Errors, 6)
+	rows := make([]int, len(result.Errors))
+	for i, e := range result.Errors {
+		rows[i] = e.Row
+	}
+	assert.Equal(t, []int{3, 4, 5, 6, 7, 8}, rows)
+	assert.Contains(t, result.Errors[4].Message, "conflict")
+	assert.Equal(t, "failed to save translation", result.Errors[5].Message)
+
+	require.Len(t, repo.saved, 3)
+	assert.Equal(t, entity.TranslationFieldLabel, repo.saved[0].Field)
+	assert.Equal(t, "lang-es", repo.saved[0].LanguageID)
+	assert.Equal(t, entity.TranslationFieldName, repo.saved[1].Field)
+	assert.Equal(t, mocks.StrPtr("country-mx"), repo.saved[1].CountryID)
+}
+
+func TestParseTranslationCSV(t *testing.T) {
+	t.Run("columns in any order", func(t *testing.T) {
+		input := "\ufeffLanguage,Domain,Key,Value,Field\n" +
+			"es,characteristic,water_needs:low,Bajo,\n" +
+			"es,growing_conditions," + tomatoPlantID + ",\"Franco | Arenoso\",soil_types\n"
+
+		translations, err := ParseTranslationCSV(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Len(t, translations, 2)
+
+		assert.Equal(t, "characteristic", translations[0].Domain)
+		assert.Equal(t, "water_needs:low", translations[0].Key)
+		assert.Equal(t, "Bajo", translations[0].Value)
+		assert.Nil(t, translations[0].Country)
+
+		assert.Equal(t, entity.TranslationFieldSoilTypes, translations[1].Field)
+		assert.Equal(t, []string{"Franco", "Arenoso"}, translations[1].ListValues())
+	})
+
+	t.Run("missing required column", func(t *testing.T) {
+		_, err := ParseTranslationCSV(strings.NewReader("domain,key,value\ncharacteristic,water_needs:low,Bajo\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "language")
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		_, err := ParseTranslationCSV(strings.NewReader(""))
+		require.Error(t, err)
+	})
+}
@@ -101,6 +101,30 @@ func (r *CachedPlantRepository) GetGrowingConditions(ctx context.Context, plantI
 	return result, nil
 }
 
+// GetGrowingConditionsAssertions retrieves every source's growing conditions with caching (with language support)
+func (r *CachedPlantRepository) GetGrowingConditionsAssertions(ctx context.Context, plantID, countryID, languageID string) ([]*types.GrowingConditions, error) {
+	key := fmt.Sprintf("%s:assertions:%s", GrowingConditionsKey(plantID, countryID), languageID)
+
+	// Try cache first
+	var assertions []*types.GrowingConditions
+	err := r.cache.GetJSON(ctx, key, &assertions)
+	if err == nil {
+		return assertions, nil
+	}
+
+	// Cache miss - fetch from database
+	result, err := r.repo.GetGrowingConditionsAssertions(ctx, plantID, countryID, languageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) > 0 {
+		_ = r.cache.SetJSON(ctx, key, result, GrowingConditionsTTL)
+	}
+
+	return result, nil
+}
+
 // GetPhysicalCharacteristics retrieves physical characteristics with caching (with language support)
 func (r *CachedPlantRepository) GetPhysicalCharacteristics(ctx context.Context, plantID, languageID string) (*types.PhysicalCharacteristics, error) {
 	// Include language in cache key for language-specific translations
@@ -210,7 +210,7 @@ func (r *PostgresClimateZoneRepository) FindByPoint(ctx context.Context, latitud
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("no climate zone found at location: lat=%f, lng=%f, system=%s", latitude, longitude, zoneSystem)
+		return nil, entity.NewNotFoundError("climate zone", fmt.Sprintf("lat=%f, lng=%f, system=%s", latitude, longitude, zoneSystem))
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find climate zone by point: %w", err)
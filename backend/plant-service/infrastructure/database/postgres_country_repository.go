@@ -290,7 +290,7 @@ func (r *PostgresCountryRepository) FindByPoint(ctx context.Context, latitude, l
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("no country found at location: lat=%f, lng=%f", latitude, longitude)
+		return nil, entity.NewNotFoundError("country", fmt.Sprintf("lat=%f, lng=%f", latitude, longitude))
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find country by point: %w", err)
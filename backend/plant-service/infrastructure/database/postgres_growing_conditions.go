@@ -10,10 +10,8 @@ import (
 	"github.com/lib/pq"
 )
 
-// GetGrowingConditions retrieves growing conditions for a plant in a specific country with localized characteristic values
-func (r *PostgresPlantRepository) GetGrowingConditions(ctx context.Context, plantID, countryID, languageID string) (*types.GrowingConditions, error) {
-	query := `
-		SELECT
+// growingConditionsColumns are the growing_conditions_assertions columns read by scanGrowingConditions
+const growingConditionsColumns = `
 			gca.assertion_id,
 			gca.source_id,
 			gca.confidence,
@@ -31,7 +29,12 @@ func (r *PostgresPlantRepository) GetGrowingConditions(ctx context.Context, plan
 			gca.flowering_months,
 			gca.fruiting_months,
 			gca.ph_preference,
-			gca.created_at
+			gca.created_at`
+
+// GetGrowingConditions retrieves growing conditions for a plant in a specific country with localized characteristic values
+func (r *PostgresPlantRepository) GetGrowingConditions(ctx context.Context, plantID, countryID, languageID string) (*types.GrowingConditions, error) {
+	query := `
+		SELECT` + growingConditionsColumns + `
 		FROM growing_conditions_assertions gca
 		INNER JOIN country_plants cp ON gca.country_plant_id = cp.country_plant_id
 		WHERE cp.plant_id = $1 AND cp.country_id = $2
@@ -39,6 +42,53 @@ func (r *PostgresPlantRepository) GetGrowingConditions(ctx context.Context, plan
 		LIMIT 1
 	`
 
+	gc, err := scanGrowingConditions(r.db.QueryRowContext(ctx, query, plantID, countryID).Scan, plantID, countryID)
+	if err == sql.ErrNoRows {
+		return nil, nil // No growing conditions found for this country
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get growing conditions: %w", err)
+	}
+
+	translateGrowingConditions(ctx, NewCharacteristicTranslator(r.db), gc, languageID)
+
+	return gc, nil
+}
+
+// GetGrowingConditionsAssertions retrieves every source's growing conditions for a plant in a
+// country, most confident first, with localized characteristic values
+func (r *PostgresPlantRepository) GetGrowingConditionsAssertions(ctx context.Context, plantID, countryID, languageID string) ([]*types.GrowingConditions, error) {
+	query := `
+		SELECT` + growingConditionsColumns + `
+		FROM growing_conditions_assertions gca
+		INNER JOIN country_plants cp ON gca.country_plant_id = cp.country_plant_id
+		WHERE cp.plant_id = $1 AND cp.country_id = $2
+		ORDER BY gca.confidence DESC, gca.created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, plantID, countryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get growing conditions assertions: %w", err)
+	}
+	defer rows.Close()
+
+	assertions, err := ScanRows(rows, func(row *sql.Rows) (*types.GrowingConditions, error) {
+		return scanGrowingConditions(row.Scan, plantID, countryID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get growing conditions assertions: %w", err)
+	}
+
+	translator := NewCharacteristicTranslator(r.db)
+	for _, gc := range assertions {
+		translateGrowingConditions(ctx, translator, gc, languageID)
+	}
+
+	return assertions, nil
+}
+
+// scanGrowingConditions scans one row of growingConditionsColumns, passing sql.ErrNoRows through
+func scanGrowingConditions(scan func(dest ...interface{}) error, plantID, countryID string) (*types.GrowingConditions, error) {
 	gc := &types.GrowingConditions{
 		PlantID:   plantID,
 		CountryID: &countryID,
@@ -55,7 +105,7 @@ func (r *PostgresPlantRepository) GetGrowingConditions(ctx context.Context, plan
 	var floweringMonths, fruitingMonths pq.Int64Array
 	var phPreferenceStr sql.NullString // Composite type ph_range scanned as string
 
-	err := r.db.QueryRowContext(ctx, query, plantID, countryID).Scan(
+	err := scan(
 		&assertionID,
 		&sourceID,
 		&confidenceStr,
@@ -75,12 +125,8 @@ func (r *PostgresPlantRepository) GetGrowingConditions(ctx context.Context, plan
 		&phPreferenceStr, // Scan composite type as string
 		&gc.CreatedAt,
 	)
-
-	if err == sql.ErrNoRows {
-		return nil, nil // No growing conditions found for this country
-	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get growing conditions: %w", err)
+		return nil, err
 	}
 
 	// Map confidence
@@ -147,9 +193,11 @@ func (r *PostgresPlantRepository) GetGrowingConditions(ctx context.Context, plan
 		gc.FruitingMonths = append(gc.FruitingMonths, int(m))
 	}
 
-	// Translate characteristic values to user's language
-	translator := NewCharacteristicTranslator(r.db)
+	return gc, nil
+}
 
+// translateGrowingConditions translates characteristic values to the user's language
+func translateGrowingConditions(ctx context.Context, translator *CharacteristicTranslator, gc *types.GrowingConditions, languageID string) {
 	// Translate sun requirements
 	if len(gc.SunRequirements) > 0 {
 		sunReqStrings := make([]string, len(gc.SunRequirements))
@@ -175,8 +223,6 @@ func (r *PostgresPlantRepository) GetGrowingConditions(ctx context.Context, plan
 		sd := types.SoilDrainage(translated)
 		gc.SoilDrainage = &sd
 	}
-
-	return gc, nil
 }
 
 // GetPhysicalCharacteristics retrieves physical characteristics for a plant with localized growth rate
@@ -267,7 +267,8 @@ func (r *PostgresPlantRepository) Delete(ctx context.Context, plantID string) er
 	return nil
 }
 
-// FindByBotanicalName finds a plant by its exact botanical name with localized common names
+// FindByBotanicalName finds a plant by its exact botanical name with localized common names.
+// A deprecated name resolves to the current plant, with MatchedSynonym set to the old name.
 func (r *PostgresPlantRepository) FindByBotanicalName(ctx context.Context, botanicalName, languageID string, countryID *string) (*entity.Plant, error) {
 	query := `
 		SELECT
@@ -280,18 +281,24 @@ func (r *PostgresPlantRepository) FindByBotanicalName(ctx context.Context, botan
 			pg.genus_name,
 			pf.family_name,
 			c.cultivar_name,
-			p.created_at
+			p.created_at,
+			syn.old_name
 		FROM plants p
 		INNER JOIN plant_species ps ON p.species_id = ps.species_id
 		INNER JOIN plant_genera pg ON ps.genus_id = pg.genus_id
 		INNER JOIN plant_families pf ON pg.family_id = pf.family_id
 		LEFT JOIN cultivars c ON p.cultivar_id = c.cultivar_id
+		LEFT JOIN plant_synonyms syn ON syn.current_plant_id = p.plant_id
+			AND LOWER(syn.old_name) = LOWER($1)
+			AND LOWER(p.full_botanical_name) <> LOWER($1)
 		WHERE LOWER(p.full_botanical_name) = LOWER($1)
+			OR syn.synonym_id IS NOT NULL
+		ORDER BY (syn.synonym_id IS NULL) DESC
 		LIMIT 1
 	`
 
 	plant := &entity.Plant{}
-	var cultivarID, cultivarName sql.NullString
+	var cultivarID, cultivarName, matchedSynonym sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, botanicalName).Scan(
 		&plant.PlantID,
@@ -304,6 +311,7 @@ func (r *PostgresPlantRepository) FindByBotanicalName(ctx context.Context, botan
 		&plant.FamilyName,
 		&cultivarName,
 		&plant.CreatedAt,
+		&matchedSynonym,
 	)
 
 	if err == sql.ErrNoRows {
@@ -319,6 +327,9 @@ func (r *PostgresPlantRepository) FindByBotanicalName(ctx context.Context, botan
 	if cultivarName.Valid {
 		plant.CultivarName = &cultivarName.String
 	}
+	if matchedSynonym.Valid {
+		plant.MatchedSynonym = &matchedSynonym.String
+	}
 
 	if err := r.loadCommonNames(ctx, plant, languageID, countryID); err != nil {
 		return nil, fmt.Errorf("failed to load common names: %w", err)
@@ -24,8 +24,8 @@ func (r *PostgresPlantRepository) Search(ctx context.Context, query string, filt
 	args := []interface{}{}
 	argPos := 1
 
-	// Full-text search on botanical name AND common names
-	// Use CTE to search across both botanical and common names
+	// Full-text search on botanical name, common names AND deprecated synonyms
+	// Use CTE to search across botanical names, common names and synonyms
 	searchInCommonNames := false
 	if query != "" {
 		searchInCommonNames = true
@@ -124,9 +124,11 @@ func (r *PostgresPlantRepository) Search(ctx context.Context, query string, filt
 			SELECT DISTINCT p.plant_id
 			FROM plants p
 			LEFT JOIN plant_common_names pcn ON p.plant_id = pcn.plant_id
+			LEFT JOIN plant_synonyms syn ON p.plant_id = syn.current_plant_id
 			WHERE (
 				to_tsvector('english', COALESCE(p.full_botanical_name, '')) @@ plainto_tsquery('english', $1)
 				OR to_tsvector('english', COALESCE(pcn.common_name, '')) @@ plainto_tsquery('english', $1)
+				OR to_tsvector('english', COALESCE(syn.old_name, '')) @@ plainto_tsquery('english', $1)
 			)
 			%s
 		)`,
@@ -182,6 +184,23 @@ func (r *PostgresPlantRepository) Search(ctx context.Context, query string, filt
 	// Always add plant_id to ORDER BY for consistent cursor pagination
 	orderBySQL += ", p.plant_id ASC"
 
+	// Report the deprecated name a plant was found by when its current name doesn't match,
+	// so clients can show "matched synonym X" next to the accepted plant
+	matchedSynonymSQL := "NULL::text"
+	if searchInCommonNames {
+		matchedSynonymSQL = `CASE
+				WHEN to_tsvector('english', COALESCE(p.full_botanical_name, '')) @@ plainto_tsquery('english', $1) THEN NULL
+				ELSE (
+					SELECT syn.old_name
+					FROM plant_synonyms syn
+					WHERE syn.current_plant_id = p.plant_id
+						AND to_tsvector('english', syn.old_name) @@ plainto_tsquery('english', $1)
+					ORDER BY syn.old_name
+					LIMIT 1
+				)
+			END`
+	}
+
 	// Count total results
 	countQuery := fmt.Sprintf(`
 		%s
@@ -213,7 +232,8 @@ func (r *PostgresPlantRepository) Search(ctx context.Context, query string, filt
 			pg.genus_name,
 			pf.family_name,
 			c.cultivar_name,
-			p.created_at
+			p.created_at,
+			%s AS matched_synonym
 		FROM plants p
 		INNER JOIN plant_species ps ON p.species_id = ps.species_id
 		INNER JOIN plant_genera pg ON ps.genus_id = pg.genus_id
@@ -223,7 +243,7 @@ func (r *PostgresPlantRepository) Search(ctx context.Context, query string, filt
 		%s
 		%s
 		LIMIT $%d
-	`, cteSQL, whereSQL, orderBySQL, argPos)
+	`, cteSQL, matchedSynonymSQL, whereSQL, orderBySQL, argPos)
 
 	args = append(args, filter.Limit+1) // Fetch one extra to determine if there are more results
 
@@ -236,7 +256,7 @@ func (r *PostgresPlantRepository) Search(ctx context.Context, query string, filt
 	plants := make([]*entity.Plant, 0, filter.Limit)
 	for rows.Next() {
 		plant := &entity.Plant{}
-		var cultivarID, cultivarName sql.NullString
+		var cultivarID, cultivarName, matchedSynonym sql.NullString
 
 		err := rows.Scan(
 			&plant.PlantID,
@@ -249,6 +269,7 @@ func (r *PostgresPlantRepository) Search(ctx context.Context, query string, filt
 			&plant.FamilyName,
 			&cultivarName,
 			&plant.CreatedAt,
+			&matchedSynonym,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan plant: %w", err)
@@ -260,6 +281,9 @@ func (r *PostgresPlantRepository) Search(ctx context.Context, query string, filt
 		if cultivarName.Valid {
 			plant.CultivarName = &cultivarName.String
 		}
+		if matchedSynonym.Valid {
+			plant.MatchedSynonym = &matchedSynonym.String
+		}
 
 		plants = append(plants, plant)
 	}
@@ -441,6 +465,13 @@ func (r *PostgresPlantRepository) FindByGrowingConditions(ctx context.Context, f
 		argCount++
 	}
 
+	// Country filter
+	if filter.CountryID != nil {
+		query += fmt.Sprintf(" AND cp.country_id = $%d", argCount)
+		args = append(args, *filter.CountryID)
+		argCount++
+	}
+
 	// Sun requirements filter (any match)
 	if len(filter.SunRequirements) > 0 {
 		sunReqs := make([]string, len(filter.SunRequirements))
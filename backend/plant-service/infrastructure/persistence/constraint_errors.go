@@ -0,0 +1,27 @@
+package persistence
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// PostgreSQL error codes for constraint violations
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// isForeignKeyViolation returns true if a statement failed because rows in other tables
+// still reference the affected row
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation
+}
+
+// isUniqueViolation returns true if a statement failed because the row would duplicate
+// an existing one
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lib/pq"
+
 	"twigger-backend/backend/plant-service/domain/entity"
 	"twigger-backend/backend/plant-service/domain/repository"
 	"twigger-backend/backend/plant-service/infrastructure/database"
@@ -128,7 +130,7 @@ func (r *PostgresCountryPlantRepository) FindByCountryAndPlant(ctx context.Conte
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("country-plant relationship not found for country %s and plant %s", countryID, plantID)
+		return nil, entity.NewNotFoundError("country-plant relationship", fmt.Sprintf("country %s and plant %s", countryID, plantID))
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find country-plant relationship: %w", err)
@@ -137,6 +139,27 @@ func (r *PostgresCountryPlantRepository) FindByCountryAndPlant(ctx context.Conte
 	return &cp, nil
 }
 
+func (r *PostgresCountryPlantRepository) FindByCountryAndPlants(ctx context.Context, countryID string, plantIDs []string) ([]*entity.CountryPlant, error) {
+	if len(plantIDs) == 0 {
+		return []*entity.CountryPlant{}, nil
+	}
+
+	query := `
+		SELECT country_plant_id, country_id, plant_id, native_status, legal_status,
+		       ST_AsGeoJSON(native_range_geojson), created_at, updated_at
+		FROM country_plants
+		WHERE country_id = $1 AND plant_id = ANY($2::uuid[])
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, countryID, pq.Array(plantIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query country-plants by plants: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanCountryPlants(rows)
+}
+
 func (r *PostgresCountryPlantRepository) FindByNativeStatus(ctx context.Context, countryID, nativeStatus string) ([]*entity.CountryPlant, error) {
 	query := `
 		SELECT country_plant_id, country_id, plant_id, native_status, legal_status,
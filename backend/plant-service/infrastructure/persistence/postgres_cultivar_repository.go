@@ -226,6 +226,9 @@ func (r *PostgresCultivarRepository) Delete(ctx context.Context, cultivarID stri
 	query := `DELETE FROM cultivars WHERE cultivar_id = $1`
 
 	result, err := r.db.ExecContext(ctx, query, cultivarID)
+	if isForeignKeyViolation(err) {
+		return entity.NewConflictError("cultivar", cultivarID, "still referenced by other records")
+	}
 	if err != nil {
 		return fmt.Errorf("failed to delete cultivar: %w", err)
 	}
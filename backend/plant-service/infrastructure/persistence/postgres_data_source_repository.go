@@ -41,7 +41,7 @@ func (r *PostgresDataSourceRepository) FindByID(ctx context.Context, sourceID st
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("data source not found: %s", sourceID)
+		return nil, entity.NewNotFoundError("data source", sourceID)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find data source: %w", err)
@@ -169,7 +169,7 @@ func (r *PostgresDataSourceRepository) Update(ctx context.Context, source *entit
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("data source not found: %s", source.SourceID)
+		return entity.NewNotFoundError("data source", source.SourceID)
 	}
 
 	return nil
@@ -180,6 +180,9 @@ func (r *PostgresDataSourceRepository) Delete(ctx context.Context, sourceID stri
 	query := `DELETE FROM data_sources WHERE source_id = $1`
 
 	result, err := r.db.ExecContext(ctx, query, sourceID)
+	if isForeignKeyViolation(err) {
+		return entity.NewConflictError("data source", sourceID, "still referenced by other records")
+	}
 	if err != nil {
 		return fmt.Errorf("failed to delete data source: %w", err)
 	}
@@ -190,7 +193,7 @@ func (r *PostgresDataSourceRepository) Delete(ctx context.Context, sourceID stri
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("data source not found: %s", sourceID)
+		return entity.NewNotFoundError("data source", sourceID)
 	}
 
 	return nil
@@ -0,0 +1,252 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+)
+
+// PostgresPlantDescriptionRepository implements PlantDescriptionRepository using PostgreSQL
+type PostgresPlantDescriptionRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresPlantDescriptionRepository creates a new PostgreSQL plant description repository
+func NewPostgresPlantDescriptionRepository(db *sql.DB) repository.PlantDescriptionRepository {
+	return &PostgresPlantDescriptionRepository{db: db}
+}
+
+// descriptionSelect selects descriptions with their language code
+const descriptionSelect = `
+	SELECT
+		pd.description_id, pd.plant_id, pd.language_id, l.language_code, pd.country_id,
+		pd.short_description, pd.full_description, pd.planting_instructions,
+		pd.care_instructions, pd.propagation_instructions, pd.uses,
+		COALESCE(pd.is_primary, false), pd.source_id, pd.created_at, pd.updated_at
+	FROM plant_descriptions pd
+	INNER JOIN languages l ON pd.language_id = l.language_id
+`
+
+// FindByPlant retrieves all of a plant's descriptions
+func (r *PostgresPlantDescriptionRepository) FindByPlant(ctx context.Context, plantID string) ([]*entity.PlantDescription, error) {
+	query := descriptionSelect + `
+		WHERE pd.plant_id = $1
+		ORDER BY l.language_code, pd.country_id NULLS FIRST
+	`
+
+	return r.queryDescriptions(ctx, query, plantID)
+}
+
+// FindForLocale retrieves a plant's descriptions that can serve a language and country
+func (r *PostgresPlantDescriptionRepository) FindForLocale(ctx context.Context, plantID, languageID string, countryID *string) ([]*entity.PlantDescription, error) {
+	query := descriptionSelect + `
+		WHERE pd.plant_id = $1
+			AND (pd.language_id = $2 OR l.language_code = 'en')
+			AND (pd.country_id IS NULL OR pd.country_id = $3)
+	`
+
+	return r.queryDescriptions(ctx, query, plantID, languageID, countryID)
+}
+
+// Find retrieves the description for one plant, language and country
+func (r *PostgresPlantDescriptionRepository) Find(ctx context.Context, plantID, languageID string, countryID *string) (*entity.PlantDescription, error) {
+	query := descriptionSelect + `
+		WHERE pd.plant_id = $1
+			AND pd.language_id = $2
+			AND pd.country_id IS NOT DISTINCT FROM $3
+	`
+
+	description, err := scanDescription(r.db.QueryRowContext(ctx, query, plantID, languageID, countryID).Scan)
+	if err == sql.ErrNoRows {
+		return nil, entity.NewNotFoundError("plant_description", plantID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find plant description: %w", err)
+	}
+
+	return description, nil
+}
+
+// Save creates or replaces the description for its plant, language and country.
+// An existing description keeps its ID and creation time.
+func (r *PostgresPlantDescriptionRepository) Save(ctx context.Context, description *entity.PlantDescription) error {
+	if err := description.Validate(); err != nil {
+		return entity.NewValidationError("description", err.Error())
+	}
+
+	description.UpdatedAt = time.Now()
+
+	// The country-independent row can't be targeted by ON CONFLICT, so update first
+	updateQuery := `
+		UPDATE plant_descriptions SET
+			short_description = $4,
+			full_description = $5,
+			planting_instructions = $6,
+			care_instructions = $7,
+			propagation_instructions = $8,
+			uses = $9,
+			is_primary = $10,
+			source_id = $11,
+			updated_at = $12
+		WHERE plant_id = $1 AND language_id = $2 AND country_id IS NOT DISTINCT FROM $3
+		RETURNING description_id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, updateQuery,
+		description.PlantID,
+		description.LanguageID,
+		description.CountryID,
+		description.Summary,
+		description.Overview,
+		description.Planting,
+		description.Care,
+		description.Propagation,
+		description.Uses,
+		description.IsPrimary,
+		description.SourceID,
+		description.UpdatedAt,
+	).Scan(&description.DescriptionID, &description.CreatedAt)
+	if err == nil {
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return fmt.Errorf("failed to update plant description: %w", err)
+	}
+
+	description.DescriptionID = uuid.New().String()
+	description.CreatedAt = description.UpdatedAt
+
+	insertQuery := `
+		INSERT INTO plant_descriptions (
+			description_id, plant_id, language_id, country_id,
+			short_description, full_description, planting_instructions,
+			care_instructions, propagation_instructions, uses,
+			is_primary, source_id, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+		)
+	`
+
+	_, err = r.db.ExecContext(ctx, insertQuery,
+		description.DescriptionID,
+		description.PlantID,
+		description.LanguageID,
+		description.CountryID,
+		description.Summary,
+		description.Overview,
+		description.Planting,
+		description.Care,
+		description.Propagation,
+		description.Uses,
+		description.IsPrimary,
+		description.SourceID,
+		description.CreatedAt,
+		description.UpdatedAt,
+	)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return entity.NewValidationError("description", "plant, language, country or data source does not exist")
+		}
+		return fmt.Errorf("failed to create plant description: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes the description for one plant, language and country
+func (r *PostgresPlantDescriptionRepository) Delete(ctx context.Context, plantID, languageID string, countryID *string) error {
+	query := `
+		DELETE FROM plant_descriptions
+		WHERE plant_id = $1 AND language_id = $2 AND country_id IS NOT DISTINCT FROM $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, plantID, languageID, countryID)
+	if err != nil {
+		return fmt.Errorf("failed to delete plant description: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return entity.NewNotFoundError("plant_description", plantID)
+	}
+
+	return nil
+}
+
+// queryDescriptions runs a description query and scans every row
+func (r *PostgresPlantDescriptionRepository) queryDescriptions(ctx context.Context, query string, args ...interface{}) ([]*entity.PlantDescription, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query plant descriptions: %w", err)
+	}
+	defer rows.Close()
+
+	descriptions := []*entity.PlantDescription{}
+	for rows.Next() {
+		description, err := scanDescription(rows.Scan)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan plant description: %w", err)
+		}
+		descriptions = append(descriptions, description)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating plant descriptions: %w", err)
+	}
+
+	return descriptions, nil
+}
+
+// scanDescription scans a row selected with descriptionSelect
+func scanDescription(scan func(dest ...interface{}) error) (*entity.PlantDescription, error) {
+	var d entity.PlantDescription
+	var countryID, summary, overview, planting, care, propagation, uses, sourceID sql.NullString
+
+	err := scan(
+		&d.DescriptionID,
+		&d.PlantID,
+		&d.LanguageID,
+		&d.LanguageCode,
+		&countryID,
+		&summary,
+		&overview,
+		&planting,
+		&care,
+		&propagation,
+		&uses,
+		&d.IsPrimary,
+		&sourceID,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	d.CountryID = nullStringPtr(countryID)
+	d.Summary = nullStringPtr(summary)
+	d.Overview = nullStringPtr(overview)
+	d.Planting = nullStringPtr(planting)
+	d.Care = nullStringPtr(care)
+	d.Propagation = nullStringPtr(propagation)
+	d.Uses = nullStringPtr(uses)
+	d.SourceID = nullStringPtr(sourceID)
+
+	return &d, nil
+}
+
+func nullStringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
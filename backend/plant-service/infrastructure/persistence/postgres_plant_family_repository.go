@@ -36,7 +36,7 @@ func (r *PostgresPlantFamilyRepository) FindByID(ctx context.Context, familyID s
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("plant family not found: %s", familyID)
+		return nil, entity.NewNotFoundError("plant family", familyID)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find plant family: %w", err)
@@ -61,7 +61,7 @@ func (r *PostgresPlantFamilyRepository) FindByName(ctx context.Context, familyNa
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("plant family not found: %s", familyName)
+		return nil, entity.NewNotFoundError("plant family", familyName)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find plant family by name: %w", err)
@@ -157,7 +157,7 @@ func (r *PostgresPlantFamilyRepository) Update(ctx context.Context, family *enti
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("plant family not found: %s", family.FamilyID)
+		return entity.NewNotFoundError("plant family", family.FamilyID)
 	}
 
 	return nil
@@ -167,6 +167,9 @@ func (r *PostgresPlantFamilyRepository) Delete(ctx context.Context, familyID str
 	query := `DELETE FROM plant_families WHERE family_id = $1`
 
 	result, err := r.db.ExecContext(ctx, query, familyID)
+	if isForeignKeyViolation(err) {
+		return entity.NewConflictError("plant family", familyID, "still referenced by other records")
+	}
 	if err != nil {
 		return fmt.Errorf("failed to delete plant family: %w", err)
 	}
@@ -177,7 +180,7 @@ func (r *PostgresPlantFamilyRepository) Delete(ctx context.Context, familyID str
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("plant family not found: %s", familyID)
+		return entity.NewNotFoundError("plant family", familyID)
 	}
 
 	return nil
@@ -36,7 +36,7 @@ func (r *PostgresPlantGenusRepository) FindByID(ctx context.Context, genusID str
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("plant genus not found: %s", genusID)
+		return nil, entity.NewNotFoundError("plant genus", genusID)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find plant genus: %w", err)
@@ -61,7 +61,7 @@ func (r *PostgresPlantGenusRepository) FindByName(ctx context.Context, genusName
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("plant genus not found: %s", genusName)
+		return nil, entity.NewNotFoundError("plant genus", genusName)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find plant genus by name: %w", err)
@@ -174,7 +174,7 @@ func (r *PostgresPlantGenusRepository) Update(ctx context.Context, genus *entity
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("plant genus not found: %s", genus.GenusID)
+		return entity.NewNotFoundError("plant genus", genus.GenusID)
 	}
 
 	return nil
@@ -184,6 +184,9 @@ func (r *PostgresPlantGenusRepository) Delete(ctx context.Context, genusID strin
 	query := `DELETE FROM plant_genera WHERE genus_id = $1`
 
 	result, err := r.db.ExecContext(ctx, query, genusID)
+	if isForeignKeyViolation(err) {
+		return entity.NewConflictError("plant genus", genusID, "still referenced by other records")
+	}
 	if err != nil {
 		return fmt.Errorf("failed to delete plant genus: %w", err)
 	}
@@ -194,7 +197,7 @@ func (r *PostgresPlantGenusRepository) Delete(ctx context.Context, genusID strin
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("plant genus not found: %s", genusID)
+		return entity.NewNotFoundError("plant genus", genusID)
 	}
 
 	return nil
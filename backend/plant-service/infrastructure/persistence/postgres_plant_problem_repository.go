@@ -4,12 +4,54 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"twigger-backend/backend/plant-service/domain/entity"
 	"twigger-backend/backend/plant-service/domain/repository"
+
+	"github.com/lib/pq"
 )
 
+// problemColumns selects a problem with its localized text, falling back to the base row.
+// Queries using it must join problemLocalizedJoin with the language as $1 and country as $2.
+const problemColumns = `
+	pp.problem_id,
+	pp.plant_id,
+	p.full_botanical_name,
+	pp.problem_type,
+	pp.severity,
+	COALESCE(loc.problem_name, pp.problem_name),
+	COALESCE(loc.symptoms, pp.symptoms),
+	pp.affected_parts,
+	pp.active_months,
+	COALESCE(loc.treatments, pp.treatments),
+	COALESCE(loc.organic_treatments, pp.organic_treatments),
+	COALESCE(loc.chemical_treatments, pp.chemical_treatments),
+	COALESCE(loc.prevention, pp.prevention),
+	loc.regional_notes,
+	pp.created_at,
+	pp.updated_at`
+
+// problemLocalizedJoin picks the best translation: requested language before English,
+// country-specific before general
+const problemLocalizedJoin = `
+	INNER JOIN plants p ON pp.plant_id = p.plant_id
+	LEFT JOIN LATERAL (
+		SELECT i.problem_name, i.symptoms, i.treatments, i.organic_treatments,
+			i.chemical_treatments, i.prevention, i.regional_notes
+		FROM plant_problems_i18n i
+		INNER JOIN languages l ON i.language_id = l.language_id
+		WHERE i.problem_id = pp.problem_id
+			AND (i.language_id = $1 OR l.language_code = 'en')
+			AND (i.country_id IS NULL OR i.country_id = $2)
+		ORDER BY (i.language_id = $1) DESC NULLS LAST, (i.country_id IS NOT NULL) DESC
+		LIMIT 1
+	) loc ON true`
+
+// problemSeverityOrder sorts the most severe problems first
+const problemSeverityOrder = `CASE pp.severity WHEN 'high' THEN 3 WHEN 'medium' THEN 2 ELSE 1 END DESC`
+
 // PostgresPlantProblemRepository implements PlantProblemRepository using PostgreSQL
 type PostgresPlantProblemRepository struct {
 	db *sql.DB
@@ -21,22 +63,24 @@ func NewPostgresPlantProblemRepository(db *sql.DB) repository.PlantProblemReposi
 }
 
 func (r *PostgresPlantProblemRepository) FindByID(ctx context.Context, problemID string) (*entity.PlantProblem, error) {
-	query := `
-		SELECT problem_id, plant_id, problem_type, severity, created_at, updated_at
-		FROM plant_problems
-		WHERE problem_id = $1
-	`
+	// No requested language: the lookup falls back to English
+	return r.findOne(ctx, problemID, sql.NullString{}, nil)
+}
 
-	var problem entity.PlantProblem
-	err := r.db.QueryRowContext(ctx, query, problemID).Scan(
-		&problem.ProblemID,
-		&problem.PlantID,
-		&problem.ProblemType,
-		&problem.Severity,
-		&problem.CreatedAt,
-		&problem.UpdatedAt,
-	)
+// FindLocalized retrieves a plant problem with content in the requested language
+func (r *PostgresPlantProblemRepository) FindLocalized(ctx context.Context, problemID, languageID string, countryID *string) (*entity.PlantProblem, error) {
+	return r.findOne(ctx, problemID, sql.NullString{String: languageID, Valid: languageID != ""}, countryID)
+}
 
+func (r *PostgresPlantProblemRepository) findOne(ctx context.Context, problemID string, languageID sql.NullString, countryID *string) (*entity.PlantProblem, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM plant_problems pp
+		%s
+		WHERE pp.problem_id = $3
+	`, problemColumns, problemLocalizedJoin)
+
+	problem, err := scanProblem(r.db.QueryRowContext(ctx, query, languageID, countryID, problemID).Scan)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("plant problem not found: %s", problemID)
 	}
@@ -44,11 +88,11 @@ func (r *PostgresPlantProblemRepository) FindByID(ctx context.Context, problemID
 		return nil, fmt.Errorf("failed to find plant problem: %w", err)
 	}
 
-	return &problem, nil
+	return problem, nil
 }
 
 // FindByPlant retrieves problems for a plant with pagination
-func (r *PostgresPlantProblemRepository) FindByPlant(ctx context.Context, plantID string, limit, offset int) ([]*entity.PlantProblem, error) {
+func (r *PostgresPlantProblemRepository) FindByPlant(ctx context.Context, plantID, languageID string, countryID *string, limit, offset int) ([]*entity.PlantProblem, error) {
 	// Apply default limit if not specified or invalid
 	if limit <= 0 || limit > 1000 {
 		limit = 100 // Default page size
@@ -57,15 +101,16 @@ func (r *PostgresPlantProblemRepository) FindByPlant(ctx context.Context, plantI
 		offset = 0
 	}
 
-	query := `
-		SELECT problem_id, plant_id, problem_type, severity, created_at, updated_at
-		FROM plant_problems
-		WHERE plant_id = $1
-		ORDER BY severity DESC, problem_type
-		LIMIT $2 OFFSET $3
-	`
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM plant_problems pp
+		%s
+		WHERE pp.plant_id = $3
+		ORDER BY %s, pp.problem_type
+		LIMIT $4 OFFSET $5
+	`, problemColumns, problemLocalizedJoin, problemSeverityOrder)
 
-	rows, err := r.db.QueryContext(ctx, query, plantID, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, languageID, countryID, plantID, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query problems by plant: %w", err)
 	}
@@ -75,7 +120,7 @@ func (r *PostgresPlantProblemRepository) FindByPlant(ctx context.Context, plantI
 }
 
 // FindByType retrieves problems of a specific type for a plant with pagination
-func (r *PostgresPlantProblemRepository) FindByType(ctx context.Context, plantID, problemType string, limit, offset int) ([]*entity.PlantProblem, error) {
+func (r *PostgresPlantProblemRepository) FindByType(ctx context.Context, plantID, problemType, languageID string, countryID *string, limit, offset int) ([]*entity.PlantProblem, error) {
 	// Apply default limit if not specified or invalid
 	if limit <= 0 || limit > 1000 {
 		limit = 100 // Default page size
@@ -84,15 +129,16 @@ func (r *PostgresPlantProblemRepository) FindByType(ctx context.Context, plantID
 		offset = 0
 	}
 
-	query := `
-		SELECT problem_id, plant_id, problem_type, severity, created_at, updated_at
-		FROM plant_problems
-		WHERE plant_id = $1 AND problem_type = $2
-		ORDER BY severity DESC
-		LIMIT $3 OFFSET $4
-	`
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM plant_problems pp
+		%s
+		WHERE pp.plant_id = $3 AND pp.problem_type = $4
+		ORDER BY %s
+		LIMIT $5 OFFSET $6
+	`, problemColumns, problemLocalizedJoin, problemSeverityOrder)
 
-	rows, err := r.db.QueryContext(ctx, query, plantID, problemType, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, languageID, countryID, plantID, problemType, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query problems by type: %w", err)
 	}
@@ -102,7 +148,7 @@ func (r *PostgresPlantProblemRepository) FindByType(ctx context.Context, plantID
 }
 
 // FindBySeverity retrieves problems of a specific severity for a plant with pagination
-func (r *PostgresPlantProblemRepository) FindBySeverity(ctx context.Context, plantID, severity string, limit, offset int) ([]*entity.PlantProblem, error) {
+func (r *PostgresPlantProblemRepository) FindBySeverity(ctx context.Context, plantID, severity, languageID string, countryID *string, limit, offset int) ([]*entity.PlantProblem, error) {
 	// Apply default limit if not specified or invalid
 	if limit <= 0 || limit > 1000 {
 		limit = 100 // Default page size
@@ -111,15 +157,16 @@ func (r *PostgresPlantProblemRepository) FindBySeverity(ctx context.Context, pla
 		offset = 0
 	}
 
-	query := `
-		SELECT problem_id, plant_id, problem_type, severity, created_at, updated_at
-		FROM plant_problems
-		WHERE plant_id = $1 AND severity = $2
-		ORDER BY problem_type
-		LIMIT $3 OFFSET $4
-	`
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM plant_problems pp
+		%s
+		WHERE pp.plant_id = $3 AND pp.severity = $4
+		ORDER BY pp.problem_type
+		LIMIT $5 OFFSET $6
+	`, problemColumns, problemLocalizedJoin)
 
-	rows, err := r.db.QueryContext(ctx, query, plantID, severity, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, languageID, countryID, plantID, severity, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query problems by severity: %w", err)
 	}
@@ -128,14 +175,168 @@ func (r *PostgresPlantProblemRepository) FindBySeverity(ctx context.Context, pla
 	return r.scanProblems(rows)
 }
 
+// FindSusceptiblePlants returns the plants among plantIDs with a catalogue record of the same
+// problem. Catalogue problems are recorded per plant, so the same pest or disease on different
+// plants is matched by type and name.
+func (r *PostgresPlantProblemRepository) FindSusceptiblePlants(ctx context.Context, problemID string, plantIDs []string) ([]string, error) {
+	if len(plantIDs) == 0 {
+		return []string{}, nil
+	}
+
+	query := `
+		SELECT DISTINCT pp.plant_id
+		FROM plant_problems src
+		INNER JOIN plant_problems pp
+			ON pp.problem_type = src.problem_type
+			AND LOWER(pp.problem_name) = LOWER(src.problem_name)
+		WHERE src.problem_id = $1 AND pp.plant_id = ANY($2::uuid[])
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, problemID, pq.Array(plantIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query susceptible plants: %w", err)
+	}
+	defer rows.Close()
+
+	plants := []string{}
+	for rows.Next() {
+		var plantID string
+		if err := rows.Scan(&plantID); err != nil {
+			return nil, fmt.Errorf("failed to scan susceptible plant: %w", err)
+		}
+		plants = append(plants, plantID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating susceptible plants: %w", err)
+	}
+
+	return plants, nil
+}
+
+// Search searches the problem catalogue across all plants
+func (r *PostgresPlantProblemRepository) Search(ctx context.Context, filter *repository.ProblemSearchFilter, languageID string, countryID *string) (*repository.ProblemSearchResult, error) {
+	if filter == nil {
+		filter = &repository.ProblemSearchFilter{}
+	}
+	limit, offset := filter.Limit, filter.Offset
+	if limit <= 0 || limit > 1000 {
+		limit = 100 // Default page size
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// $1 and $2 are taken by the language join
+	whereClauses := []string{}
+	args := []interface{}{languageID, countryID}
+	argPos := 3
+
+	if q := strings.TrimSpace(filter.Query); q != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf(`(
+			COALESCE(loc.problem_name, pp.problem_name) ILIKE $%[1]d
+			OR pp.problem_name ILIKE $%[1]d
+			OR array_to_string(COALESCE(loc.symptoms, pp.symptoms), ' ') ILIKE $%[1]d
+			OR p.full_botanical_name ILIKE $%[1]d
+			OR EXISTS (
+				SELECT 1 FROM plant_common_names pcn
+				WHERE pcn.plant_id = pp.plant_id AND pcn.common_name ILIKE $%[1]d
+			)
+		)`, argPos))
+		args = append(args, "%"+q+"%")
+		argPos++
+	}
+
+	if filter.PlantID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("pp.plant_id = $%d", argPos))
+		args = append(args, *filter.PlantID)
+		argPos++
+	}
+
+	if filter.ProblemType != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("pp.problem_type = $%d", argPos))
+		args = append(args, *filter.ProblemType)
+		argPos++
+	}
+
+	if filter.Severity != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("pp.severity = $%d", argPos))
+		args = append(args, *filter.Severity)
+		argPos++
+	}
+
+	if filter.AffectedPart != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("$%d = ANY(pp.affected_parts)", argPos))
+		args = append(args, *filter.AffectedPart)
+		argPos++
+	}
+
+	if filter.Month != nil {
+		// Problems without seasonality are active year-round
+		whereClauses = append(whereClauses, fmt.Sprintf("(cardinality(pp.active_months) = 0 OR $%d::smallint = ANY(pp.active_months))", argPos))
+		args = append(args, *filter.Month)
+		argPos++
+	}
+
+	whereSQL := ""
+	if len(whereClauses) > 0 {
+		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM plant_problems pp
+		%s
+		%s
+	`, problemLocalizedJoin, whereSQL)
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count plant problems: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM plant_problems pp
+		%s
+		%s
+		ORDER BY %s, COALESCE(loc.problem_name, pp.problem_name), pp.problem_id
+		LIMIT $%d OFFSET $%d
+	`, problemColumns, problemLocalizedJoin, whereSQL, problemSeverityOrder, argPos, argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search plant problems: %w", err)
+	}
+	defer rows.Close()
+
+	problems, err := r.scanProblems(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.ProblemSearchResult{
+		Problems: problems,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+		HasMore:  int64(offset+len(problems)) < total,
+	}, nil
+}
+
 func (r *PostgresPlantProblemRepository) Create(ctx context.Context, problem *entity.PlantProblem) error {
 	if err := problem.Validate(); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
 	query := `
-		INSERT INTO plant_problems (problem_id, plant_id, problem_type, severity, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO plant_problems (
+			problem_id, plant_id, problem_type, severity, problem_name,
+			symptoms, affected_parts, active_months, treatments,
+			organic_treatments, chemical_treatments, prevention,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
 
 	now := time.Now()
@@ -147,6 +348,14 @@ func (r *PostgresPlantProblemRepository) Create(ctx context.Context, problem *en
 		problem.PlantID,
 		problem.ProblemType,
 		problem.Severity,
+		problem.ProblemName,
+		pq.Array(problem.Symptoms),
+		pq.Array(nonNilStrings(problem.AffectedParts)),
+		pq.Array(monthsToInt64(problem.ActiveMonths)),
+		pq.Array(problem.Treatments),
+		pq.Array(problem.OrganicTreatments),
+		pq.Array(problem.ChemicalTreatments),
+		pq.Array(problem.Prevention),
 		problem.CreatedAt,
 		problem.UpdatedAt,
 	)
@@ -165,7 +374,10 @@ func (r *PostgresPlantProblemRepository) Update(ctx context.Context, problem *en
 
 	query := `
 		UPDATE plant_problems
-		SET plant_id = $2, problem_type = $3, severity = $4, updated_at = $5
+		SET plant_id = $2, problem_type = $3, severity = $4, problem_name = $5,
+			symptoms = $6, affected_parts = $7, active_months = $8, treatments = $9,
+			organic_treatments = $10, chemical_treatments = $11, prevention = $12,
+			updated_at = $13
 		WHERE problem_id = $1
 	`
 
@@ -176,6 +388,14 @@ func (r *PostgresPlantProblemRepository) Update(ctx context.Context, problem *en
 		problem.PlantID,
 		problem.ProblemType,
 		problem.Severity,
+		problem.ProblemName,
+		pq.Array(problem.Symptoms),
+		pq.Array(nonNilStrings(problem.AffectedParts)),
+		pq.Array(monthsToInt64(problem.ActiveMonths)),
+		pq.Array(problem.Treatments),
+		pq.Array(problem.OrganicTreatments),
+		pq.Array(problem.ChemicalTreatments),
+		pq.Array(problem.Prevention),
 		problem.UpdatedAt,
 	)
 
@@ -219,18 +439,11 @@ func (r *PostgresPlantProblemRepository) Delete(ctx context.Context, problemID s
 func (r *PostgresPlantProblemRepository) scanProblems(rows *sql.Rows) ([]*entity.PlantProblem, error) {
 	var problems []*entity.PlantProblem
 	for rows.Next() {
-		var problem entity.PlantProblem
-		if err := rows.Scan(
-			&problem.ProblemID,
-			&problem.PlantID,
-			&problem.ProblemType,
-			&problem.Severity,
-			&problem.CreatedAt,
-			&problem.UpdatedAt,
-		); err != nil {
+		problem, err := scanProblem(rows.Scan)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan plant problem: %w", err)
 		}
-		problems = append(problems, &problem)
+		problems = append(problems, problem)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -239,3 +452,56 @@ func (r *PostgresPlantProblemRepository) scanProblems(rows *sql.Rows) ([]*entity
 
 	return problems, nil
 }
+
+// scanProblem scans one row selected with problemColumns
+func scanProblem(scan func(dest ...interface{}) error) (*entity.PlantProblem, error) {
+	var problem entity.PlantProblem
+	var months []int64
+	var regionalNotes sql.NullString
+
+	if err := scan(
+		&problem.ProblemID,
+		&problem.PlantID,
+		&problem.PlantName,
+		&problem.ProblemType,
+		&problem.Severity,
+		&problem.ProblemName,
+		pq.Array(&problem.Symptoms),
+		pq.Array(&problem.AffectedParts),
+		pq.Array(&months),
+		pq.Array(&problem.Treatments),
+		pq.Array(&problem.OrganicTreatments),
+		pq.Array(&problem.ChemicalTreatments),
+		pq.Array(&problem.Prevention),
+		&regionalNotes,
+		&problem.CreatedAt,
+		&problem.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	for _, m := range months {
+		problem.ActiveMonths = append(problem.ActiveMonths, int(m))
+	}
+	if regionalNotes.Valid {
+		problem.RegionalNotes = &regionalNotes.String
+	}
+
+	return &problem, nil
+}
+
+// nonNilStrings keeps NOT NULL array columns from receiving NULL
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func monthsToInt64(months []int) []int64 {
+	result := make([]int64, 0, len(months))
+	for _, m := range months {
+		result = append(result, int64(m))
+	}
+	return result
+}
@@ -37,7 +37,7 @@ func (r *PostgresPlantSpeciesRepository) FindByID(ctx context.Context, speciesID
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("plant species not found: %s", speciesID)
+		return nil, entity.NewNotFoundError("plant species", speciesID)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find plant species: %w", err)
@@ -169,7 +169,7 @@ func (r *PostgresPlantSpeciesRepository) Update(ctx context.Context, species *en
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("plant species not found: %s", species.SpeciesID)
+		return entity.NewNotFoundError("plant species", species.SpeciesID)
 	}
 
 	return nil
@@ -179,6 +179,9 @@ func (r *PostgresPlantSpeciesRepository) Delete(ctx context.Context, speciesID s
 	query := `DELETE FROM plant_species WHERE species_id = $1`
 
 	result, err := r.db.ExecContext(ctx, query, speciesID)
+	if isForeignKeyViolation(err) {
+		return entity.NewConflictError("plant species", speciesID, "still referenced by other records")
+	}
 	if err != nil {
 		return fmt.Errorf("failed to delete plant species: %w", err)
 	}
@@ -189,7 +192,7 @@ func (r *PostgresPlantSpeciesRepository) Delete(ctx context.Context, speciesID s
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("plant species not found: %s", speciesID)
+		return entity.NewNotFoundError("plant species", speciesID)
 	}
 
 	return nil
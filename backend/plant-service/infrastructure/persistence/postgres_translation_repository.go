@@ -0,0 +1,355 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+)
+
+// PostgresTranslationRepository implements TranslationRepository using PostgreSQL
+type PostgresTranslationRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresTranslationRepository creates a new PostgreSQL translation repository
+func NewPostgresTranslationRepository(db *sql.DB) repository.TranslationRepository {
+	return &PostgresTranslationRepository{db: db}
+}
+
+// translationSources selects every translatable record of a domain as
+// (key, plant_id, source, translated), with $1 as the language to check
+var translationSources = map[string]string{
+	entity.TranslationDomainCharacteristic: `
+		SELECT
+			c.category || ':' || c.value AS key,
+			NULL::text AS plant_id,
+			COALESCE(en.translated_label, c.value) AS source,
+			EXISTS (
+				SELECT 1 FROM characteristic_translations t
+				WHERE t.language_id = $1
+					AND t.characteristic_category = c.category
+					AND t.characteristic_value = c.value
+			) AS translated
+		FROM (` + characteristicValues() + `) c
+		LEFT JOIN characteristic_translations en
+			ON en.characteristic_category = c.category
+			AND en.characteristic_value = c.value
+			AND en.language_id = (SELECT language_id FROM languages WHERE language_code = 'en')
+	`,
+	entity.TranslationDomainPhysicalTraits: `
+		SELECT
+			pc.characteristic_id::text AS key,
+			pc.plant_id::text AS plant_id,
+			pc.traits::text AS source,
+			EXISTS (
+				SELECT 1 FROM physical_traits_i18n t
+				WHERE t.characteristic_id = pc.characteristic_id AND t.language_id = $1
+			) AS translated
+		FROM physical_characteristics pc
+		WHERE pc.traits IS NOT NULL AND pc.traits <> '{}'::jsonb
+	`,
+	entity.TranslationDomainGrowingConditions: `
+		SELECT
+			gca.assertion_id::text AS key,
+			cp.plant_id::text AS plant_id,
+			array_to_string(gca.soil_types, '` + entity.TranslationListSeparator + `') AS source,
+			EXISTS (
+				SELECT 1 FROM growing_conditions_i18n t
+				WHERE t.assertion_id = gca.assertion_id AND t.language_id = $1
+			) AS translated
+		FROM growing_conditions_assertions gca
+		INNER JOIN country_plants cp ON gca.country_plant_id = cp.country_plant_id
+		WHERE cardinality(gca.soil_types) > 0
+	`,
+	entity.TranslationDomainCompanionBenefits: `
+		SELECT
+			cr.relationship_id::text AS key,
+			cr.plant_a_id::text AS plant_id,
+			array_to_string(cr.benefits, '` + entity.TranslationListSeparator + `') AS source,
+			EXISTS (
+				SELECT 1 FROM companion_benefits_i18n t
+				WHERE t.relationship_id = cr.relationship_id AND t.language_id = $1
+			) AS translated
+		FROM companion_relationships cr
+		WHERE cardinality(cr.benefits) > 0
+	`,
+	entity.TranslationDomainCommonNames: `
+		SELECT
+			p.plant_id::text AS key,
+			p.plant_id::text AS plant_id,
+			COALESCE((
+				SELECT pcn.common_name
+				FROM plant_common_names pcn
+				INNER JOIN languages l ON pcn.language_id = l.language_id
+				WHERE pcn.plant_id = p.plant_id AND l.language_code = 'en'
+				ORDER BY pcn.is_primary DESC NULLS LAST, pcn.common_name
+				LIMIT 1
+			), p.full_botanical_name, '') AS source,
+			EXISTS (
+				SELECT 1 FROM plant_common_names t
+				WHERE t.plant_id = p.plant_id AND t.language_id = $1
+			) AS translated
+		FROM plants p
+	`,
+}
+
+// characteristicValues lists every value of the translatable characteristic enums
+func characteristicValues() string {
+	selects := make([]string, len(entity.TranslatableCharacteristics))
+	for i, category := range entity.TranslatableCharacteristics {
+		selects[i] = fmt.Sprintf("SELECT '%s'::text AS category, unnest(enum_range(NULL::%s))::text AS value", category, category)
+	}
+	return strings.Join(selects, " UNION ALL ")
+}
+
+// growingConditionColumns maps growing condition fields to their columns
+var growingConditionColumns = map[string]string{
+	entity.TranslationFieldSoilTypes:           "soil_types_localized",
+	entity.TranslationFieldSpecialRequirements: "special_requirements",
+	entity.TranslationFieldRegionalTips:        "regional_tips",
+}
+
+// FindMissing lists the records of a domain with no translation in a language
+func (r *PostgresTranslationRepository) FindMissing(ctx context.Context, domain, languageID string, limit, offset int) (*repository.MissingTranslationResult, error) {
+	source, ok := translationSources[domain]
+	if !ok {
+		return nil, entity.NewValidationError("domain", "unknown translation domain")
+	}
+
+	countQuery := `SELECT COUNT(*) FROM (` + source + `) s WHERE NOT s.translated`
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, countQuery, languageID).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count missing translations: %w", err)
+	}
+
+	query := `
+		SELECT s.key, s.plant_id, s.source
+		FROM (` + source + `) s
+		WHERE NOT s.translated
+		ORDER BY s.key
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, languageID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query missing translations: %w", err)
+	}
+	defer rows.Close()
+
+	missing := []*entity.MissingTranslation{}
+	for rows.Next() {
+		m := &entity.MissingTranslation{Domain: domain}
+		var plantID sql.NullString
+		if err := rows.Scan(&m.Key, &plantID, &m.Source); err != nil {
+			return nil, fmt.Errorf("failed to scan missing translation: %w", err)
+		}
+		if plantID.Valid {
+			m.PlantID = &plantID.String
+		}
+		missing = append(missing, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating missing translations: %w", err)
+	}
+
+	return &repository.MissingTranslationResult{
+		Translations: missing,
+		Total:        total,
+		Limit:        limit,
+		Offset:       offset,
+		HasMore:      int64(offset+len(missing)) < total,
+	}, nil
+}
+
+// Coverage counts translatable and translated records per domain for a language
+func (r *PostgresTranslationRepository) Coverage(ctx context.Context, languageID string) ([]*entity.DomainCoverage, error) {
+	counts := make([]string, len(entity.TranslationDomains))
+	for i, domain := range entity.TranslationDomains {
+		counts[i] = fmt.Sprintf(`
+			SELECT %d AS position, '%s' AS domain, COUNT(*) AS total, COUNT(*) FILTER (WHERE s.translated) AS translated
+			FROM (%s) s
+		`, i, domain, translationSources[domain])
+	}
+	query := strings.Join(counts, " UNION ALL ") + " ORDER BY position"
+
+	rows, err := r.db.QueryContext(ctx, query, languageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query translation coverage: %w", err)
+	}
+	defer rows.Close()
+
+	coverage := []*entity.DomainCoverage{}
+	for rows.Next() {
+		var position int
+		c := &entity.DomainCoverage{}
+		if err := rows.Scan(&position, &c.Domain, &c.Total, &c.Translated); err != nil {
+			return nil, fmt.Errorf("failed to scan translation coverage: %w", err)
+		}
+		coverage = append(coverage, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating translation coverage: %w", err)
+	}
+
+	return coverage, nil
+}
+
+// Save creates or updates one translated value
+func (r *PostgresTranslationRepository) Save(ctx context.Context, t *entity.Translation) error {
+	var result sql.Result
+	var err error
+	resource := "plant"
+
+	switch t.Domain {
+	case entity.TranslationDomainCharacteristic:
+		return r.saveCharacteristic(ctx, t)
+
+	case entity.TranslationDomainPhysicalTraits:
+		// Array traits (e.g. flower colors) stay arrays in the translation
+		resource = "physical_trait"
+		query := `
+			INSERT INTO physical_traits_i18n (characteristic_id, language_id, traits_localized)
+			SELECT pc.characteristic_id, $2::uuid, jsonb_build_object($3::text,
+				CASE WHEN jsonb_typeof(pc.traits -> $3::text) = 'array'
+					THEN to_jsonb($5::text[])
+					ELSE to_jsonb($4::text)
+				END)
+			FROM physical_characteristics pc
+			WHERE pc.characteristic_id = $1 AND pc.traits ? $3::text
+			ON CONFLICT (characteristic_id, language_id) DO UPDATE SET
+				traits_localized = physical_traits_i18n.traits_localized || EXCLUDED.traits_localized,
+				updated_at = NOW()
+		`
+		result, err = r.db.ExecContext(ctx, query, t.Key, t.LanguageID, t.Field, t.Value, pq.Array(t.ListValues()))
+
+	case entity.TranslationDomainGrowingConditions:
+		resource = "growing_conditions_assertion"
+		column, ok := growingConditionColumns[t.Field]
+		if !ok {
+			return entity.NewValidationError("field", "unknown growing conditions field")
+		}
+		var value interface{} = t.Value
+		if t.Field == entity.TranslationFieldSoilTypes {
+			value = pq.Array(t.ListValues())
+		}
+		query := fmt.Sprintf(`
+			INSERT INTO growing_conditions_i18n (assertion_id, language_id, %[1]s)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (assertion_id, language_id) DO UPDATE SET
+				%[1]s = EXCLUDED.%[1]s,
+				updated_at = NOW()
+		`, column)
+		result, err = r.db.ExecContext(ctx, query, t.Key, t.LanguageID, value)
+
+	case entity.TranslationDomainCompanionBenefits:
+		resource = "companion_relationship"
+		query := `
+			INSERT INTO companion_benefits_i18n (relationship_id, language_id, benefit_description)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (relationship_id, language_id) DO UPDATE SET
+				benefit_description = EXCLUDED.benefit_description,
+				updated_at = NOW()
+		`
+		result, err = r.db.ExecContext(ctx, query, t.Key, t.LanguageID, t.Value)
+
+	case entity.TranslationDomainCommonNames:
+		// Names are added alongside existing ones; re-importing a name is a no-op
+		query := `
+			INSERT INTO plant_common_names (plant_id, language_id, country_id, common_name)
+			SELECT $1::uuid, $2::uuid, $3::uuid, $4::text
+			WHERE NOT EXISTS (
+				SELECT 1 FROM plant_common_names
+				WHERE plant_id = $1 AND language_id = $2
+					AND country_id IS NOT DISTINCT FROM $3
+					AND LOWER(common_name) = LOWER($4)
+			)
+		`
+		_, err = r.db.ExecContext(ctx, query, t.Key, t.LanguageID, t.CountryID, t.Value)
+		if err != nil {
+			if isForeignKeyViolation(err) {
+				return entity.NewNotFoundError(resource, t.Key)
+			}
+			// A concurrent save of the same name won the race past NOT EXISTS
+			if isUniqueViolation(err) {
+				return entity.NewConflictError("common name", t.Key, "name already exists")
+			}
+			return fmt.Errorf("failed to save common name: %w", err)
+		}
+		return nil
+
+	default:
+		return entity.NewValidationError("domain", "unknown translation domain")
+	}
+
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return entity.NewNotFoundError(resource, t.Key)
+		}
+		return fmt.Errorf("failed to save translation: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return entity.NewNotFoundError(resource, t.Key+"."+t.Field)
+	}
+
+	return nil
+}
+
+// saveCharacteristic saves a characteristic label or description. Labels are only
+// accepted for values of the characteristic's enum, and descriptions need a label.
+func (r *PostgresTranslationRepository) saveCharacteristic(ctx context.Context, t *entity.Translation) error {
+	category, value, ok := t.CharacteristicKey()
+	if !ok {
+		return entity.NewValidationError("key", "unknown characteristic")
+	}
+
+	var query string
+	if t.Field == entity.TranslationFieldDescription {
+		query = `
+			UPDATE characteristic_translations SET
+				translated_description = $4,
+				updated_at = NOW()
+			WHERE language_id = $1 AND characteristic_category = $2 AND characteristic_value = $3
+		`
+	} else {
+		// category is one of the known enum types, so it is safe to interpolate
+		query = fmt.Sprintf(`
+			INSERT INTO characteristic_translations (language_id, characteristic_category, characteristic_value, translated_label)
+			SELECT $1::uuid, $2::text, $3::text, $4::text
+			WHERE $3::text = ANY(enum_range(NULL::%s)::text[])
+			ON CONFLICT (language_id, characteristic_category, characteristic_value) DO UPDATE SET
+				translated_label = EXCLUDED.translated_label,
+				updated_at = NOW()
+		`, category)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, t.LanguageID, category, value, t.Value)
+	if err != nil {
+		return fmt.Errorf("failed to save characteristic translation: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		if t.Field == entity.TranslationFieldDescription {
+			return entity.NewValidationError("field", "translate the label before the description")
+		}
+		return entity.NewNotFoundError("characteristic", t.Key)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"twigger-backend/backend/plant-service/pkg/types"
+)
+
+// MaxGapYears bounds configurable rotation gaps
+const MaxGapYears = 10
+
+// RotationRules configures how many years a plant family must stay out of a bed
+// before it is grown there again
+type RotationRules struct {
+	DefaultGapYears int            `json:"default_gap_years"`
+	FamilyGapYears  map[string]int `json:"family_gap_years,omitempty"` // Keyed by family name, e.g. "Solanaceae"
+}
+
+// DefaultRotationRules returns gaps for the common vegetable families.
+// Heavy feeders and families sharing soil-borne diseases get longer gaps.
+func DefaultRotationRules() *RotationRules {
+	return &RotationRules{
+		DefaultGapYears: 2,
+		FamilyGapYears: map[string]int{
+			"Solanaceae":     3, // Tomatoes, potatoes, peppers: blight, nematodes
+			"Brassicaceae":   3, // Cabbages, kale, radish: clubroot
+			"Amaryllidaceae": 3, // Onions, garlic, leeks: white rot
+			"Alliaceae":      3,
+			"Apiaceae":       3, // Carrots, parsnips, celery: carrot fly, root rots
+			"Cucurbitaceae":  2,
+			"Fabaceae":       2,
+			"Amaranthaceae":  2,
+			"Poaceae":        2,
+		},
+	}
+}
+
+// GapYears returns the rotation gap for a plant family
+func (r *RotationRules) GapYears(familyName string) int {
+	if gap, ok := r.FamilyGapYears[familyName]; ok {
+		return gap
+	}
+	return r.DefaultGapYears
+}
+
+// Validate validates the rotation rules
+func (r *RotationRules) Validate() error {
+	if r.DefaultGapYears < 0 || r.DefaultGapYears > MaxGapYears {
+		return fmt.Errorf("default_gap_years must be between 0 and %d", MaxGapYears)
+	}
+
+	for family, gap := range r.FamilyGapYears {
+		if gap < 0 || gap > MaxGapYears {
+			return fmt.Errorf("gap years for %s must be between 0 and %d", family, MaxGapYears)
+		}
+	}
+
+	return nil
+}
+
+// MaxConfiguredGap returns the longest gap of any family, bounding how much history is needed
+func (r *RotationRules) MaxConfiguredGap() int {
+	longest := r.DefaultGapYears
+	for _, gap := range r.FamilyGapYears {
+		if gap > longest {
+			longest = gap
+		}
+	}
+	return longest
+}
+
+// IsRotatedPlantType returns true for plant types grown season to season and
+// rotated between beds. Trees, shrubs and other perennials stay put.
+func IsRotatedPlantType(plantType types.PlantType) bool {
+	switch plantType {
+	case types.PlantTypeAnnual, types.PlantTypeBiennial, types.PlantTypeBulb, types.PlantTypeVine:
+		return true
+	}
+	return false
+}
+
+// ZonePlanting is one plant's stay in a zone
+type ZonePlanting struct {
+	GardenPlantID string    `json:"garden_plant_id"`
+	PlantID       string    `json:"plant_id"`
+	PlantName     string    `json:"plant_name,omitempty"`
+	FamilyName    string    `json:"family_name,omitempty"`
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`     // Now for plants still in the zone
+	Active        bool      `json:"active"` // Still in the zone
+}
+
+// Years returns the calendar years the planting occupied the zone
+func (p *ZonePlanting) Years() []int {
+	var years []int
+	for year := p.From.Year(); year <= p.To.Year(); year++ {
+		years = append(years, year)
+	}
+	return years
+}
+
+// RotationCandidate scores a plant for a zone by how recently its family was grown there
+type RotationCandidate struct {
+	PlantID    string `json:"plant_id"`
+	PlantName  string `json:"plant_name"`
+	FamilyName string `json:"family_name"`
+	GapYears   int    `json:"gap_years"`
+
+	LastGrownYear   *int `json:"last_grown_year,omitempty"`   // Latest year the family was in the zone within the gap
+	NextAllowedYear *int `json:"next_allowed_year,omitempty"` // First year the family may return
+
+	Score    int    `json:"score"` // 0-100; 100 means no rotation conflict
+	Suitable bool   `json:"suitable"`
+	Reason   string `json:"reason"`
+}
+
+// RotationPlan suggests what to grow in a zone in a target year
+type RotationPlan struct {
+	ZoneID     string               `json:"zone_id"`
+	GardenID   string               `json:"garden_id"`
+	Year       int                  `json:"year"`
+	History    []*ZonePlanting      `json:"history"`    // Plantings within the longest gap, oldest first
+	Candidates []*RotationCandidate `json:"candidates"` // Best first
+}
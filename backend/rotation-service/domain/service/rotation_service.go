@@ -0,0 +1,401 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/rotation-service/domain/entity"
+	"twigger-backend/backend/shared/constants"
+	"twigger-backend/backend/shared/domainerrors"
+)
+
+// maxCandidates limits how many plants one plan scores
+const maxCandidates = 100
+
+// WarningCodeCropRotation marks placement warnings raised by the rotation check
+const WarningCodeCropRotation = "crop_rotation"
+
+// RotationService defines the business logic for crop rotation planning
+type RotationService interface {
+	// PlanZone scores candidate plants for a zone in a target year by family rotation rules
+	PlanZone(ctx context.Context, zoneID string, options *PlanOptions) (*entity.RotationPlan, error)
+
+	// CheckPlacement warns when a plant's family returns to a zone before its gap has passed.
+	// It satisfies the garden service's placement check hook.
+	CheckPlacement(ctx context.Context, gardenPlant *gardenEntity.GardenPlant) ([]gardenEntity.PlacementWarning, error)
+}
+
+// PlanOptions holds optional settings for a rotation plan
+type PlanOptions struct {
+	Year     int      // Target season; defaults to next year
+	GapYears *int     // Overrides the configured gap for every family
+	PlantIDs []string // Candidates; defaults to plants grown in the garden
+}
+
+// rotationService implements RotationService
+type rotationService struct {
+	zoneRepo        gardenRepository.GardenZoneRepository
+	gardenPlantRepo gardenRepository.GardenPlantRepository
+	eventRepo       gardenRepository.GardenPlantEventRepository
+	plantRepo       plantRepository.PlantRepository
+	rules           *entity.RotationRules
+	now             func() time.Time
+}
+
+// NewRotationService creates a new rotation service instance.
+// Nil rules fall back to DefaultRotationRules.
+func NewRotationService(
+	zoneRepo gardenRepository.GardenZoneRepository,
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+	eventRepo gardenRepository.GardenPlantEventRepository,
+	plantRepo plantRepository.PlantRepository,
+	rules *entity.RotationRules,
+) RotationService {
+	if rules == nil {
+		rules = entity.DefaultRotationRules()
+	}
+	return &rotationService{
+		zoneRepo:        zoneRepo,
+		gardenPlantRepo: gardenPlantRepo,
+		eventRepo:       eventRepo,
+		plantRepo:       plantRepo,
+		rules:           rules,
+		now:             time.Now,
+	}
+}
+
+// PlanZone scores candidate plants for a zone in a target year by family rotation rules
+func (s *rotationService) PlanZone(ctx context.Context, zoneID string, options *PlanOptions) (*entity.RotationPlan, error) {
+	if zoneID == "" {
+		return nil, domainerrors.NewInvalidInputError("zone_id", "zone ID cannot be empty")
+	}
+
+	if options == nil {
+		options = &PlanOptions{}
+	}
+
+	currentYear := s.now().Year()
+	year := options.Year
+	if year == 0 {
+		year = currentYear + 1
+	}
+	if year < currentYear-entity.MaxGapYears || year > currentYear+entity.MaxGapYears {
+		return nil, domainerrors.NewValidationError("year", fmt.Sprintf("year must be within %d years of now", entity.MaxGapYears))
+	}
+
+	rules := s.rules
+	if options.GapYears != nil {
+		rules = &entity.RotationRules{DefaultGapYears: *options.GapYears}
+		if err := rules.Validate(); err != nil {
+			return nil, domainerrors.NewValidationError("gap_years", err.Error())
+		}
+	}
+
+	if len(options.PlantIDs) > maxCandidates {
+		return nil, domainerrors.NewValidationError("plant_id", fmt.Sprintf("at most %d candidate plants are allowed", maxCandidates))
+	}
+
+	zone, err := s.zoneRepo.FindByID(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zone: %w", err)
+	}
+
+	history, err := s.zoneHistory(ctx, zone.ZoneID, yearStart(year-rules.MaxConfiguredGap()))
+	if err != nil {
+		return nil, err
+	}
+
+	candidateIDs := uniqueStrings(options.PlantIDs)
+	if len(candidateIDs) == 0 {
+		if candidateIDs, err = s.gardenPlantIDs(ctx, zone.GardenID, history); err != nil {
+			return nil, err
+		}
+	}
+
+	plantIDs := append([]string{}, candidateIDs...)
+	for _, planting := range history {
+		plantIDs = append(plantIDs, planting.PlantID)
+	}
+	plants, err := s.loadPlants(ctx, plantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	annotateHistory(history, plants)
+	familyYears := occupiedFamilyYears(history, plants, year)
+
+	candidates := make([]*entity.RotationCandidate, 0, len(candidateIDs))
+	for _, plantID := range candidateIDs {
+		plant, ok := plants[plantID]
+		if !ok {
+			if len(options.PlantIDs) > 0 {
+				return nil, domainerrors.NewNotFoundError("plant", plantID)
+			}
+			continue
+		}
+		candidates = append(candidates, scoreCandidate(plant, year, rules, familyYears))
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].PlantName < candidates[j].PlantName
+	})
+
+	return &entity.RotationPlan{
+		ZoneID:     zone.ZoneID,
+		GardenID:   zone.GardenID,
+		Year:       year,
+		History:    history,
+		Candidates: candidates,
+	}, nil
+}
+
+// CheckPlacement warns when a plant's family returns to a zone before its gap has passed
+func (s *rotationService) CheckPlacement(ctx context.Context, gardenPlant *gardenEntity.GardenPlant) ([]gardenEntity.PlacementWarning, error) {
+	if gardenPlant.ZoneID == nil {
+		return nil, nil
+	}
+
+	plant, err := s.plantRepo.FindByID(ctx, gardenPlant.PlantID, constants.EnglishLanguageID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plant: %w", err)
+	}
+
+	gapYears := s.rules.GapYears(plant.FamilyName)
+	if !entity.IsRotatedPlantType(plant.PlantType) || gapYears == 0 {
+		return nil, nil
+	}
+
+	year := s.now().Year()
+	history, err := s.zoneHistory(ctx, *gardenPlant.ZoneID, yearStart(year-gapYears))
+	if err != nil {
+		return nil, err
+	}
+
+	plantIDs := make([]string, 0, len(history))
+	for _, planting := range history {
+		plantIDs = append(plantIDs, planting.PlantID)
+	}
+	plants, err := s.loadPlants(ctx, plantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate := scoreCandidate(plant, year, s.rules, occupiedFamilyYears(history, plants, year))
+	if candidate.Suitable {
+		return nil, nil
+	}
+
+	return []gardenEntity.PlacementWarning{{
+		Code:    WarningCodeCropRotation,
+		Message: candidate.Reason,
+		Details: map[string]interface{}{
+			"family_name":       candidate.FamilyName,
+			"gap_years":         candidate.GapYears,
+			"last_grown_year":   *candidate.LastGrownYear,
+			"next_allowed_year": *candidate.NextAllowedYear,
+		},
+	}}, nil
+}
+
+// zoneHistory combines the zone's garden plant rows (including soft-removed plants)
+// with the event log, which also covers deleted plants and plants moved elsewhere
+func (s *rotationService) zoneHistory(ctx context.Context, zoneID string, since time.Time) ([]*entity.ZonePlanting, error) {
+	now := s.now()
+	byGardenPlant := make(map[string]*entity.ZonePlanting)
+	var history []*entity.ZonePlanting
+
+	gardenPlants, err := s.gardenPlantRepo.FindByZoneID(ctx, zoneID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zone plants: %w", err)
+	}
+	for _, gardenPlant := range gardenPlants {
+		planting := &entity.ZonePlanting{
+			GardenPlantID: gardenPlant.GardenPlantID,
+			PlantID:       gardenPlant.PlantID,
+			From:          gardenPlant.CreatedAt,
+			To:            now,
+			Active:        gardenPlant.IsActive(),
+		}
+		if gardenPlant.PlantedDate != nil {
+			planting.From = *gardenPlant.PlantedDate
+		}
+		if !planting.Active {
+			planting.To = *gardenPlant.RemovedDate
+		}
+		if planting.To.Before(since) {
+			continue
+		}
+		byGardenPlant[planting.GardenPlantID] = planting
+		history = append(history, planting)
+	}
+
+	logged, err := s.eventRepo.FindZonePlantings(ctx, zoneID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zone history: %w", err)
+	}
+	for _, zonePlanting := range logged {
+		if _, ok := byGardenPlant[zonePlanting.GardenPlantID]; ok {
+			continue
+		}
+		history = append(history, &entity.ZonePlanting{
+			GardenPlantID: zonePlanting.GardenPlantID,
+			PlantID:       zonePlanting.PlantID,
+			From:          zonePlanting.FirstSeen,
+			To:            zonePlanting.LastSeen,
+		})
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].From.Before(history[j].From)
+	})
+
+	return history, nil
+}
+
+// gardenPlantIDs lists the plants grown anywhere in the garden plus those in the zone's history
+func (s *rotationService) gardenPlantIDs(ctx context.Context, gardenID string, history []*entity.ZonePlanting) ([]string, error) {
+	gardenPlants, err := s.gardenPlantRepo.FindByGardenID(ctx, gardenID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get garden plants: %w", err)
+	}
+
+	plantIDs := make([]string, 0, len(gardenPlants)+len(history))
+	for _, gardenPlant := range gardenPlants {
+		plantIDs = append(plantIDs, gardenPlant.PlantID)
+	}
+	for _, planting := range history {
+		plantIDs = append(plantIDs, planting.PlantID)
+	}
+
+	plantIDs = uniqueStrings(plantIDs)
+	if len(plantIDs) > maxCandidates {
+		plantIDs = plantIDs[:maxCandidates]
+	}
+	return plantIDs, nil
+}
+
+// loadPlants fetches plants with their family and type, keyed by plant ID
+func (s *rotationService) loadPlants(ctx context.Context, plantIDs []string) (map[string]*plantEntity.Plant, error) {
+	plants := make(map[string]*plantEntity.Plant)
+
+	plantIDs = uniqueStrings(plantIDs)
+	if len(plantIDs) == 0 {
+		return plants, nil
+	}
+
+	found, err := s.plantRepo.FindByIDs(ctx, plantIDs, constants.EnglishLanguageID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plants: %w", err)
+	}
+	for _, plant := range found {
+		plants[plant.PlantID] = plant
+	}
+
+	return plants, nil
+}
+
+// annotateHistory fills in plant names and families on zone plantings
+func annotateHistory(history []*entity.ZonePlanting, plants map[string]*plantEntity.Plant) {
+	for _, planting := range history {
+		if plant, ok := plants[planting.PlantID]; ok {
+			planting.PlantName = plant.FullBotanicalName
+			planting.FamilyName = plant.FamilyName
+		}
+	}
+}
+
+// occupiedFamilyYears maps each rotated family to the years it was in the zone. Plantings
+// still in the ground are not counted for the target season, so neighbours of one crop do
+// not block each other, but ones already removed from it are.
+func occupiedFamilyYears(history []*entity.ZonePlanting, plants map[string]*plantEntity.Plant, season int) map[string]map[int]bool {
+	familyYears := make(map[string]map[int]bool)
+	for _, planting := range history {
+		plant, ok := plants[planting.PlantID]
+		if !ok || !entity.IsRotatedPlantType(plant.PlantType) {
+			continue
+		}
+		if familyYears[plant.FamilyName] == nil {
+			familyYears[plant.FamilyName] = make(map[int]bool)
+		}
+		for _, year := range planting.Years() {
+			if year == season && planting.Active {
+				continue
+			}
+			familyYears[plant.FamilyName][year] = true
+		}
+	}
+	return familyYears
+}
+
+// scoreCandidate scores a plant for a target year. A family grown in the zone within
+// its gap scores lower the more recently it was there, and zero when it was there earlier
+// in the target season.
+func scoreCandidate(plant *plantEntity.Plant, year int, rules *entity.RotationRules, familyYears map[string]map[int]bool) *entity.RotationCandidate {
+	candidate := &entity.RotationCandidate{
+		PlantID:    plant.PlantID,
+		PlantName:  plant.FullBotanicalName,
+		FamilyName: plant.FamilyName,
+		GapYears:   rules.GapYears(plant.FamilyName),
+		Score:      100,
+		Suitable:   true,
+	}
+
+	if !entity.IsRotatedPlantType(plant.PlantType) {
+		candidate.GapYears = 0
+		candidate.Reason = fmt.Sprintf("%s plants are not rotated", plant.PlantType)
+		return candidate
+	}
+
+	lastGrown := 0
+	for y := year; y >= year-candidate.GapYears; y-- {
+		if familyYears[plant.FamilyName][y] {
+			lastGrown = y
+			break
+		}
+	}
+
+	if lastGrown == 0 {
+		candidate.Reason = fmt.Sprintf("%s has not been grown here in the last %d years", plant.FamilyName, candidate.GapYears)
+		return candidate
+	}
+
+	nextAllowed := lastGrown + candidate.GapYears + 1
+	candidate.LastGrownYear = &lastGrown
+	candidate.NextAllowedYear = &nextAllowed
+	candidate.Score = 0 // Already grown earlier in the target season
+	if lastGrown < year {
+		candidate.Score = 100 * (year - lastGrown - 1) / candidate.GapYears
+	}
+	candidate.Suitable = false
+	candidate.Reason = fmt.Sprintf("%s was grown here in %d; rotate it elsewhere until %d", plant.FamilyName, lastGrown, nextAllowed)
+
+	return candidate
+}
+
+// yearStart returns 1 January of a year
+func yearStart(year int) time.Time {
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// uniqueStrings removes empty and duplicate values, keeping the first occurrence
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/rotation-service/domain/entity"
+	"twigger-backend/backend/shared/mocks"
+)
+
+// stubEventRepository serves zone plantings from memory; other methods are not used by rotation
+type stubEventRepository struct {
+	gardenRepository.GardenPlantEventRepository
+	plantings map[string][]*gardenRepository.ZonePlanting
+}
+
+func (s *stubEventRepository) FindZonePlantings(ctx context.Context, zoneID string, since time.Time) ([]*gardenRepository.ZonePlanting, error) {
+	var result []*gardenRepository.ZonePlanting
+	for _, planting := range s.plantings[zoneID] {
+		if !planting.LastSeen.Before(since) {
+			result = append(result, planting)
+		}
+	}
+	return result, nil
+}
+
+// testNow is mid-season, so plantings removed earlier this year are history
+var testNow = time.Date(2025, time.August, 15, 9, 0, 0, 0, time.UTC)
+
+func yearsAgo(years int) time.Time {
+	return time.Date(testNow.Year()-years, time.June, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// setupRotationService builds a bed where tomatoes grew last year (and were deleted),
+// beans grew three years ago, and an apple tree still stands
+func setupRotationService() (*rotationService, *mocks.StubGardenPlantRepository) {
+	beansRemoved := yearsAgo(3).AddDate(0, 3, 0)
+	beansPlanted := yearsAgo(3)
+	applePlanted := yearsAgo(6)
+
+	gardenPlants := &mocks.StubGardenPlantRepository{Plants: []*gardenEntity.GardenPlant{
+		{GardenPlantID: "gp-beans", GardenID: "garden-123", ZoneID: mocks.StrPtr("zone-bed"), PlantID: "plant-bean", PlantedDate: &beansPlanted, RemovedDate: &beansRemoved},
+		{GardenPlantID: "gp-apple", GardenID: "garden-123", ZoneID: mocks.StrPtr("zone-bed"), PlantID: "plant-apple", PlantedDate: &applePlanted},
+		{GardenPlantID: "gp-kale", GardenID: "garden-123", ZoneID: mocks.StrPtr("zone-other"), PlantID: "plant-kale", PlantedDate: &beansPlanted},
+	}}
+
+	service := &rotationService{
+		zoneRepo: &mocks.StubGardenZoneRepository{Zones: map[string]*gardenEntity.GardenZone{
+			"zone-bed": {ZoneID: "zone-bed", GardenID: "garden-123"},
+		}},
+		gardenPlantRepo: gardenPlants,
+		eventRepo: &stubEventRepository{plantings: map[string][]*gardenRepository.ZonePlanting{
+			"zone-bed": {
+				// Deleted plant only known from the event log
+				{GardenPlantID: "gp-tomato-old", PlantID: "plant-tomato", FirstSeen: yearsAgo(1), LastSeen: yearsAgo(1).AddDate(0, 3, 0)},
+			},
+		}},
+		plantRepo: &mocks.StubPlantRepository{Plants: []*plantEntity.Plant{
+			{PlantID: "plant-tomato", FullBotanicalName: "Solanum lycopersicum", FamilyName: "Solanaceae", PlantType: types.PlantTypeAnnual},
+			{PlantID: "plant-pepper", FullBotanicalName: "Capsicum annuum", FamilyName: "Solanaceae", PlantType: types.PlantTypeAnnual},
+			{PlantID: "plant-bean", FullBotanicalName: "Phaseolus vulgaris", FamilyName: "Fabaceae", PlantType: types.PlantTypeAnnual},
+			{PlantID: "plant-kale", FullBotanicalName: "Brassica oleracea", FamilyName: "Brassicaceae", PlantType: types.PlantTypeBiennial},
+			{PlantID: "plant-apple", FullBotanicalName: "Malus domestica", FamilyName: "Rosaceae", PlantType: types.PlantTypeTree},
+		}},
+		rules: entity.DefaultRotationRules(),
+		now:   func() time.Time { return testNow },
+	}
+	return service, gardenPlants
+}
+
+func candidateByPlant(plan *entity.RotationPlan, plantID string) *entity.RotationCandidate {
+	for _, candidate := range plan.Candidates {
+		if candidate.PlantID == plantID {
+			return candidate
+		}
+	}
+	return nil
+}
+
+func TestRotationService_PlanZone_ScoresByFamilyHistory(t *testing.T) {
+	service, _ := setupRotationService()
+	thisYear := testNow.Year()
+
+	plan, err := service.PlanZone(context.Background(), "zone-bed", &PlanOptions{
+		PlantIDs: []string{"plant-pepper", "plant-bean", "plant-kale", "plant-apple"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, thisYear+1, plan.Year)
+	assert.Equal(t, "garden-123", plan.GardenID)
+	// Beans ended before the longest gap (3 years before next season) so are not history
+	require.Len(t, plan.History, 2)
+	assert.Equal(t, "gp-apple", plan.History[0].GardenPlantID)
+	assert.True(t, plan.History[0].Active)
+
+	// Tomatoes last year: peppers share Solanaceae and must wait out the 3 year gap
+	pepper := candidateByPlant(plan, "plant-pepper")
+	require.NotNil(t, pepper)
+	assert.False(t, pepper.Suitable)
+	assert.Equal(t, 3, pepper.GapYears)
+	require.NotNil(t, pepper.LastGrownYear)
+	assert.Equal(t, thisYear-1, *pepper.LastGrownYear)
+	assert.Equal(t, thisYear+3, *pepper.NextAllowedYear)
+	assert.Equal(t, 33, pepper.Score)
+
+	// Beans three years ago: outside the 2 year Fabaceae gap for next year
+	bean := candidateByPlant(plan, "plant-bean")
+	assert.True(t, bean.Suitable)
+	assert.Equal(t, 100, bean.Score)
+
+	// Trees are not rotated
+	apple := candidateByPlant(plan, "plant-apple")
+	assert.True(t, apple.Suitable)
+	assert.Equal(t, 0, apple.GapYears)
+
+	// Suitable candidates sort first
+	assert.Equal(t, "plant-pepper", plan.Candidates[len(plan.Candidates)-1].PlantID)
+}
+
+func TestRotationService_PlanZone_GapOverride(t *testing.T) {
+	service, _ := setupRotationService()
+
+	plan, err := service.PlanZone(context.Background(), "zone-bed", &PlanOptions{
+		PlantIDs: []string{"plant-bean"},
+		GapYears: mocks.IntPtr(5),
+	})
+
+	require.NoError(t, err)
+	bean := candidateByPlant(plan, "plant-bean")
+	assert.False(t, bean.Suitable)
+	assert.Equal(t, 5, bean.GapYears)
+}
+
+func TestRotationService_PlanZone_DefaultCandidatesFromGarden(t *testing.T) {
+	service, _ := setupRotationService()
+
+	plan, err := service.PlanZone(context.Background(), "zone-bed", nil)
+
+	require.NoError(t, err)
+	assert.NotNil(t, candidateByPlant(plan, "plant-kale"))
+	assert.NotNil(t, candidateByPlant(plan, "plant-tomato"))
+	assert.Nil(t, candidateByPlant(plan, "plant-pepper"))
+}
+
+func TestRotationService_PlanZone_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *PlanOptions
+		wantErr string
+	}{
+		{name: "gap too long", options: &PlanOptions{GapYears: mocks.IntPtr(entity.MaxGapYears + 1)}, wantErr: "gap"},
+		{name: "year too far", options: &PlanOptions{Year: testNow.Year() + 50}, wantErr: "year must be within"},
+		{name: "unknown plant", options: &PlanOptions{PlantIDs: []string{"plant-missing"}}, wantErr: "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := setupRotationService()
+
+			_, err := service.PlanZone(context.Background(), "zone-bed", tt.options)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestRotationService_CheckPlacement_WarnsOnRecentFamily(t *testing.T) {
+	service, _ := setupRotationService()
+
+	warnings, err := service.CheckPlacement(context.Background(), &gardenEntity.GardenPlant{
+		GardenID: "garden-123",
+		ZoneID:   mocks.StrPtr("zone-bed"),
+		PlantID:  "plant-pepper",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, WarningCodeCropRotation, warnings[0].Code)
+	assert.Equal(t, "Solanaceae", warnings[0].Details["family_name"])
+	assert.Equal(t, testNow.Year()-1, warnings[0].Details["last_grown_year"])
+}
+
+func TestRotationService_CheckPlacement_NoWarning(t *testing.T) {
+	tests := []struct {
+		name        string
+		gardenPlant *gardenEntity.GardenPlant
+	}{
+		{name: "no zone", gardenPlant: &gardenEntity.GardenPlant{PlantID: "plant-pepper"}},
+		{name: "family outside gap", gardenPlant: &gardenEntity.GardenPlant{ZoneID: mocks.StrPtr("zone-bed"), PlantID: "plant-bean"}},
+		{name: "other bed", gardenPlant: &gardenEntity.GardenPlant{ZoneID: mocks.StrPtr("zone-other"), PlantID: "plant-pepper"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := setupRotationService()
+
+			warnings, err := service.CheckPlacement(context.Background(), tt.gardenPlant)
+
+			require.NoError(t, err)
+			assert.Empty(t, warnings)
+		})
+	}
+}
+
+func TestRotationService_CheckPlacement_UnknownPlant(t *testing.T) {
+	service, _ := setupRotationService()
+
+	_, err := service.CheckPlacement(context.Background(), &gardenEntity.GardenPlant{ZoneID: mocks.StrPtr("zone-bed"), PlantID: "plant-missing"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestRotationService_CheckPlacement_CurrentSeason(t *testing.T) {
+	planted := time.Date(testNow.Year(), time.March, 1, 0, 0, 0, 0, time.UTC)
+	removed := time.Date(testNow.Year(), time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("removed earlier this season", func(t *testing.T) {
+		service, gardenPlants := setupRotationService()
+		gardenPlants.Plants = append(gardenPlants.Plants, &gardenEntity.GardenPlant{
+			GardenPlantID: "gp-kale-spring", GardenID: "garden-123", ZoneID: mocks.StrPtr("zone-new"), PlantID: "plant-kale", PlantedDate: &planted, RemovedDate: &removed,
+		})
+
+		warnings, err := service.CheckPlacement(context.Background(), &gardenEntity.GardenPlant{ZoneID: mocks.StrPtr("zone-new"), PlantID: "plant-kale"})
+
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, testNow.Year(), warnings[0].Details["last_grown_year"])
+		assert.Equal(t, testNow.Year()+4, warnings[0].Details["next_allowed_year"])
+	})
+
+	t.Run("still growing this season", func(t *testing.T) {
+		service, gardenPlants := setupRotationService()
+		gardenPlants.Plants = append(gardenPlants.Plants, &gardenEntity.GardenPlant{
+			GardenPlantID: "gp-kale-row", GardenID: "garden-123", ZoneID: mocks.StrPtr("zone-new"), PlantID: "plant-kale", PlantedDate: &planted,
+		})
+
+		warnings, err := service.CheckPlacement(context.Background(), &gardenEntity.GardenPlant{ZoneID: mocks.StrPtr("zone-new"), PlantID: "plant-kale"})
+
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+}
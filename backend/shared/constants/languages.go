@@ -0,0 +1,5 @@
+package constants
+
+// EnglishLanguageID is the seeded English language. Services that have no request
+// language, or match against English text, read plant data in it.
+const EnglishLanguageID = "8a86d436-e58f-4e2c-aac1-2e3c5a7b10cf"
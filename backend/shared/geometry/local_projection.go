@@ -0,0 +1,64 @@
+package geometry
+
+import "math"
+
+// Planar helpers for garden-scale geometry.
+// Coordinates are projected into a local frame (meters east/north of an
+// origin), which is accurate enough over the extent of a garden.
+
+// Approximate meters per degree of latitude and longitude (at the equator)
+const (
+	MetersPerDegreeLat = 110540.0
+	MetersPerDegreeLng = 111320.0
+)
+
+// Point is a coordinate in the local planar frame (meters)
+type Point struct {
+	X float64 // east
+	Y float64 // north
+}
+
+// LocalProjection converts between lon/lat degrees and local meters around an origin
+type LocalProjection struct {
+	originLat float64
+	originLng float64
+	cosLat    float64
+}
+
+// NewLocalProjection creates a projection centred on the given origin
+func NewLocalProjection(lat, lng float64) *LocalProjection {
+	return &LocalProjection{
+		originLat: lat,
+		originLng: lng,
+		cosLat:    math.Cos(lat * math.Pi / 180),
+	}
+}
+
+// ToLocal projects a lon/lat coordinate into the local frame
+func (p *LocalProjection) ToLocal(lng, lat float64) Point {
+	return Point{
+		X: (lng - p.originLng) * MetersPerDegreeLng * p.cosLat,
+		Y: (lat - p.originLat) * MetersPerDegreeLat,
+	}
+}
+
+// ToLngLat converts a local point back to lon/lat degrees
+func (p *LocalProjection) ToLngLat(pt Point) (lng, lat float64) {
+	lng = p.originLng + pt.X/(MetersPerDegreeLng*p.cosLat)
+	lat = p.originLat + pt.Y/MetersPerDegreeLat
+	return lng, lat
+}
+
+// PointInRing tests whether a point lies inside a ring using ray casting
+func PointInRing(pt Point, ring []Point) bool {
+	inside := false
+	n := len(ring)
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Y > pt.Y) != (b.Y > pt.Y) &&
+			pt.X < (b.X-a.X)*(pt.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
@@ -0,0 +1,27 @@
+package geometry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalProjection_RoundTrip(t *testing.T) {
+	proj := NewLocalProjection(51.5, -0.12)
+
+	pt := proj.ToLocal(-0.1199, 51.5001)
+	assert.InDelta(t, 6.9, pt.X, 0.1)
+	assert.InDelta(t, 11.05, pt.Y, 0.01)
+
+	lng, lat := proj.ToLngLat(pt)
+	assert.InDelta(t, -0.1199, lng, 1e-9)
+	assert.InDelta(t, 51.5001, lat, 1e-9)
+}
+
+func TestPointInRing(t *testing.T) {
+	square := []Point{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}, {X: 0, Y: 0}}
+
+	assert.True(t, PointInRing(Point{X: 1, Y: 1}, square))
+	assert.False(t, PointInRing(Point{X: 3, Y: 1}, square))
+	assert.False(t, PointInRing(Point{X: 1, Y: 1}, nil))
+}
@@ -0,0 +1,125 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/garden-service/domain/repository"
+)
+
+// StubGardenRepository serves gardens and their centre points from memory for testing.
+// Methods it does not implement panic through the nil embedded interface.
+type StubGardenRepository struct {
+	repository.GardenRepository
+	Gardens map[string]*entity.Garden
+	Centers map[string][]float64 // lat, lng by garden ID; missing when the garden has no boundary
+	Err     error                // Returned by every lookup when set
+}
+
+// NewStubGardenRepository serves one garden. A non-nil center (lat, lng) gives the garden
+// a boundary centred there; otherwise it has no location.
+func NewStubGardenRepository(garden *entity.Garden, center []float64) *StubGardenRepository {
+	gardens := &StubGardenRepository{Gardens: map[string]*entity.Garden{garden.GardenID: garden}}
+	if center != nil {
+		boundary := `{"type":"Polygon"}`
+		garden.BoundaryGeoJSON = &boundary
+		gardens.Centers = map[string][]float64{garden.GardenID: center}
+	}
+	return gardens
+}
+
+// FindByID returns the stored garden or a not found error
+func (s *StubGardenRepository) FindByID(ctx context.Context, gardenID string) (*entity.Garden, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	if garden, ok := s.Gardens[gardenID]; ok {
+		return garden, nil
+	}
+	return nil, entity.NewNotFoundError("garden", gardenID)
+}
+
+// GetCenterPoint returns the stored centre, failing like the database when the garden has no boundary
+func (s *StubGardenRepository) GetCenterPoint(ctx context.Context, gardenID string) (float64, float64, error) {
+	if s.Err != nil {
+		return 0, 0, s.Err
+	}
+	if _, ok := s.Gardens[gardenID]; !ok {
+		return 0, 0, entity.NewNotFoundError("garden", gardenID)
+	}
+	center, ok := s.Centers[gardenID]
+	if !ok {
+		return 0, 0, fmt.Errorf("garden %s has no boundary", gardenID)
+	}
+	return center[0], center[1], nil
+}
+
+// StubGardenZoneRepository serves zones from memory for testing
+type StubGardenZoneRepository struct {
+	repository.GardenZoneRepository
+	Zones map[string]*entity.GardenZone
+}
+
+// FindByID returns the stored zone or a not found error
+func (s *StubGardenZoneRepository) FindByID(ctx context.Context, zoneID string) (*entity.GardenZone, error) {
+	if zone, ok := s.Zones[zoneID]; ok {
+		return zone, nil
+	}
+	return nil, entity.NewNotFoundError("garden_zone", zoneID)
+}
+
+// StubGardenPlantRepository serves garden plants from memory for testing
+type StubGardenPlantRepository struct {
+	repository.GardenPlantRepository
+	Plants []*entity.GardenPlant
+}
+
+// FindByID returns the stored garden plant or a not found error
+func (s *StubGardenPlantRepository) FindByID(ctx context.Context, gardenPlantID string) (*entity.GardenPlant, error) {
+	for _, plant := range s.Plants {
+		if plant.GardenPlantID == gardenPlantID {
+			return plant, nil
+		}
+	}
+	return nil, entity.NewNotFoundError("garden_plant", gardenPlantID)
+}
+
+// FindByGardenID returns the garden's plants, skipping removed ones unless asked
+func (s *StubGardenPlantRepository) FindByGardenID(ctx context.Context, gardenID string, includeRemoved bool) ([]*entity.GardenPlant, error) {
+	var result []*entity.GardenPlant
+	for _, plant := range s.Plants {
+		if plant.GardenID == gardenID && (includeRemoved || plant.IsActive()) {
+			result = append(result, plant)
+		}
+	}
+	return result, nil
+}
+
+// FindByZoneID returns the zone's plants, skipping removed ones unless asked
+func (s *StubGardenPlantRepository) FindByZoneID(ctx context.Context, zoneID string, includeRemoved bool) ([]*entity.GardenPlant, error) {
+	var result []*entity.GardenPlant
+	for _, plant := range s.Plants {
+		if plant.ZoneID != nil && *plant.ZoneID == zoneID && (includeRemoved || plant.IsActive()) {
+			result = append(result, plant)
+		}
+	}
+	return result, nil
+}
+
+// FindActiveInGarden returns the garden's plants that have not been removed
+func (s *StubGardenPlantRepository) FindActiveInGarden(ctx context.Context, gardenID string) ([]*entity.GardenPlant, error) {
+	return s.FindByGardenID(ctx, gardenID, false)
+}
+
+// StubTransactionManager runs the work directly and records whether it was rolled back
+type StubTransactionManager struct {
+	RolledBack bool
+}
+
+// WithinTransaction runs fn, marking the transaction rolled back when fn fails
+func (m *StubTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := fn(ctx)
+	m.RolledBack = err != nil
+	return err
+}
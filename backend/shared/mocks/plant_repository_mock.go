@@ -114,6 +114,15 @@ func (m *MockPlantRepository) GetGrowingConditions(ctx context.Context, plantID,
 	return args.Get(0).(*types.GrowingConditions), args.Error(1)
 }
 
+// GetGrowingConditionsAssertions mocks the GetGrowingConditionsAssertions method
+func (m *MockPlantRepository) GetGrowingConditionsAssertions(ctx context.Context, plantID, countryID, languageID string) ([]*types.GrowingConditions, error) {
+	args := m.Called(ctx, plantID, countryID, languageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*types.GrowingConditions), args.Error(1)
+}
+
 // FindByGrowingConditions mocks the FindByGrowingConditions method
 func (m *MockPlantRepository) FindByGrowingConditions(ctx context.Context, filter *repository.GrowingConditionsFilter) ([]*entity.Plant, error) {
 	args := m.Called(ctx, filter)
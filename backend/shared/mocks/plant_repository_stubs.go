@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/plant-service/pkg/types"
+)
+
+// StubPlantRepository serves plants and their data from memory for testing.
+// Methods it does not implement panic through the nil embedded interface.
+type StubPlantRepository struct {
+	repository.PlantRepository
+	Plants          []*entity.Plant
+	Conditions      map[string]*types.GrowingConditions       // By plant ID
+	Characteristics map[string]*types.PhysicalCharacteristics // By plant ID
+	Err             error                                     // Returned by every lookup when set
+}
+
+// FindByID returns the stored plant or ErrPlantNotFound
+func (s *StubPlantRepository) FindByID(ctx context.Context, plantID, languageID string, countryID *string) (*entity.Plant, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	for _, plant := range s.Plants {
+		if plant.PlantID == plantID {
+			return plant, nil
+		}
+	}
+	return nil, entity.ErrPlantNotFound
+}
+
+// FindByIDs returns the stored plants among plantIDs, skipping unknown IDs
+func (s *StubPlantRepository) FindByIDs(ctx context.Context, plantIDs []string, languageID string, countryID *string) ([]*entity.Plant, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	var result []*entity.Plant
+	for _, id := range plantIDs {
+		for _, plant := range s.Plants {
+			if plant.PlantID == id {
+				result = append(result, plant)
+			}
+		}
+	}
+	return result, nil
+}
+
+// FindByGenus returns the stored plants of a genus
+func (s *StubPlantRepository) FindByGenus(ctx context.Context, genusName, languageID string, countryID *string, limit, offset int) ([]*entity.Plant, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	var result []*entity.Plant
+	for _, plant := range s.Plants {
+		if plant.GenusName == genusName {
+			result = append(result, plant)
+		}
+	}
+	return result, nil
+}
+
+// GetGrowingConditions returns the stored conditions, or nil like the database when there are none
+func (s *StubPlantRepository) GetGrowingConditions(ctx context.Context, plantID, countryID, languageID string) (*types.GrowingConditions, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	return s.Conditions[plantID], nil
+}
+
+// GetPhysicalCharacteristics returns the stored characteristics, or nil like the database when there are none
+func (s *StubPlantRepository) GetPhysicalCharacteristics(ctx context.Context, plantID, languageID string) (*types.PhysicalCharacteristics, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	return s.Characteristics[plantID], nil
+}
+
+// StubCountryRepository places every point in one country for testing
+type StubCountryRepository struct {
+	repository.CountryRepository
+	Country *entity.Country // Nil when points lie outside every country
+	Err     error           // Returned by every lookup when set
+}
+
+// FindByPoint returns the stored country or a not found error
+func (s *StubCountryRepository) FindByPoint(ctx context.Context, latitude, longitude float64) (*entity.Country, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	if s.Country == nil {
+		return nil, entity.NewNotFoundError("country", fmt.Sprintf("lat=%f, lng=%f", latitude, longitude))
+	}
+	return s.Country, nil
+}
@@ -0,0 +1,21 @@
+package mocks
+
+// StrPtr returns a pointer to s for building test fixtures
+func StrPtr(s string) *string {
+	return &s
+}
+
+// BoolPtr returns a pointer to b for building test fixtures
+func BoolPtr(b bool) *bool {
+	return &b
+}
+
+// IntPtr returns a pointer to i for building test fixtures
+func IntPtr(i int) *int {
+	return &i
+}
+
+// FloatPtr returns a pointer to f for building test fixtures
+func FloatPtr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,168 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaskType represents the kind of care a task asks for
+type TaskType string
+
+const (
+	TaskTypeWatering    TaskType = "watering"
+	TaskTypePruning     TaskType = "pruning"
+	TaskTypeFertilizing TaskType = "fertilizing"
+	TaskTypeHarvest     TaskType = "harvest"
+)
+
+// TaskStatus represents the lifecycle state of a task
+type TaskStatus string
+
+const (
+	TaskStatusPending   TaskStatus = "pending"
+	TaskStatusSnoozed   TaskStatus = "snoozed"
+	TaskStatusCompleted TaskStatus = "completed"
+	TaskStatusSkipped   TaskStatus = "skipped"
+)
+
+// Task represents a scheduled care task for a garden or a plant in it
+type Task struct {
+	TaskID        string   `json:"task_id"`
+	GardenID      string   `json:"garden_id"`
+	GardenPlantID *string  `json:"garden_plant_id,omitempty"` // NULL for garden-wide tasks
+	PlantID       *string  `json:"plant_id,omitempty"`
+	TaskType      TaskType `json:"task_type"`
+	Title         string   `json:"title"`
+	Description   *string  `json:"description,omitempty"`
+
+	// Scheduling
+	DueDate        time.Time `json:"due_date"`
+	RecurrenceDays *int      `json:"recurrence_days,omitempty"` // NULL = one-off task
+	ActiveMonths   []int     `json:"active_months,omitempty"`   // Months (1-12) the task recurs in; empty = all year
+
+	// Status tracking
+	Status      TaskStatus `json:"status"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CompletedBy *string    `json:"completed_by,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate validates the task entity
+func (t *Task) Validate() error {
+	if t.GardenID == "" {
+		return fmt.Errorf("garden_id is required")
+	}
+
+	if !t.TaskType.IsValid() {
+		return fmt.Errorf("invalid task_type: must be watering, pruning, fertilizing, or harvest")
+	}
+
+	if t.Title == "" {
+		return fmt.Errorf("title is required")
+	}
+
+	if len(t.Title) > 200 {
+		return fmt.Errorf("title must be 200 characters or less")
+	}
+
+	if t.DueDate.IsZero() {
+		return fmt.Errorf("due_date is required")
+	}
+
+	if t.RecurrenceDays != nil && *t.RecurrenceDays <= 0 {
+		return fmt.Errorf("recurrence_days must be positive")
+	}
+
+	for _, month := range t.ActiveMonths {
+		if month < 1 || month > 12 {
+			return fmt.Errorf("invalid active month: %d", month)
+		}
+	}
+
+	if !t.Status.IsValid() {
+		return fmt.Errorf("invalid status: must be pending, snoozed, completed, or skipped")
+	}
+
+	return nil
+}
+
+// IsOpen returns true if the task still needs doing
+func (t *Task) IsOpen() bool {
+	return t.Status == TaskStatusPending || t.Status == TaskStatusSnoozed
+}
+
+// IsRecurring returns true if the task repeats after completion
+func (t *Task) IsRecurring() bool {
+	return t.RecurrenceDays != nil
+}
+
+// NextOccurrence builds the next instance of a recurring task, due RecurrenceDays
+// after the given date and moved forward into the task's active months.
+// Returns nil for one-off tasks.
+func (t *Task) NextOccurrence(from time.Time) *Task {
+	if !t.IsRecurring() {
+		return nil
+	}
+
+	next := &Task{
+		GardenID:       t.GardenID,
+		GardenPlantID:  t.GardenPlantID,
+		PlantID:        t.PlantID,
+		TaskType:       t.TaskType,
+		Title:          t.Title,
+		Description:    t.Description,
+		DueDate:        NextActiveDate(from.AddDate(0, 0, *t.RecurrenceDays), t.ActiveMonths),
+		RecurrenceDays: t.RecurrenceDays,
+		ActiveMonths:   t.ActiveMonths,
+		Status:         TaskStatusPending,
+	}
+
+	return next
+}
+
+// NextActiveDate returns the date itself if its month is active, otherwise
+// the first day of the next active month. Empty months means always active.
+func NextActiveDate(date time.Time, activeMonths []int) time.Time {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	if len(activeMonths) == 0 {
+		return date
+	}
+
+	for i := 0; i < 12; i++ {
+		if containsMonth(activeMonths, int(date.Month())) {
+			return date
+		}
+		date = time.Date(date.Year(), date.Month()+1, 1, 0, 0, 0, 0, date.Location())
+	}
+
+	return date
+}
+
+// IsValid checks if the task type is valid
+func (tt TaskType) IsValid() bool {
+	switch tt {
+	case TaskTypeWatering, TaskTypePruning, TaskTypeFertilizing, TaskTypeHarvest:
+		return true
+	}
+	return false
+}
+
+// IsValid checks if the task status is valid
+func (ts TaskStatus) IsValid() bool {
+	switch ts {
+	case TaskStatusPending, TaskStatusSnoozed, TaskStatusCompleted, TaskStatusSkipped:
+		return true
+	}
+	return false
+}
+
+func containsMonth(months []int, month int) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
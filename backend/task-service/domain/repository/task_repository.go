@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"twigger-backend/backend/task-service/domain/entity"
+)
+
+// TaskRepository defines the interface for care task persistence
+type TaskRepository interface {
+	// CRUD operations
+	Create(ctx context.Context, task *entity.Task) error
+	FindByID(ctx context.Context, taskID string) (*entity.Task, error)
+	Update(ctx context.Context, task *entity.Task) error
+	Delete(ctx context.Context, taskID string) error
+
+	// Batch operations
+	BulkCreate(ctx context.Context, tasks []*entity.Task) error
+
+	// Query operations
+	FindByGardenID(ctx context.Context, gardenID string, filter *TaskFilter) ([]*entity.Task, error)
+}
+
+// TaskFilter represents optional criteria for listing tasks
+type TaskFilter struct {
+	Status        *entity.TaskStatus
+	TaskType      *entity.TaskType
+	GardenPlantID *string
+	DueBefore     *time.Time // Inclusive
+	OpenOnly      bool       // pending or snoozed
+
+	// Pagination
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/task-service/domain/entity"
+)
+
+// Recurrence intervals in days
+const (
+	harvestIntervalDays = 7
+	annualIntervalDays  = 365
+)
+
+// Watering interval by water needs; aquatic and bog plants are never watered
+var wateringIntervalDays = map[types.WaterNeeds]int{
+	types.WaterVeryDry:  14,
+	types.WaterDry:      10,
+	types.WaterModerate: 7,
+	types.WaterMoist:    3,
+	types.WaterWet:      2,
+}
+
+// Fertilizing interval by growth rate
+var fertilizingIntervalDays = map[types.GrowthRate]int{
+	types.GrowthVeryFast: 14,
+	types.GrowthFast:     14,
+	types.GrowthModerate: 30,
+	types.GrowthSlow:     60,
+	types.GrowthVerySlow: 60,
+}
+
+// Growing season months for the northern hemisphere (March-September)
+var northernGrowingSeason = []int{3, 4, 5, 6, 7, 8, 9}
+
+// PlantCareProfile holds the plant data care tasks are derived from
+type PlantCareProfile struct {
+	Name            string
+	PlantType       types.PlantType
+	Conditions      *types.GrowingConditions       // may be nil when no country data exists
+	Characteristics *types.PhysicalCharacteristics // may be nil
+}
+
+// generateCareTasks derives recurring watering, pruning, fertilizing and harvest
+// tasks for a garden plant. Tasks are due from today, moved into their active months.
+func generateCareTasks(gardenPlant *gardenEntity.GardenPlant, profile *PlantCareProfile, southernHemisphere bool, today time.Time) []*entity.Task {
+	growingSeason := seasonMonths(northernGrowingSeason, southernHemisphere)
+
+	var tasks []*entity.Task
+	newTask := func(taskType entity.TaskType, title, description string, intervalDays int, activeMonths []int) {
+		interval := intervalDays
+		desc := description
+		plantID := gardenPlant.PlantID
+		gardenPlantID := gardenPlant.GardenPlantID
+		tasks = append(tasks, &entity.Task{
+			GardenID:       gardenPlant.GardenID,
+			GardenPlantID:  &gardenPlantID,
+			PlantID:        &plantID,
+			TaskType:       taskType,
+			Title:          title,
+			Description:    &desc,
+			DueDate:        entity.NextActiveDate(today, activeMonths),
+			RecurrenceDays: &interval,
+			ActiveMonths:   activeMonths,
+			Status:         entity.TaskStatusPending,
+		})
+	}
+
+	// Watering
+	if interval, reason, ok := wateringSchedule(profile.Conditions); ok {
+		newTask(entity.TaskTypeWatering, "Water "+profile.Name,
+			fmt.Sprintf("Water every %d days during the growing season (%s)", interval, reason),
+			interval, growingSeason)
+	}
+
+	// Fertilizing
+	if interval, months, description, ok := fertilizingSchedule(profile, growingSeason); ok {
+		newTask(entity.TaskTypeFertilizing, "Fertilize "+profile.Name, description, interval, months)
+	}
+
+	// Pruning
+	if month, description, ok := pruningSchedule(profile, southernHemisphere); ok {
+		newTask(entity.TaskTypePruning, "Prune "+profile.Name, description, annualIntervalDays, []int{month})
+	}
+
+	// Harvest
+	if months, ok := harvestSchedule(profile); ok {
+		newTask(entity.TaskTypeHarvest, "Harvest "+profile.Name,
+			"Check for ripe fruit weekly while the plant is fruiting",
+			harvestIntervalDays, months)
+	}
+
+	return tasks
+}
+
+// wateringSchedule returns the watering interval derived from water needs
+func wateringSchedule(conditions *types.GrowingConditions) (int, string, bool) {
+	if conditions == nil || conditions.WaterNeeds == nil {
+		return wateringIntervalDays[types.WaterModerate], "default for unknown water needs", true
+	}
+
+	interval, ok := wateringIntervalDays[*conditions.WaterNeeds]
+	if !ok {
+		return 0, "", false // aquatic and bog plants
+	}
+
+	reason := fmt.Sprintf("%s water needs", *conditions.WaterNeeds)
+	if conditions.DroughtTolerant {
+		interval *= 2
+		reason += ", drought tolerant"
+	}
+
+	return interval, reason, true
+}
+
+// fertilizingSchedule returns the fertilizing interval derived from plant type and growth rate
+func fertilizingSchedule(profile *PlantCareProfile, growingSeason []int) (int, []int, string, bool) {
+	switch profile.PlantType {
+	case types.PlantTypeAquatic, types.PlantTypeSucculent:
+		return 0, nil, "", false
+	case types.PlantTypeTree, types.PlantTypePalm:
+		// Established trees need a single feed at the start of the season
+		return annualIntervalDays, growingSeason[:1], "Feed once at the start of the growing season", true
+	}
+
+	interval := fertilizingIntervalDays[types.GrowthModerate]
+	reason := "default for unknown growth rate"
+	if pc := profile.Characteristics; pc != nil && pc.GrowthRate != nil {
+		if days, ok := fertilizingIntervalDays[*pc.GrowthRate]; ok {
+			interval = days
+			reason = fmt.Sprintf("%s growth", *pc.GrowthRate)
+		}
+	}
+
+	return interval, growingSeason,
+		fmt.Sprintf("Feed every %d days during the growing season (%s)", interval, reason), true
+}
+
+// pruningSchedule picks the month after flowering ends, or late winter for woody plants
+func pruningSchedule(profile *PlantCareProfile, southernHemisphere bool) (int, string, bool) {
+	switch profile.PlantType {
+	case types.PlantTypeAnnual, types.PlantTypeBiennial, types.PlantTypeAquatic, types.PlantTypeOrchid:
+		return 0, "", false
+	}
+
+	if profile.Conditions != nil && len(profile.Conditions.FloweringMonths) > 0 {
+		if month, ok := monthAfterRun(profile.Conditions.FloweringMonths); ok {
+			return month, "Prune or deadhead once flowering has finished", true
+		}
+	}
+
+	switch profile.PlantType {
+	case types.PlantTypeTree, types.PlantTypeShrub, types.PlantTypeClimber, types.PlantTypeVine:
+		lateWinter := 2
+		if southernHemisphere {
+			lateWinter = 8
+		}
+		return lateWinter, "Prune in late winter while the plant is dormant", true
+	}
+
+	return 0, "", false
+}
+
+// harvestSchedule returns the fruiting months for plants with edible fruit
+func harvestSchedule(profile *PlantCareProfile) ([]int, bool) {
+	if profile.Conditions == nil || len(profile.Conditions.FruitingMonths) == 0 {
+		return nil, false
+	}
+
+	// Only skip when the fruit is explicitly marked inedible
+	if pc := profile.Characteristics; pc != nil {
+		if edible, ok := pc.Traits[types.TraitFruitEdible].(bool); ok && !edible {
+			return nil, false
+		}
+	}
+
+	return profile.Conditions.FruitingMonths, true
+}
+
+// monthAfterRun returns the month following the end of a (possibly year-wrapping) run of months
+func monthAfterRun(months []int) (int, bool) {
+	set := make(map[int]bool, len(months))
+	for _, m := range months {
+		set[m] = true
+	}
+
+	for _, m := range months {
+		next := m%12 + 1
+		if !set[next] {
+			return next, true
+		}
+	}
+
+	return 0, false // flowers all year
+}
+
+// seasonMonths shifts northern hemisphere months by six for the southern hemisphere
+func seasonMonths(northern []int, southernHemisphere bool) []int {
+	if !southernHemisphere {
+		return northern
+	}
+
+	shifted := make([]int, len(northern))
+	for i, m := range northern {
+		shifted[i] = (m+5)%12 + 1
+	}
+	return shifted
+}
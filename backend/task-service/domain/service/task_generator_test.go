@@ -0,0 +1,181 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/task-service/domain/entity"
+)
+
+func testGardenPlant() *gardenEntity.GardenPlant {
+	return &gardenEntity.GardenPlant{
+		GardenPlantID: "gp-123",
+		GardenID:      "garden-123",
+		PlantID:       "plant-123",
+	}
+}
+
+func tasksByType(tasks []*entity.Task) map[entity.TaskType]*entity.Task {
+	byType := make(map[entity.TaskType]*entity.Task, len(tasks))
+	for _, task := range tasks {
+		byType[task.TaskType] = task
+	}
+	return byType
+}
+
+func TestGenerateCareTasks_Shrub(t *testing.T) {
+	moist := types.WaterMoist
+	fast := types.GrowthFast
+	profile := &PlantCareProfile{
+		Name:      "Lavender",
+		PlantType: types.PlantTypeShrub,
+		Conditions: &types.GrowingConditions{
+			WaterNeeds:      &moist,
+			FloweringMonths: []int{6, 7, 8},
+		},
+		Characteristics: &types.PhysicalCharacteristics{GrowthRate: &fast},
+	}
+	today := time.Date(2025, 5, 10, 9, 30, 0, 0, time.UTC)
+
+	tasks := tasksByType(generateCareTasks(testGardenPlant(), profile, false, today))
+
+	require.Len(t, tasks, 3)
+
+	watering := tasks[entity.TaskTypeWatering]
+	require.NotNil(t, watering)
+	assert.Equal(t, "Water Lavender", watering.Title)
+	assert.Equal(t, 3, *watering.RecurrenceDays)
+	assert.Equal(t, northernGrowingSeason, watering.ActiveMonths)
+	assert.Equal(t, time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC), watering.DueDate)
+	assert.Equal(t, "gp-123", *watering.GardenPlantID)
+	assert.Equal(t, entity.TaskStatusPending, watering.Status)
+	assert.NoError(t, watering.Validate())
+
+	fertilizing := tasks[entity.TaskTypeFertilizing]
+	require.NotNil(t, fertilizing)
+	assert.Equal(t, 14, *fertilizing.RecurrenceDays)
+
+	// Pruned the month after flowering ends
+	pruning := tasks[entity.TaskTypePruning]
+	require.NotNil(t, pruning)
+	assert.Equal(t, []int{9}, pruning.ActiveMonths)
+	assert.Equal(t, time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), pruning.DueDate)
+
+	assert.Nil(t, tasks[entity.TaskTypeHarvest])
+}
+
+func TestGenerateCareTasks_DroughtTolerantDoublesInterval(t *testing.T) {
+	dry := types.WaterDry
+	profile := &PlantCareProfile{
+		Name:       "Rosemary",
+		PlantType:  types.PlantTypePerennial,
+		Conditions: &types.GrowingConditions{WaterNeeds: &dry, DroughtTolerant: true},
+	}
+
+	tasks := tasksByType(generateCareTasks(testGardenPlant(), profile, false, time.Now()))
+
+	require.NotNil(t, tasks[entity.TaskTypeWatering])
+	assert.Equal(t, 20, *tasks[entity.TaskTypeWatering].RecurrenceDays)
+}
+
+func TestGenerateCareTasks_AquaticPlant(t *testing.T) {
+	aquatic := types.WaterAquatic
+	profile := &PlantCareProfile{
+		Name:       "Water Lily",
+		PlantType:  types.PlantTypeAquatic,
+		Conditions: &types.GrowingConditions{WaterNeeds: &aquatic, FloweringMonths: []int{6, 7}},
+	}
+
+	tasks := generateCareTasks(testGardenPlant(), profile, false, time.Now())
+
+	assert.Empty(t, tasks)
+}
+
+func TestGenerateCareTasks_Harvest(t *testing.T) {
+	tests := []struct {
+		name        string
+		traits      map[string]interface{}
+		wantHarvest bool
+	}{
+		{name: "edible fruit", traits: map[string]interface{}{types.TraitFruitEdible: true}, wantHarvest: true},
+		{name: "unknown edibility", traits: nil, wantHarvest: true},
+		{name: "inedible fruit", traits: map[string]interface{}{types.TraitFruitEdible: false}, wantHarvest: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &PlantCareProfile{
+				Name:            "Tomato",
+				PlantType:       types.PlantTypeAnnual,
+				Conditions:      &types.GrowingConditions{FruitingMonths: []int{7, 8, 9}},
+				Characteristics: &types.PhysicalCharacteristics{Traits: tt.traits},
+			}
+
+			tasks := tasksByType(generateCareTasks(testGardenPlant(), profile, false, time.Now()))
+
+			harvest, ok := tasks[entity.TaskTypeHarvest]
+			assert.Equal(t, tt.wantHarvest, ok)
+			if ok {
+				assert.Equal(t, []int{7, 8, 9}, harvest.ActiveMonths)
+				assert.Equal(t, harvestIntervalDays, *harvest.RecurrenceDays)
+			}
+			// Annuals are never pruned
+			assert.Nil(t, tasks[entity.TaskTypePruning])
+		})
+	}
+}
+
+func TestGenerateCareTasks_SouthernHemisphere(t *testing.T) {
+	profile := &PlantCareProfile{
+		Name:      "Apple",
+		PlantType: types.PlantTypeTree,
+	}
+	today := time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	tasks := tasksByType(generateCareTasks(testGardenPlant(), profile, true, today))
+
+	// Growing season shifts to September-March
+	watering := tasks[entity.TaskTypeWatering]
+	require.NotNil(t, watering)
+	assert.Equal(t, []int{9, 10, 11, 12, 1, 2, 3}, watering.ActiveMonths)
+	assert.Equal(t, time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), watering.DueDate)
+
+	// Trees get a single feed at the start of the season
+	fertilizing := tasks[entity.TaskTypeFertilizing]
+	require.NotNil(t, fertilizing)
+	assert.Equal(t, []int{9}, fertilizing.ActiveMonths)
+	assert.Equal(t, annualIntervalDays, *fertilizing.RecurrenceDays)
+
+	// Dormant pruning in the southern late winter
+	pruning := tasks[entity.TaskTypePruning]
+	require.NotNil(t, pruning)
+	assert.Equal(t, []int{8}, pruning.ActiveMonths)
+}
+
+func TestMonthAfterRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		months []int
+		want   int
+		wantOK bool
+	}{
+		{name: "summer run", months: []int{6, 7, 8}, want: 9, wantOK: true},
+		{name: "wraps year end", months: []int{11, 12, 1}, want: 2, wantOK: true},
+		{name: "single month", months: []int{12}, want: 1, wantOK: true},
+		{name: "all year", months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := monthAfterRun(tt.months)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gardenRepository "twigger-backend/backend/garden-service/domain/repository"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	plantRepository "twigger-backend/backend/plant-service/domain/repository"
+	"twigger-backend/backend/shared/constants"
+	"twigger-backend/backend/shared/domainerrors"
+	"twigger-backend/backend/task-service/domain/entity"
+	"twigger-backend/backend/task-service/domain/repository"
+)
+
+// maxSnoozeDays limits how far a task can be pushed back
+const maxSnoozeDays = 90
+
+// TaskService defines the business logic for garden care tasks
+type TaskService interface {
+	// GenerateGardenTasks creates care tasks for active plants that don't have one yet
+	GenerateGardenTasks(ctx context.Context, gardenID string) (*GenerationResult, error)
+
+	// ListGardenTasks retrieves a garden's tasks with optional filtering
+	ListGardenTasks(ctx context.Context, gardenID string, filter *repository.TaskFilter) ([]*entity.Task, error)
+
+	// GetTask retrieves a task by ID
+	GetTask(ctx context.Context, taskID string) (*entity.Task, error)
+
+	// CompleteTask marks a task done and schedules its next occurrence
+	CompleteTask(ctx context.Context, taskID, userID string) (*TaskActionResult, error)
+
+	// SnoozeTask postpones a task until the given date
+	SnoozeTask(ctx context.Context, taskID string, until time.Time) (*TaskActionResult, error)
+
+	// SkipTask skips this occurrence and schedules the next one
+	SkipTask(ctx context.Context, taskID string) (*TaskActionResult, error)
+}
+
+// GenerationResult summarises a task generation run
+type GenerationResult struct {
+	Created        []*entity.Task `json:"created"`
+	PlantsScanned  int            `json:"plants_scanned"`
+	CancelledTasks int            `json:"cancelled_tasks"` // open tasks for plants no longer in the garden
+}
+
+// TaskActionResult holds the updated task and the next occurrence, if any
+type TaskActionResult struct {
+	Task     *entity.Task `json:"task"`
+	NextTask *entity.Task `json:"next_task,omitempty"`
+}
+
+// taskService implements TaskService
+type taskService struct {
+	taskRepo        repository.TaskRepository
+	gardenRepo      gardenRepository.GardenRepository
+	gardenPlantRepo gardenRepository.GardenPlantRepository
+	plantRepo       plantRepository.PlantRepository
+	countryRepo     plantRepository.CountryRepository
+	now             func() time.Time
+}
+
+// NewTaskService creates a new task service instance
+func NewTaskService(
+	taskRepo repository.TaskRepository,
+	gardenRepo gardenRepository.GardenRepository,
+	gardenPlantRepo gardenRepository.GardenPlantRepository,
+	plantRepo plantRepository.PlantRepository,
+	countryRepo plantRepository.CountryRepository,
+) TaskService {
+	return &taskService{
+		taskRepo:        taskRepo,
+		gardenRepo:      gardenRepo,
+		gardenPlantRepo: gardenPlantRepo,
+		plantRepo:       plantRepo,
+		countryRepo:     countryRepo,
+		now:             time.Now,
+	}
+}
+
+// GenerateGardenTasks creates care tasks for active plants that don't have one yet
+func (s *taskService) GenerateGardenTasks(ctx context.Context, gardenID string) (*GenerationResult, error) {
+	if gardenID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	plants, err := s.gardenPlantRepo.FindActiveInGarden(ctx, gardenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get garden plants: %w", err)
+	}
+
+	openTasks, err := s.taskRepo.FindByGardenID(ctx, gardenID, &repository.TaskFilter{OpenOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open tasks: %w", err)
+	}
+
+	result := &GenerationResult{Created: []*entity.Task{}, PlantsScanned: len(plants)}
+
+	// Index open tasks so regeneration is idempotent
+	activePlants := make(map[string]bool, len(plants))
+	for _, gp := range plants {
+		activePlants[gp.GardenPlantID] = true
+	}
+	existing := make(map[string]bool, len(openTasks))
+	for _, task := range openTasks {
+		if task.GardenPlantID == nil {
+			continue
+		}
+		if !activePlants[*task.GardenPlantID] {
+			// The plant was removed; its remaining tasks no longer apply
+			task.Status = entity.TaskStatusSkipped
+			if err := s.taskRepo.Update(ctx, task); err != nil {
+				return nil, fmt.Errorf("failed to cancel task %s: %w", task.TaskID, err)
+			}
+			result.CancelledTasks++
+			continue
+		}
+		existing[taskKey(*task.GardenPlantID, task.TaskType)] = true
+	}
+
+	if len(plants) == 0 {
+		return result, nil
+	}
+
+	southern, countryID, err := s.gardenLocation(ctx, gardenID)
+	if err != nil {
+		return nil, err
+	}
+	today := s.now()
+	profiles := make(map[string]*PlantCareProfile)
+
+	for _, gp := range plants {
+		profile, ok := profiles[gp.PlantID]
+		if !ok {
+			profile, err = s.loadCareProfile(ctx, gp.PlantID, countryID)
+			if err != nil {
+				return nil, err
+			}
+			profiles[gp.PlantID] = profile
+		}
+
+		for _, task := range generateCareTasks(gp, profile, southern, today) {
+			if existing[taskKey(gp.GardenPlantID, task.TaskType)] {
+				continue
+			}
+			result.Created = append(result.Created, task)
+		}
+	}
+
+	if len(result.Created) > 0 {
+		if err := s.taskRepo.BulkCreate(ctx, result.Created); err != nil {
+			return nil, fmt.Errorf("failed to create tasks: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// ListGardenTasks retrieves a garden's tasks with optional filtering
+func (s *taskService) ListGardenTasks(ctx context.Context, gardenID string, filter *repository.TaskFilter) ([]*entity.Task, error) {
+	if gardenID == "" {
+		return nil, domainerrors.NewInvalidInputError("garden_id", "garden ID cannot be empty")
+	}
+
+	if filter == nil {
+		filter = &repository.TaskFilter{}
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 50 // Default limit
+	}
+	if filter.Limit > 200 {
+		filter.Limit = 200 // Maximum limit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	tasks, err := s.taskRepo.FindByGardenID(ctx, gardenID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// GetTask retrieves a task by ID
+func (s *taskService) GetTask(ctx context.Context, taskID string) (*entity.Task, error) {
+	if taskID == "" {
+		return nil, domainerrors.NewInvalidInputError("task_id", "task ID cannot be empty")
+	}
+
+	task, err := s.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	return task, nil
+}
+
+// CompleteTask marks a task done and schedules its next occurrence
+func (s *taskService) CompleteTask(ctx context.Context, taskID, userID string) (*TaskActionResult, error) {
+	task, err := s.getOpenTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	task.Status = entity.TaskStatusCompleted
+	task.CompletedAt = &now
+	if userID != "" {
+		task.CompletedBy = &userID
+	}
+
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to complete task: %w", err)
+	}
+
+	// Recurrence counts from when the work was actually done
+	return s.scheduleNext(ctx, task, now)
+}
+
+// SnoozeTask postpones a task until the given date
+func (s *taskService) SnoozeTask(ctx context.Context, taskID string, until time.Time) (*TaskActionResult, error) {
+	task, err := s.getOpenTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if !until.After(now) {
+		return nil, domainerrors.NewValidationError("until", "snooze date must be in the future")
+	}
+	if until.After(now.AddDate(0, 0, maxSnoozeDays)) {
+		return nil, domainerrors.NewValidationError("until", fmt.Sprintf("tasks can be snoozed for at most %d days", maxSnoozeDays))
+	}
+
+	task.Status = entity.TaskStatusSnoozed
+	task.DueDate = until
+
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to snooze task: %w", err)
+	}
+
+	return &TaskActionResult{Task: task}, nil
+}
+
+// SkipTask skips this occurrence and schedules the next one
+func (s *taskService) SkipTask(ctx context.Context, taskID string) (*TaskActionResult, error) {
+	task, err := s.getOpenTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Status = entity.TaskStatusSkipped
+
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to skip task: %w", err)
+	}
+
+	// A skipped occurrence keeps the original cadence
+	return s.scheduleNext(ctx, task, task.DueDate)
+}
+
+// getOpenTask loads a task and ensures it can still be acted on
+func (s *taskService) getOpenTask(ctx context.Context, taskID string) (*entity.Task, error) {
+	task, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !task.IsOpen() {
+		return nil, domainerrors.NewValidationError("status", fmt.Sprintf("task is already %s", task.Status))
+	}
+
+	return task, nil
+}
+
+// scheduleNext creates the next occurrence of a recurring task
+func (s *taskService) scheduleNext(ctx context.Context, task *entity.Task, from time.Time) (*TaskActionResult, error) {
+	result := &TaskActionResult{Task: task}
+
+	next := task.NextOccurrence(from)
+	if next == nil {
+		return result, nil
+	}
+
+	if err := s.taskRepo.Create(ctx, next); err != nil {
+		return nil, fmt.Errorf("failed to schedule next task: %w", err)
+	}
+
+	result.NextTask = next
+	return result, nil
+}
+
+// gardenLocation returns the garden's hemisphere and country for growing conditions.
+// Gardens without a location default to the northern hemisphere and no country;
+// failed lookups are returned rather than treated as unknown.
+func (s *taskService) gardenLocation(ctx context.Context, gardenID string) (bool, *string, error) {
+	garden, err := s.gardenRepo.FindByID(ctx, gardenID)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get garden: %w", err)
+	}
+	if garden.BoundaryGeoJSON == nil {
+		return false, nil, nil
+	}
+
+	lat, lng, err := s.gardenRepo.GetCenterPoint(ctx, gardenID)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to locate garden: %w", err)
+	}
+
+	southern := lat < 0
+
+	country, err := s.countryRepo.FindByPoint(ctx, lat, lng)
+	if err != nil {
+		if isNotFound(err) {
+			return southern, nil, nil
+		}
+		return false, nil, fmt.Errorf("failed to find garden country: %w", err)
+	}
+
+	return southern, &country.CountryID, nil
+}
+
+// loadCareProfile gathers the plant data needed by the task generator
+func (s *taskService) loadCareProfile(ctx context.Context, plantID string, countryID *string) (*PlantCareProfile, error) {
+	plant, err := s.plantRepo.FindByID(ctx, plantID, constants.EnglishLanguageID, countryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plant %s: %w", plantID, err)
+	}
+
+	profile := &PlantCareProfile{
+		Name:      plant.FullBotanicalName,
+		PlantType: plant.PlantType,
+	}
+	if len(plant.CommonNames) > 0 {
+		profile.Name = plant.CommonNames[0]
+	}
+
+	// Missing conditions or characteristics fall back to generator defaults
+	if countryID != nil {
+		gc, err := s.plantRepo.GetGrowingConditions(ctx, plantID, *countryID, constants.EnglishLanguageID)
+		if err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("failed to get growing conditions for plant %s: %w", plantID, err)
+		}
+		profile.Conditions = gc
+	}
+	pc, err := s.plantRepo.GetPhysicalCharacteristics(ctx, plantID, constants.EnglishLanguageID)
+	if err != nil && !isNotFound(err) {
+		return nil, fmt.Errorf("failed to get physical characteristics for plant %s: %w", plantID, err)
+	}
+	profile.Characteristics = pc
+
+	return profile, nil
+}
+
+// isNotFound reports whether a plant data lookup found nothing
+func isNotFound(err error) bool {
+	var notFound *plantEntity.NotFoundError
+	return errors.As(err, &notFound)
+}
+
+// taskKey identifies one kind of task for one garden plant
+func taskKey(gardenPlantID string, taskType entity.TaskType) string {
+	return gardenPlantID + ":" + string(taskType)
+}
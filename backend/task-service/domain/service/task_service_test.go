@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	gardenEntity "twigger-backend/backend/garden-service/domain/entity"
+	plantEntity "twigger-backend/backend/plant-service/domain/entity"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/backend/shared/domainerrors"
+	"twigger-backend/backend/shared/mocks"
+	"twigger-backend/backend/task-service/domain/entity"
+	"twigger-backend/backend/task-service/domain/repository"
+)
+
+// MockTaskRepository is a mock implementation of repository.TaskRepository
+type MockTaskRepository struct {
+	mock.Mock
+}
+
+func (m *MockTaskRepository) Create(ctx context.Context, task *entity.Task) error {
+	args := m.Called(ctx, task)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) FindByID(ctx context.Context, taskID string) (*entity.Task, error) {
+	args := m.Called(ctx, taskID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Update(ctx context.Context, task *entity.Task) error {
+	args := m.Called(ctx, task)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) Delete(ctx context.Context, taskID string) error {
+	args := m.Called(ctx, taskID)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) BulkCreate(ctx context.Context, tasks []*entity.Task) error {
+	args := m.Called(ctx, tasks)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) FindByGardenID(ctx context.Context, gardenID string, filter *repository.TaskFilter) ([]*entity.Task, error) {
+	args := m.Called(ctx, gardenID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Task), args.Error(1)
+}
+
+var testNow = time.Date(2025, 6, 10, 8, 0, 0, 0, time.UTC)
+
+func newTestTaskService(taskRepo *MockTaskRepository) *taskService {
+	return &taskService{
+		taskRepo: taskRepo,
+		now:      func() time.Time { return testNow },
+	}
+}
+
+func testWateringTask() *entity.Task {
+	interval := 7
+	gardenPlantID := "gp-123"
+	return &entity.Task{
+		TaskID:         "task-123",
+		GardenID:       "garden-123",
+		GardenPlantID:  &gardenPlantID,
+		TaskType:       entity.TaskTypeWatering,
+		Title:          "Water Tomato",
+		DueDate:        time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC),
+		RecurrenceDays: &interval,
+		ActiveMonths:   []int{3, 4, 5, 6, 7, 8, 9},
+		Status:         entity.TaskStatusPending,
+	}
+}
+
+func TestTaskService_CompleteTask_SchedulesNext(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	service := newTestTaskService(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.On("FindByID", ctx, "task-123").Return(testWateringTask(), nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Task")).Return(nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Task")).Return(nil)
+
+	result, err := service.CompleteTask(ctx, "task-123", "user-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.TaskStatusCompleted, result.Task.Status)
+	assert.Equal(t, testNow, *result.Task.CompletedAt)
+	assert.Equal(t, "user-123", *result.Task.CompletedBy)
+
+	// Next occurrence counts from completion, not the original due date
+	require.NotNil(t, result.NextTask)
+	assert.Equal(t, entity.TaskStatusPending, result.NextTask.Status)
+	assert.Equal(t, time.Date(2025, 6, 17, 0, 0, 0, 0, time.UTC), result.NextTask.DueDate)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTaskService_CompleteTask_NextOccurrenceOutOfSeason(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	service := newTestTaskService(mockRepo)
+	service.now = func() time.Time { return time.Date(2025, 9, 28, 0, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	mockRepo.On("FindByID", ctx, "task-123").Return(testWateringTask(), nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Task")).Return(nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Task")).Return(nil)
+
+	result, err := service.CompleteTask(ctx, "task-123", "user-123")
+
+	// October is outside the active months, so the task waits until March
+	require.NoError(t, err)
+	require.NotNil(t, result.NextTask)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), result.NextTask.DueDate)
+}
+
+func TestTaskService_CompleteTask_AlreadyCompleted(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	service := newTestTaskService(mockRepo)
+	ctx := context.Background()
+
+	task := testWateringTask()
+	task.Status = entity.TaskStatusCompleted
+	mockRepo.On("FindByID", ctx, "task-123").Return(task, nil)
+
+	_, err := service.CompleteTask(ctx, "task-123", "user-123")
+
+	assert.Error(t, err)
+	assert.IsType(t, &domainerrors.ValidationError{}, err)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestTaskService_CompleteTask_OneOff(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	service := newTestTaskService(mockRepo)
+	ctx := context.Background()
+
+	task := testWateringTask()
+	task.RecurrenceDays = nil
+	mockRepo.On("FindByID", ctx, "task-123").Return(task, nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Task")).Return(nil)
+
+	result, err := service.CompleteTask(ctx, "task-123", "user-123")
+
+	require.NoError(t, err)
+	assert.Nil(t, result.NextTask)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestTaskService_SkipTask_KeepsCadence(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	service := newTestTaskService(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.On("FindByID", ctx, "task-123").Return(testWateringTask(), nil)
+	mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Task")).Return(nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*entity.Task")).Return(nil)
+
+	result, err := service.SkipTask(ctx, "task-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.TaskStatusSkipped, result.Task.Status)
+	assert.Nil(t, result.Task.CompletedAt)
+	require.NotNil(t, result.NextTask)
+	assert.Equal(t, time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC), result.NextTask.DueDate)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTaskService_SnoozeTask(t *testing.T) {
+	tests := []struct {
+		name    string
+		until   time.Time
+		wantErr bool
+	}{
+		{name: "valid", until: testNow.AddDate(0, 0, 3), wantErr: false},
+		{name: "in the past", until: testNow.AddDate(0, 0, -1), wantErr: true},
+		{name: "too far ahead", until: testNow.AddDate(0, 0, maxSnoozeDays+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			service := newTestTaskService(mockRepo)
+			ctx := context.Background()
+
+			mockRepo.On("FindByID", ctx, "task-123").Return(testWateringTask(), nil)
+			mockRepo.On("Update", ctx, mock.AnythingOfType("*entity.Task")).Return(nil)
+
+			result, err := service.SnoozeTask(ctx, "task-123", tt.until)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entity.TaskStatusSnoozed, result.Task.Status)
+			assert.Equal(t, tt.until, result.Task.DueDate)
+			assert.Nil(t, result.NextTask)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestTaskService_ListGardenTasks_DefaultsLimit(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	service := newTestTaskService(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.On("FindByGardenID", ctx, "garden-123", mock.MatchedBy(func(f *repository.TaskFilter) bool {
+		return f.Limit == 50 && f.Offset == 0
+	})).Return([]*entity.Task{testWateringTask()}, nil)
+
+	tasks, err := service.ListGardenTasks(ctx, "garden-123", nil)
+
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1)
+	mockRepo.AssertExpectations(t)
+}
+
+// failingConditionsPlantRepository fails growing condition lookups as a broken database would
+type failingConditionsPlantRepository struct {
+	mocks.StubPlantRepository
+}
+
+func (s *failingConditionsPlantRepository) GetGrowingConditions(ctx context.Context, plantID, countryID, languageID string) (*types.GrowingConditions, error) {
+	return nil, errors.New("connection refused")
+}
+
+// setupGenerationService serves one tomato planted in garden-123, located at center
+func setupGenerationService(center []float64) (*taskService, *MockTaskRepository) {
+	taskRepo := new(MockTaskRepository)
+	taskRepo.On("FindByGardenID", mock.Anything, "garden-123", mock.Anything).Return([]*entity.Task{}, nil)
+	taskRepo.On("BulkCreate", mock.Anything, mock.Anything).Return(nil)
+
+	service := newTestTaskService(taskRepo)
+	service.gardenRepo = mocks.NewStubGardenRepository(&gardenEntity.Garden{GardenID: "garden-123"}, center)
+	service.gardenPlantRepo = &mocks.StubGardenPlantRepository{Plants: []*gardenEntity.GardenPlant{
+		{GardenPlantID: "gp-123", GardenID: "garden-123", PlantID: "plant-tomato"},
+	}}
+	service.plantRepo = &mocks.StubPlantRepository{Plants: []*plantEntity.Plant{
+		{PlantID: "plant-tomato", FullBotanicalName: "Solanum lycopersicum", CommonNames: []string{"Tomato"}},
+	}}
+	service.countryRepo = &mocks.StubCountryRepository{Country: &plantEntity.Country{CountryID: "country-au"}}
+	return service, taskRepo
+}
+
+func TestTaskService_GenerateGardenTasks_Location(t *testing.T) {
+	t.Run("southern garden shifts the growing season", func(t *testing.T) {
+		service, _ := setupGenerationService([]float64{-33.87, 151.21})
+
+		result, err := service.GenerateGardenTasks(context.Background(), "garden-123")
+
+		require.NoError(t, err)
+		require.NotEmpty(t, result.Created)
+		assert.Contains(t, result.Created[0].ActiveMonths, 12)
+	})
+
+	t.Run("garden without a boundary defaults to the north", func(t *testing.T) {
+		service, _ := setupGenerationService(nil)
+
+		result, err := service.GenerateGardenTasks(context.Background(), "garden-123")
+
+		require.NoError(t, err)
+		require.NotEmpty(t, result.Created)
+		assert.NotContains(t, result.Created[0].ActiveMonths, 12)
+	})
+
+	t.Run("garden outside every country has no country", func(t *testing.T) {
+		service, _ := setupGenerationService([]float64{-33.87, 151.21})
+		service.countryRepo = &mocks.StubCountryRepository{}
+
+		result, err := service.GenerateGardenTasks(context.Background(), "garden-123")
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.Created)
+	})
+}
+
+func TestTaskService_GenerateGardenTasks_LookupErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		breakIt func(s *taskService)
+		wantErr string
+	}{
+		{
+			name: "garden lookup fails",
+			breakIt: func(s *taskService) {
+				s.gardenRepo.(*mocks.StubGardenRepository).Err = errors.New("connection refused")
+			},
+			wantErr: "failed to get garden",
+		},
+		{
+			name: "centre lookup fails",
+			breakIt: func(s *taskService) {
+				delete(s.gardenRepo.(*mocks.StubGardenRepository).Centers, "garden-123")
+			},
+			wantErr: "failed to locate garden",
+		},
+		{
+			name: "country lookup fails",
+			breakIt: func(s *taskService) {
+				s.countryRepo = &mocks.StubCountryRepository{Err: errors.New("connection refused")}
+			},
+			wantErr: "failed to find garden country",
+		},
+		{
+			name: "growing conditions lookup fails",
+			breakIt: func(s *taskService) {
+				s.plantRepo = &failingConditionsPlantRepository{StubPlantRepository: *s.plantRepo.(*mocks.StubPlantRepository)}
+			},
+			wantErr: "failed to get growing conditions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, taskRepo := setupGenerationService([]float64{-33.87, 151.21})
+			tt.breakIt(service)
+
+			result, err := service.GenerateGardenTasks(context.Background(), "garden-123")
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			taskRepo.AssertNotCalled(t, "BulkCreate", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"twigger-backend/backend/shared/domainerrors"
+	"twigger-backend/backend/task-service/domain/entity"
+	"twigger-backend/backend/task-service/domain/repository"
+)
+
+// PostgresTaskRepository implements repository.TaskRepository using PostgreSQL
+type PostgresTaskRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresTaskRepository creates a new PostgreSQL task repository
+func NewPostgresTaskRepository(db *sql.DB) repository.TaskRepository {
+	return &PostgresTaskRepository{db: db}
+}
+
+const taskColumns = `
+	task_id, garden_id, garden_plant_id, plant_id, task_type,
+	title, description, due_date, recurrence_days, active_months,
+	status, completed_at, completed_by, created_at, updated_at
+`
+
+const insertTaskQuery = `
+	INSERT INTO garden_tasks (
+		task_id, garden_id, garden_plant_id, plant_id, task_type,
+		title, description, due_date, recurrence_days, active_months,
+		status, completed_at, completed_by, created_at, updated_at
+	) VALUES (
+		$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+	)
+`
+
+// Create creates a new task
+func (r *PostgresTaskRepository) Create(ctx context.Context, task *entity.Task) error {
+	if err := prepareTaskInsert(task, time.Now()); err != nil {
+		return err
+	}
+
+	_, err := r.db.ExecContext(ctx, insertTaskQuery, taskInsertArgs(task)...)
+	if err != nil {
+		return domainerrors.NewDatabaseError("task_create", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a task by ID
+func (r *PostgresTaskRepository) FindByID(ctx context.Context, taskID string) (*entity.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM garden_tasks WHERE task_id = $1`
+
+	task, err := scanTask(r.db.QueryRowContext(ctx, query, taskID))
+	if err == sql.ErrNoRows {
+		return nil, domainerrors.NewNotFoundError("task", taskID)
+	}
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("task_find_by_id", err)
+	}
+
+	return task, nil
+}
+
+// Update updates a task's schedule and status
+func (r *PostgresTaskRepository) Update(ctx context.Context, task *entity.Task) error {
+	if err := task.Validate(); err != nil {
+		return domainerrors.NewValidationError("task", err.Error())
+	}
+
+	task.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE garden_tasks SET
+			title = $2,
+			description = $3,
+			due_date = $4,
+			recurrence_days = $5,
+			active_months = $6,
+			status = $7,
+			completed_at = $8,
+			completed_by = $9,
+			updated_at = $10
+		WHERE task_id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		task.TaskID,
+		task.Title,
+		task.Description,
+		task.DueDate,
+		task.RecurrenceDays,
+		pq.Array(task.ActiveMonths),
+		task.Status,
+		task.CompletedAt,
+		task.CompletedBy,
+		task.UpdatedAt,
+	)
+	if err != nil {
+		return domainerrors.NewDatabaseError("task_update", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainerrors.NewDatabaseError("task_update_rows_affected", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainerrors.NewNotFoundError("task", task.TaskID)
+	}
+
+	return nil
+}
+
+// Delete deletes a task
+func (r *PostgresTaskRepository) Delete(ctx context.Context, taskID string) error {
+	query := `DELETE FROM garden_tasks WHERE task_id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, taskID)
+	if err != nil {
+		return domainerrors.NewDatabaseError("task_delete", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainerrors.NewDatabaseError("task_delete_rows_affected", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainerrors.NewNotFoundError("task", taskID)
+	}
+
+	return nil
+}
+
+// BulkCreate creates multiple tasks in a single transaction
+func (r *PostgresTaskRepository) BulkCreate(ctx context.Context, tasks []*entity.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domainerrors.NewDatabaseError("task_bulk_create_begin_transaction", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, insertTaskQuery)
+	if err != nil {
+		_ = tx.Rollback()
+		return domainerrors.NewDatabaseError("task_bulk_create_prepare", err)
+	}
+	defer stmt.Close()
+
+	now := time.Now()
+	for _, task := range tasks {
+		if err := prepareTaskInsert(task, now); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+
+		if _, err := stmt.ExecContext(ctx, taskInsertArgs(task)...); err != nil {
+			_ = tx.Rollback()
+			return domainerrors.NewDatabaseError("task_bulk_create_exec", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domainerrors.NewDatabaseError("task_bulk_create_commit", err)
+	}
+
+	return nil
+}
+
+// FindByGardenID finds tasks in a garden, ordered by due date
+func (r *PostgresTaskRepository) FindByGardenID(ctx context.Context, gardenID string, filter *repository.TaskFilter) ([]*entity.Task, error) {
+	whereClauses := []string{"garden_id = $1"}
+	args := []interface{}{gardenID}
+	argPos := 2
+
+	if filter == nil {
+		filter = &repository.TaskFilter{}
+	}
+
+	if filter.Status != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("status = $%d", argPos))
+		args = append(args, *filter.Status)
+		argPos++
+	}
+	if filter.OpenOnly {
+		whereClauses = append(whereClauses, "status IN ('pending', 'snoozed')")
+	}
+	if filter.TaskType != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("task_type = $%d", argPos))
+		args = append(args, *filter.TaskType)
+		argPos++
+	}
+	if filter.GardenPlantID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("garden_plant_id = $%d", argPos))
+		args = append(args, *filter.GardenPlantID)
+		argPos++
+	}
+	if filter.DueBefore != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("due_date <= $%d", argPos))
+		args = append(args, *filter.DueBefore)
+		argPos++
+	}
+
+	query := `SELECT ` + taskColumns + ` FROM garden_tasks WHERE ` +
+		strings.Join(whereClauses, " AND ") +
+		` ORDER BY due_date ASC, task_type ASC, task_id ASC`
+
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argPos, argPos+1)
+		args = append(args, filter.Limit, filter.Offset)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, domainerrors.NewDatabaseError("task_find_by_garden", err)
+	}
+	defer rows.Close()
+
+	tasks := []*entity.Task{}
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, domainerrors.NewDatabaseError("task_scan", err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domainerrors.NewDatabaseError("task_rows_iteration", err)
+	}
+
+	return tasks, nil
+}
+
+// prepareTaskInsert validates a new task and fills in its ID and timestamps
+func prepareTaskInsert(task *entity.Task, now time.Time) error {
+	if task.Status == "" {
+		task.Status = entity.TaskStatusPending
+	}
+
+	if err := task.Validate(); err != nil {
+		return domainerrors.NewValidationError("task", err.Error())
+	}
+
+	if task.TaskID == "" {
+		task.TaskID = uuid.New().String()
+	}
+
+	task.CreatedAt = now
+	task.UpdatedAt = now
+
+	return nil
+}
+
+// taskInsertArgs returns the arguments for insertTaskQuery
+func taskInsertArgs(task *entity.Task) []interface{} {
+	return []interface{}{
+		task.TaskID,
+		task.GardenID,
+		task.GardenPlantID,
+		task.PlantID,
+		task.TaskType,
+		task.Title,
+		task.Description,
+		task.DueDate,
+		task.RecurrenceDays,
+		pq.Array(task.ActiveMonths),
+		task.Status,
+		task.CompletedAt,
+		task.CompletedBy,
+		task.CreatedAt,
+		task.UpdatedAt,
+	}
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanTask scans a single task row, mapping nullable columns
+func scanTask(row rowScanner) (*entity.Task, error) {
+	var task entity.Task
+	var gardenPlantID, plantID, description, completedBy sql.NullString
+	var recurrenceDays sql.NullInt64
+	var completedAt sql.NullTime
+	var activeMonths pq.Int64Array
+
+	err := row.Scan(
+		&task.TaskID,
+		&task.GardenID,
+		&gardenPlantID,
+		&plantID,
+		&task.TaskType,
+		&task.Title,
+		&description,
+		&task.DueDate,
+		&recurrenceDays,
+		&activeMonths,
+		&task.Status,
+		&completedAt,
+		&completedBy,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map nullable fields
+	if gardenPlantID.Valid {
+		task.GardenPlantID = &gardenPlantID.String
+	}
+	if plantID.Valid {
+		task.PlantID = &plantID.String
+	}
+	if description.Valid {
+		task.Description = &description.String
+	}
+	if recurrenceDays.Valid {
+		days := int(recurrenceDays.Int64)
+		task.RecurrenceDays = &days
+	}
+	if completedAt.Valid {
+		task.CompletedAt = &completedAt.Time
+	}
+	if completedBy.Valid {
+		task.CompletedBy = &completedBy.String
+	}
+	for _, month := range activeMonths {
+		task.ActiveMonths = append(task.ActiveMonths, int(month))
+	}
+
+	return &task, nil
+}
@@ -40,11 +40,45 @@ import (
 	// Plant Service
 	plantService "twigger-backend/backend/plant-service/domain/service"
 	plantRepo "twigger-backend/backend/plant-service/infrastructure/database"
+	plantPersistence "twigger-backend/backend/plant-service/infrastructure/persistence"
 
 	// Garden Service
 	gardenService "twigger-backend/backend/garden-service/domain/service"
+	gardenDatabase "twigger-backend/backend/garden-service/infrastructure/database"
 	gardenRepo "twigger-backend/backend/garden-service/infrastructure/persistence"
 
+	// Auth Service
+	authService "twigger-backend/backend/auth-service/domain/service"
+	authRepo "twigger-backend/backend/auth-service/infrastructure/persistence"
+
+	// Task Service
+	taskService "twigger-backend/backend/task-service/domain/service"
+	taskRepo "twigger-backend/backend/task-service/infrastructure/persistence"
+
+	// Journal Service
+	journalService "twigger-backend/backend/journal-service/domain/service"
+	journalRepo "twigger-backend/backend/journal-service/infrastructure/persistence"
+
+	// Photo Service
+	photoService "twigger-backend/backend/photo-service/domain/service"
+	photoBlobStorage "twigger-backend/backend/photo-service/domain/storage"
+	photoRepo "twigger-backend/backend/photo-service/infrastructure/persistence"
+	photoStorage "twigger-backend/backend/photo-service/infrastructure/storage"
+
+	// Harvest Service
+	harvestService "twigger-backend/backend/harvest-service/domain/service"
+	harvestRepo "twigger-backend/backend/harvest-service/infrastructure/persistence"
+
+	// Rotation Service
+	rotationService "twigger-backend/backend/rotation-service/domain/service"
+
+	// Health Service
+	healthService "twigger-backend/backend/health-service/domain/service"
+	healthRepo "twigger-backend/backend/health-service/infrastructure/persistence"
+
+	// Planning Service
+	planningService "twigger-backend/backend/planning-service/domain/service"
+
 	// Swagger docs (imported in router package)
 	_ "twigger-backend/docs/swagger"
 )
@@ -79,23 +113,92 @@ func main() {
 
 	// Initialize repositories
 	plantRepository := plantRepo.NewPostgresPlantRepository(db)
+	countryRepository := plantRepo.NewPostgresCountryRepository(db)
+	countryPlantRepository := plantPersistence.NewPostgresCountryPlantRepository(db)
+	climateZoneRepository := plantRepo.NewPostgresClimateZoneRepository(db)
+	dataSourceRepository := plantPersistence.NewPostgresDataSourceRepository(db)
+	familyRepository := plantPersistence.NewPostgresPlantFamilyRepository(db)
+	genusRepository := plantPersistence.NewPostgresPlantGenusRepository(db)
+	speciesRepository := plantPersistence.NewPostgresPlantSpeciesRepository(db)
+	cultivarRepository := plantPersistence.NewPostgresCultivarRepository(db)
+	synonymRepository := plantPersistence.NewPostgresPlantSynonymRepository(db)
+	languageRepository := plantPersistence.NewPostgresLanguageRepository(db)
 	gardenRepository := gardenRepo.NewPostgresGardenRepository(db)
 	zoneRepository := gardenRepo.NewPostgresGardenZoneRepository(db)
 	gardenPlantRepository := gardenRepo.NewPostgresGardenPlantRepository(db)
+	featureRepository := gardenRepo.NewPostgresGardenFeatureRepository(db)
+	plantEventRepository := gardenRepo.NewPostgresGardenPlantEventRepository(db)
+	gardenTxManager := gardenDatabase.NewTxManager(db)
+	userRepository := authRepo.NewPostgresUserRepository(db)
+	workspaceRepository := authRepo.NewPostgresWorkspaceRepository(db)
+	auditRepository := authRepo.NewPostgresAuditRepository(db)
+	taskRepository := taskRepo.NewPostgresTaskRepository(db)
+	journalRepository := journalRepo.NewPostgresJournalRepository(db)
+	problemRepository := plantPersistence.NewPostgresPlantProblemRepository(db)
+	descriptionRepository := plantPersistence.NewPostgresPlantDescriptionRepository(db)
+	translationRepository := plantPersistence.NewPostgresTranslationRepository(db)
+	photoRepository := photoRepo.NewPostgresPhotoRepository(db)
+	harvestRepository := harvestRepo.NewPostgresHarvestRepository(db)
+	problemReportRepository := healthRepo.NewPostgresProblemReportRepository(db)
+
+	// Initialize photo blob storage
+	photoBlobs, err := newPhotoStorage(ctx, config)
+	if err != nil {
+		log.Fatalf("Failed to initialize photo storage: %v", err)
+	}
 
 	// Initialize services
 	plantSvc := plantService.NewPlantService(plantRepository)
+	consensusSvc := plantService.NewConsensusService(plantRepository, dataSourceRepository)
+	catalogSvc := plantService.NewCatalogService(dataSourceRepository, familyRepository, genusRepository, speciesRepository, cultivarRepository, synonymRepository, plantRepository)
+	problemSvc := plantService.NewProblemService(problemRepository, plantRepository, languageRepository, countryRepository)
+	descriptionSvc := plantService.NewDescriptionService(descriptionRepository, plantRepository, languageRepository, countryRepository)
+	translationSvc := plantService.NewTranslationService(translationRepository, languageRepository, countryRepository)
 	gardenSvc := gardenService.NewGardenService(gardenRepository)
 	zoneSvc := gardenService.NewZoneManagementService(zoneRepository, gardenRepository)
-	plantPlacementSvc := gardenService.NewPlantPlacementService(gardenPlantRepository, gardenRepository, zoneRepository)
+	rotationSvc := rotationService.NewRotationService(zoneRepository, gardenPlantRepository, plantEventRepository, plantRepository, nil)
+	placementAdvisorSvc := planningService.NewPlacementAdvisorService(gardenRepository, gardenPlantRepository, plantRepository)
+	regionalStatusSvc := planningService.NewRegionalStatusService(gardenRepository, gardenPlantRepository, plantRepository, countryRepository, countryPlantRepository)
+	plantPlacementSvc := gardenService.NewPlantPlacementService(gardenPlantRepository, gardenRepository, zoneRepository, plantEventRepository, gardenTxManager, regionalStatusSvc, rotationSvc, placementAdvisorSvc)
+	zoneFillSvc := planningService.NewZoneFillService(zoneRepository, plantRepository, plantPlacementSvc)
+	recommendationSvc := planningService.NewRecommendationService(zoneRepository, gardenRepository, gardenPlantRepository, plantRepository, countryRepository)
+	suitabilitySvc := planningService.NewSuitabilityService(gardenRepository, zoneRepository, plantRepository, countryRepository, countryPlantRepository, climateZoneRepository)
+	featureSvc := gardenService.NewGardenFeatureService(featureRepository, gardenRepository)
+	shadeSvc := gardenService.NewShadeService(gardenRepository, zoneRepository, featureRepository)
+	authzSvc := authService.NewAuthorizationService(userRepository, workspaceRepository)
+	workspaceSvc := authService.NewWorkspaceService(workspaceRepository, userRepository, auditRepository)
+	taskSvc := taskService.NewTaskService(taskRepository, gardenRepository, gardenPlantRepository, plantRepository, countryRepository)
+	journalSvc := journalService.NewJournalService(journalRepository, zoneRepository, gardenPlantRepository)
+	photoSvc := photoService.NewPhotoService(photoRepository, photoBlobs, journalRepository, gardenPlantRepository, problemRepository)
+	harvestSvc := harvestService.NewHarvestService(harvestRepository, gardenPlantRepository, plantEventRepository, gardenTxManager)
+	diagnosisSvc := healthService.NewDiagnosisService(gardenPlantRepository, gardenRepository, plantRepository, problemRepository, climateZoneRepository)
+	outbreakSvc := healthService.NewOutbreakService(problemReportRepository, gardenPlantRepository, problemRepository)
+
+	// Garden access is checked by ownership or workspace role
+	gardenAuthorizer := handlers.NewGardenAuthorizer(authzSvc, gardenSvc, zoneSvc)
 
 	// Initialize handlers
 	h := handlers.NewHandlers(
 		db,
-		handlers.NewPlantHandler(plantSvc),
-		handlers.NewGardenHandler(gardenSvc),
-		handlers.NewZoneHandler(zoneSvc),
-		handlers.NewPlantPlacementHandler(plantPlacementSvc),
+		handlers.NewPlantHandler(plantSvc, consensusSvc, descriptionSvc),
+		handlers.NewGardenHandler(gardenSvc, gardenAuthorizer),
+		handlers.NewZoneHandler(zoneSvc, gardenAuthorizer),
+		handlers.NewPlantPlacementHandler(plantPlacementSvc, gardenAuthorizer),
+		handlers.NewFeatureHandler(featureSvc, gardenAuthorizer),
+		handlers.NewShadeHandler(shadeSvc, gardenAuthorizer),
+		handlers.NewWorkspaceHandler(workspaceSvc, gardenAuthorizer),
+		handlers.NewTaskHandler(taskSvc, gardenAuthorizer),
+		handlers.NewJournalHandler(journalSvc, gardenAuthorizer),
+		handlers.NewPhotoHandler(photoSvc, gardenAuthorizer),
+		handlers.NewHarvestHandler(harvestSvc, gardenAuthorizer),
+		handlers.NewRotationHandler(rotationSvc, gardenAuthorizer),
+		handlers.NewPlanningHandler(placementAdvisorSvc, zoneFillSvc, recommendationSvc, suitabilitySvc, regionalStatusSvc, gardenAuthorizer),
+		handlers.NewCatalogHandler(catalogSvc),
+		handlers.NewProblemHandler(problemSvc),
+		handlers.NewDiagnosisHandler(diagnosisSvc, gardenAuthorizer),
+		handlers.NewOutbreakHandler(outbreakSvc, gardenAuthorizer),
+		handlers.NewDescriptionHandler(descriptionSvc),
+		handlers.NewTranslationHandler(translationSvc),
 	)
 
 	// Initialize middleware
@@ -124,11 +227,17 @@ func main() {
 		}
 	}()
 
+	// Remove photos detached by owner or user deletes, with their stored images
+	sweepCtx, stopSweep := context.WithCancel(ctx)
+	defer stopSweep()
+	go sweepOrphanedPhotos(sweepCtx, photoSvc)
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutting down server...")
+	stopSweep()
 
 	// Graceful shutdown with timeout
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -148,6 +257,9 @@ type Config struct {
 	FirebaseProjectID string
 	Environment       string
 	LogLevel          string
+	PhotoStorage      string // "local" or "gcs"
+	PhotoStorageDir   string
+	PhotoBucket       string
 }
 
 // loadConfig loads configuration from environment variables
@@ -158,6 +270,50 @@ func loadConfig() *Config {
 		FirebaseProjectID: getEnv("FIREBASE_PROJECT_ID", "twigger"),
 		Environment:       getEnv("ENVIRONMENT", "development"),
 		LogLevel:          getEnv("LOG_LEVEL", "info"),
+		PhotoStorage:      getEnv("PHOTO_STORAGE", "local"),
+		PhotoStorageDir:   getEnv("PHOTO_STORAGE_DIR", "./data/photos"),
+		PhotoBucket:       getEnv("PHOTO_BUCKET", ""),
+	}
+}
+
+// newPhotoStorage creates the blob store for uploaded photos
+func newPhotoStorage(ctx context.Context, config *Config) (photoBlobStorage.BlobStorage, error) {
+	switch config.PhotoStorage {
+	case "local":
+		return photoStorage.NewLocalStorage(config.PhotoStorageDir)
+	case "gcs":
+		if config.PhotoBucket == "" {
+			return nil, fmt.Errorf("PHOTO_BUCKET is required for gcs photo storage")
+		}
+		return photoStorage.NewGCSStorage(ctx, config.PhotoBucket)
+	}
+	return nil, fmt.Errorf("unknown PHOTO_STORAGE %q: must be local or gcs", config.PhotoStorage)
+}
+
+// Orphaned photo sweep schedule
+const (
+	photoSweepInterval  = time.Hour
+	photoSweepBatchSize = 500
+)
+
+// sweepOrphanedPhotos periodically removes photos whose owner or uploader has been deleted
+func sweepOrphanedPhotos(ctx context.Context, svc photoService.PhotoService) {
+	ticker := time.NewTicker(photoSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := svc.SweepOrphanedPhotos(ctx, photoSweepBatchSize)
+			if removed > 0 {
+				log.Printf("Removed %d orphaned photos", removed)
+			}
+			if err != nil {
+				log.Printf("Orphaned photo sweep failed: %v", err)
+			}
+		}
 	}
 }
 
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	authService "twigger-backend/backend/auth-service/domain/service"
+	"twigger-backend/backend/garden-service/domain/entity"
+	gardenService "twigger-backend/backend/garden-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// GardenAuthorizer enforces ownership and workspace role checks on gardens
+// and everything nested under them (zones, features, placements).
+//
+// Access rules:
+//   - the garden owner can do everything
+//   - workspace viewers can read, members can edit, admins can delete
+type GardenAuthorizer struct {
+	authzService  *authService.AuthorizationService
+	gardenService gardenService.GardenService
+	zoneService   gardenService.ZoneManagementService
+}
+
+// NewGardenAuthorizer creates a new garden authorizer
+func NewGardenAuthorizer(authzService *authService.AuthorizationService, gardenSvc gardenService.GardenService, zoneSvc gardenService.ZoneManagementService) *GardenAuthorizer {
+	return &GardenAuthorizer{
+		authzService:  authzService,
+		gardenService: gardenSvc,
+		zoneService:   zoneSvc,
+	}
+}
+
+// CurrentUserID resolves the caller's users.user_id from their Firebase UID.
+// Writes a 401 response and returns false if the caller is not a registered user.
+func (a *GardenAuthorizer) CurrentUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	firebaseUID := utils.GetUserID(r.Context())
+	if firebaseUID == "" {
+		utils.RespondUnauthorized(w, "User not authenticated")
+		return "", false
+	}
+
+	user, err := a.authzService.ResolveUser(r.Context(), firebaseUID)
+	if err != nil {
+		utils.RespondUnauthorized(w, "User not registered")
+		return "", false
+	}
+
+	return user.UserID.String(), true
+}
+
+// AuthorizeGarden loads a garden and checks the caller holds the permission on it.
+// Writes the error response and returns false if access is denied.
+func (a *GardenAuthorizer) AuthorizeGarden(w http.ResponseWriter, r *http.Request, gardenID string, permission authEntity.Permission) (*entity.Garden, bool) {
+	userID, ok := a.CurrentUserID(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	garden, err := a.gardenService.GetGarden(r.Context(), gardenID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return nil, false
+	}
+
+	if err := a.checkGarden(r, userID, garden, permission); err != nil {
+		if errors.Is(err, authService.ErrForbidden) {
+			utils.RespondForbidden(w, "You don't have permission to "+string(permission)+" this garden")
+			return nil, false
+		}
+		utils.RespondError(w, err)
+		return nil, false
+	}
+
+	return garden, true
+}
+
+// AuthorizeZone loads a zone and checks the caller holds the permission on its garden.
+// Writes the error response and returns false if access is denied.
+func (a *GardenAuthorizer) AuthorizeZone(w http.ResponseWriter, r *http.Request, zoneID string, permission authEntity.Permission) (*entity.GardenZone, bool) {
+	zone, err := a.zoneService.GetZone(r.Context(), zoneID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return nil, false
+	}
+
+	if _, ok := a.AuthorizeGarden(w, r, zone.GardenID, permission); !ok {
+		return nil, false
+	}
+
+	return zone, true
+}
+
+// AuthorizeWorkspace checks the caller holds the permission in a workspace.
+// Writes the error response and returns false if access is denied.
+func (a *GardenAuthorizer) AuthorizeWorkspace(w http.ResponseWriter, r *http.Request, userID, workspaceID string, permission authEntity.Permission) bool {
+	callerID, err := uuid.Parse(userID)
+	if err != nil {
+		utils.RespondUnauthorized(w, "User not registered")
+		return false
+	}
+
+	wsID, err := uuid.Parse(workspaceID)
+	if err != nil {
+		utils.RespondValidationError(w, "workspace_id", "Invalid workspace ID")
+		return false
+	}
+
+	role, err := a.authzService.GetRole(r.Context(), wsID, callerID)
+	if err != nil && !errors.Is(err, authService.ErrForbidden) {
+		utils.RespondError(w, err)
+		return false
+	}
+	if err != nil || !role.Allows(permission) {
+		utils.RespondForbidden(w, "You don't have permission to "+string(permission)+" in this workspace")
+		return false
+	}
+
+	return true
+}
+
+// CanAccessGarden reports whether a user holds the permission on an already loaded garden
+func (a *GardenAuthorizer) CanAccessGarden(r *http.Request, userID string, garden *entity.Garden, permission authEntity.Permission) bool {
+	return a.checkGarden(r, userID, garden, permission) == nil
+}
+
+// checkGarden delegates the ownership/role decision to the authorization service
+func (a *GardenAuthorizer) checkGarden(r *http.Request, userID string, garden *entity.Garden, permission authEntity.Permission) error {
+	callerID, err := uuid.Parse(userID)
+	if err != nil {
+		return authService.ErrForbidden
+	}
+
+	// Gardens created before user UUIDs were enforced may hold a non-UUID owner
+	ownerID, err := uuid.Parse(garden.UserID)
+	if err != nil {
+		log.Printf("WARN: garden %s has non-UUID owner %q", garden.GardenID, garden.UserID)
+		ownerID = uuid.Nil
+	}
+
+	var workspaceID *uuid.UUID
+	if garden.WorkspaceID != nil {
+		if parsed, err := uuid.Parse(*garden.WorkspaceID); err == nil {
+			workspaceID = &parsed
+		}
+	}
+
+	return a.authzService.Authorize(r.Context(), callerID, ownerID, workspaceID, permission)
+}
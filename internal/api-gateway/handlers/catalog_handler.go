@@ -0,0 +1,726 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	plantService "twigger-backend/backend/plant-service/domain/service"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// CatalogHandler handles admin HTTP requests for plant reference data: data sources,
+// families, genera, species, cultivars and synonyms
+type CatalogHandler struct {
+	service *plantService.CatalogService
+}
+
+// NewCatalogHandler creates a new catalog handler
+func NewCatalogHandler(service *plantService.CatalogService) *CatalogHandler {
+	return &CatalogHandler{
+		service: service,
+	}
+}
+
+// ListDataSources handles GET /api/v1/admin/data-sources?type=
+func (h *CatalogHandler) ListDataSources(w http.ResponseWriter, r *http.Request) {
+	sources, err := h.service.ListDataSources(r.Context(), utils.GetQueryParam(r, "type"))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, sources, nil)
+}
+
+// GetDataSource handles GET /api/v1/admin/data-sources/:id
+func (h *CatalogHandler) GetDataSource(w http.ResponseWriter, r *http.Request) {
+	sourceID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	source, err := h.service.GetDataSource(r.Context(), sourceID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, source, nil)
+}
+
+// CreateDataSource handles POST /api/v1/admin/data-sources
+func (h *CatalogHandler) CreateDataSource(w http.ResponseWriter, r *http.Request) {
+	source, ok := decodeDataSource(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.CreateDataSource(r.Context(), source); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, source)
+}
+
+// UpdateDataSource handles PUT /api/v1/admin/data-sources/:id
+func (h *CatalogHandler) UpdateDataSource(w http.ResponseWriter, r *http.Request) {
+	sourceID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	source, ok := decodeDataSource(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.UpdateDataSource(r.Context(), sourceID, source); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, source, nil)
+}
+
+// DeleteDataSource handles DELETE /api/v1/admin/data-sources/:id
+// Sources still cited by growing conditions or characteristics cannot be deleted.
+func (h *CatalogHandler) DeleteDataSource(w http.ResponseWriter, r *http.Request) {
+	sourceID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteDataSource(r.Context(), sourceID); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// ListFamilies handles GET /api/v1/admin/families?q=&limit=
+func (h *CatalogHandler) ListFamilies(w http.ResponseWriter, r *http.Request) {
+	families, err := h.service.ListFamilies(r.Context(), utils.GetQueryParam(r, "q"), catalogLimit(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, families, nil)
+}
+
+// GetFamily handles GET /api/v1/admin/families/:id
+func (h *CatalogHandler) GetFamily(w http.ResponseWriter, r *http.Request) {
+	familyID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	family, err := h.service.GetFamily(r.Context(), familyID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, family, nil)
+}
+
+// CreateFamily handles POST /api/v1/admin/families
+func (h *CatalogHandler) CreateFamily(w http.ResponseWriter, r *http.Request) {
+	var req familyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	family := &entity.PlantFamily{FamilyName: req.FamilyName, CommonName: req.CommonName}
+	if err := h.service.CreateFamily(r.Context(), family); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, family)
+}
+
+// UpdateFamily handles PUT /api/v1/admin/families/:id
+func (h *CatalogHandler) UpdateFamily(w http.ResponseWriter, r *http.Request) {
+	familyID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	var req familyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	family := &entity.PlantFamily{FamilyName: req.FamilyName, CommonName: req.CommonName}
+	if err := h.service.UpdateFamily(r.Context(), familyID, family); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, family, nil)
+}
+
+// DeleteFamily handles DELETE /api/v1/admin/families/:id
+// Families that still have genera cannot be deleted.
+func (h *CatalogHandler) DeleteFamily(w http.ResponseWriter, r *http.Request) {
+	familyID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteFamily(r.Context(), familyID); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// ListGenera handles GET /api/v1/admin/genera?family_id=&q=&limit=
+func (h *CatalogHandler) ListGenera(w http.ResponseWriter, r *http.Request) {
+	familyID, ok := optionalCatalogID(w, r, "family_id")
+	if !ok {
+		return
+	}
+
+	genera, err := h.service.ListGenera(r.Context(), familyID, utils.GetQueryParam(r, "q"), catalogLimit(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, genera, nil)
+}
+
+// GetGenus handles GET /api/v1/admin/genera/:id
+func (h *CatalogHandler) GetGenus(w http.ResponseWriter, r *http.Request) {
+	genusID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	genus, err := h.service.GetGenus(r.Context(), genusID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, genus, nil)
+}
+
+// CreateGenus handles POST /api/v1/admin/genera
+func (h *CatalogHandler) CreateGenus(w http.ResponseWriter, r *http.Request) {
+	genus, ok := decodeGenus(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.CreateGenus(r.Context(), genus); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, genus)
+}
+
+// UpdateGenus handles PUT /api/v1/admin/genera/:id
+func (h *CatalogHandler) UpdateGenus(w http.ResponseWriter, r *http.Request) {
+	genusID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	genus, ok := decodeGenus(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.UpdateGenus(r.Context(), genusID, genus); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, genus, nil)
+}
+
+// DeleteGenus handles DELETE /api/v1/admin/genera/:id
+// Genera that still have species cannot be deleted.
+func (h *CatalogHandler) DeleteGenus(w http.ResponseWriter, r *http.Request) {
+	genusID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteGenus(r.Context(), genusID); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// ListSpecies handles GET /api/v1/admin/species?genus_id=&q=&limit=
+func (h *CatalogHandler) ListSpecies(w http.ResponseWriter, r *http.Request) {
+	genusID, ok := optionalCatalogID(w, r, "genus_id")
+	if !ok {
+		return
+	}
+
+	species, err := h.service.ListSpecies(r.Context(), genusID, utils.GetQueryParam(r, "q"), catalogLimit(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, species, nil)
+}
+
+// GetSpecies handles GET /api/v1/admin/species/:id
+func (h *CatalogHandler) GetSpecies(w http.ResponseWriter, r *http.Request) {
+	speciesID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	species, err := h.service.GetSpecies(r.Context(), speciesID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, species, nil)
+}
+
+// CreateSpecies handles POST /api/v1/admin/species
+func (h *CatalogHandler) CreateSpecies(w http.ResponseWriter, r *http.Request) {
+	species, ok := decodeSpecies(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.CreateSpecies(r.Context(), species); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, species)
+}
+
+// UpdateSpecies handles PUT /api/v1/admin/species/:id
+func (h *CatalogHandler) UpdateSpecies(w http.ResponseWriter, r *http.Request) {
+	speciesID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	species, ok := decodeSpecies(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.UpdateSpecies(r.Context(), speciesID, species); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, species, nil)
+}
+
+// DeleteSpecies handles DELETE /api/v1/admin/species/:id
+// Species that still have cultivars or plants cannot be deleted.
+func (h *CatalogHandler) DeleteSpecies(w http.ResponseWriter, r *http.Request) {
+	speciesID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteSpecies(r.Context(), speciesID); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// ListCultivars handles GET /api/v1/admin/cultivars?species_id=&q=&limit=
+func (h *CatalogHandler) ListCultivars(w http.ResponseWriter, r *http.Request) {
+	speciesID, ok := optionalCatalogID(w, r, "species_id")
+	if !ok {
+		return
+	}
+
+	cultivars, err := h.service.ListCultivars(r.Context(), speciesID, utils.GetQueryParam(r, "q"), catalogLimit(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, cultivars, nil)
+}
+
+// GetCultivar handles GET /api/v1/admin/cultivars/:id
+func (h *CatalogHandler) GetCultivar(w http.ResponseWriter, r *http.Request) {
+	cultivarID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	cultivar, err := h.service.GetCultivar(r.Context(), cultivarID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, cultivar, nil)
+}
+
+// CreateCultivar handles POST /api/v1/admin/cultivars
+func (h *CatalogHandler) CreateCultivar(w http.ResponseWriter, r *http.Request) {
+	cultivar, ok := decodeCultivar(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.CreateCultivar(r.Context(), cultivar); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, cultivar)
+}
+
+// UpdateCultivar handles PUT /api/v1/admin/cultivars/:id
+func (h *CatalogHandler) UpdateCultivar(w http.ResponseWriter, r *http.Request) {
+	cultivarID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	cultivar, ok := decodeCultivar(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.UpdateCultivar(r.Context(), cultivarID, cultivar); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, cultivar, nil)
+}
+
+// DeleteCultivar handles DELETE /api/v1/admin/cultivars/:id
+// Cultivars that still have plants cannot be deleted.
+func (h *CatalogHandler) DeleteCultivar(w http.ResponseWriter, r *http.Request) {
+	cultivarID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteCultivar(r.Context(), cultivarID); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// ListSynonyms handles GET /api/v1/admin/synonyms?plant_id=&old_name=
+func (h *CatalogHandler) ListSynonyms(w http.ResponseWriter, r *http.Request) {
+	plantID, ok := optionalCatalogID(w, r, "plant_id")
+	if !ok {
+		return
+	}
+
+	synonyms, err := h.service.ListSynonyms(r.Context(), plantID, utils.GetQueryParam(r, "old_name"))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, synonyms, nil)
+}
+
+// GetSynonym handles GET /api/v1/admin/synonyms/:id
+func (h *CatalogHandler) GetSynonym(w http.ResponseWriter, r *http.Request) {
+	synonymID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	synonym, err := h.service.GetSynonym(r.Context(), synonymID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, synonym, nil)
+}
+
+// CreateSynonym handles POST /api/v1/admin/synonyms
+func (h *CatalogHandler) CreateSynonym(w http.ResponseWriter, r *http.Request) {
+	synonym, ok := decodeSynonym(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.CreateSynonym(r.Context(), synonym); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, synonym)
+}
+
+// UpdateSynonym handles PUT /api/v1/admin/synonyms/:id
+func (h *CatalogHandler) UpdateSynonym(w http.ResponseWriter, r *http.Request) {
+	synonymID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	synonym, ok := decodeSynonym(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.UpdateSynonym(r.Context(), synonymID, synonym); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, synonym, nil)
+}
+
+// DeleteSynonym handles DELETE /api/v1/admin/synonyms/:id
+func (h *CatalogHandler) DeleteSynonym(w http.ResponseWriter, r *http.Request) {
+	synonymID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteSynonym(r.Context(), synonymID); err != nil {
+		respondCatalogError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// catalogID validates the id path parameter, responding with a validation error if it is invalid
+func catalogID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	id := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(id); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return "", false
+	}
+	return id, true
+}
+
+// optionalCatalogID validates an optional UUID query parameter
+func optionalCatalogID(w http.ResponseWriter, r *http.Request, param string) (string, bool) {
+	id := utils.GetQueryParam(r, param)
+	if id == "" {
+		return "", true
+	}
+	if err := utils.ValidateUUID(id); err != nil {
+		utils.RespondValidationError(w, param, err.Error())
+		return "", false
+	}
+	return id, true
+}
+
+// catalogLimit reads the limit for name searches
+func catalogLimit(r *http.Request) int {
+	return utils.ValidateLimit(utils.GetQueryParamInt(r, "limit", 50), 100)
+}
+
+// parseCatalogDate parses an optional YYYY-MM-DD date field
+func parseCatalogDate(w http.ResponseWriter, field string, value *string) (*time.Time, bool) {
+	if value == nil || *value == "" {
+		return nil, true
+	}
+	date, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		utils.RespondValidationError(w, field, "Date must be in YYYY-MM-DD format")
+		return nil, false
+	}
+	return &date, true
+}
+
+// decodeDataSource decodes a data source request body
+func decodeDataSource(w http.ResponseWriter, r *http.Request) (*entity.DataSource, bool) {
+	var req dataSourceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return nil, false
+	}
+
+	lastVerified, ok := parseCatalogDate(w, "last_verified", req.LastVerified)
+	if !ok {
+		return nil, false
+	}
+
+	return &entity.DataSource{
+		SourceName:       req.SourceName,
+		SourceType:       req.SourceType,
+		ReliabilityScore: req.ReliabilityScore,
+		WebsiteURL:       req.WebsiteURL,
+		LastVerified:     lastVerified,
+	}, true
+}
+
+// decodeGenus decodes a genus request body
+func decodeGenus(w http.ResponseWriter, r *http.Request) (*entity.PlantGenus, bool) {
+	var req genusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return nil, false
+	}
+
+	if err := utils.ValidateUUID(req.FamilyID); err != nil {
+		utils.RespondValidationError(w, "family_id", "Invalid family ID format")
+		return nil, false
+	}
+
+	return &entity.PlantGenus{FamilyID: req.FamilyID, GenusName: req.GenusName}, true
+}
+
+// decodeSpecies decodes a species request body
+func decodeSpecies(w http.ResponseWriter, r *http.Request) (*entity.PlantSpecies, bool) {
+	var req speciesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return nil, false
+	}
+
+	if err := utils.ValidateUUID(req.GenusID); err != nil {
+		utils.RespondValidationError(w, "genus_id", "Invalid genus ID format")
+		return nil, false
+	}
+
+	return &entity.PlantSpecies{
+		GenusID:     req.GenusID,
+		SpeciesName: req.SpeciesName,
+		PlantType:   types.PlantType(req.PlantType),
+	}, true
+}
+
+// decodeCultivar decodes a cultivar request body
+func decodeCultivar(w http.ResponseWriter, r *http.Request) (*entity.Cultivar, bool) {
+	var req cultivarRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return nil, false
+	}
+
+	if err := utils.ValidateUUID(req.SpeciesID); err != nil {
+		utils.RespondValidationError(w, "species_id", "Invalid species ID format")
+		return nil, false
+	}
+
+	patentExpiry, ok := parseCatalogDate(w, "patent_expiry", req.PatentExpiry)
+	if !ok {
+		return nil, false
+	}
+
+	return &entity.Cultivar{
+		SpeciesID:             req.SpeciesID,
+		CultivarName:          req.CultivarName,
+		TradeName:             req.TradeName,
+		PatentNumber:          req.PatentNumber,
+		PatentExpiry:          patentExpiry,
+		PropagationRestricted: req.PropagationRestricted,
+	}, true
+}
+
+// decodeSynonym decodes a synonym request body
+func decodeSynonym(w http.ResponseWriter, r *http.Request) (*entity.PlantSynonym, bool) {
+	var req synonymRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return nil, false
+	}
+
+	if err := utils.ValidateUUID(req.CurrentPlantID); err != nil {
+		utils.RespondValidationError(w, "current_plant_id", "Invalid plant ID format")
+		return nil, false
+	}
+
+	dateDeprecated, ok := parseCatalogDate(w, "date_deprecated", req.DateDeprecated)
+	if !ok {
+		return nil, false
+	}
+
+	return &entity.PlantSynonym{
+		CurrentPlantID: req.CurrentPlantID,
+		OldName:        req.OldName,
+		DateDeprecated: dateDeprecated,
+	}, true
+}
+
+// respondCatalogError maps duplicate names and deletes blocked by dependent records to 409
+func respondCatalogError(w http.ResponseWriter, err error) {
+	var conflict *entity.ConflictError
+	if errors.As(err, &conflict) {
+		utils.RespondJSON(w, http.StatusConflict, utils.ErrorResponse{
+			Error:   "conflict",
+			Code:    "CONFLICT",
+			Message: conflict.Error(),
+			Details: map[string]interface{}{
+				"resource": conflict.Resource,
+			},
+		})
+		return
+	}
+	utils.RespondError(w, err)
+}
+
+// Request DTOs
+type dataSourceRequest struct {
+	SourceName       string  `json:"source_name"`
+	SourceType       string  `json:"source_type"`
+	ReliabilityScore int     `json:"reliability_score"` // 1-5, defaults to 3
+	WebsiteURL       *string `json:"website_url,omitempty"`
+	LastVerified     *string `json:"last_verified,omitempty"` // YYYY-MM-DD
+}
+
+type familyRequest struct {
+	FamilyName string  `json:"family_name"`
+	CommonName *string `json:"common_name,omitempty"`
+}
+
+type genusRequest struct {
+	FamilyID  string `json:"family_id"`
+	GenusName string `json:"genus_name"`
+}
+
+type speciesRequest struct {
+	GenusID     string `json:"genus_id"`
+	SpeciesName string `json:"species_name"`
+	PlantType   string `json:"plant_type"`
+}
+
+type cultivarRequest struct {
+	SpeciesID             string  `json:"species_id"`
+	CultivarName          string  `json:"cultivar_name"`
+	TradeName             *string `json:"trade_name,omitempty"`
+	PatentNumber          *string `json:"patent_number,omitempty"`
+	PatentExpiry          *string `json:"patent_expiry,omitempty"` // YYYY-MM-DD
+	PropagationRestricted bool    `json:"propagation_restricted"`
+}
+
+type synonymRequest struct {
+	CurrentPlantID string  `json:"current_plant_id"`
+	OldName        string  `json:"old_name"`
+	DateDeprecated *string `json:"date_deprecated,omitempty"` // YYYY-MM-DD
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	plantService "twigger-backend/backend/plant-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// DescriptionHandler handles HTTP requests for localized plant descriptions and care guides
+type DescriptionHandler struct {
+	service *plantService.DescriptionService
+}
+
+// NewDescriptionHandler creates a new plant description handler
+func NewDescriptionHandler(service *plantService.DescriptionService) *DescriptionHandler {
+	return &DescriptionHandler{
+		service: service,
+	}
+}
+
+// GetGuide handles GET /api/v1/plants/:id/guide
+// Returns the overview, planting, care, propagation and uses sections in the user's language,
+// with each missing section served in English.
+func (h *DescriptionHandler) GetGuide(w http.ResponseWriter, r *http.Request) {
+	plantID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	guide, err := h.service.GetGuide(r.Context(), plantID, requestLocale(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, guide, nil)
+}
+
+// ListDescriptions handles GET /api/v1/admin/plants/:id/descriptions
+func (h *DescriptionHandler) ListDescriptions(w http.ResponseWriter, r *http.Request) {
+	plantID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	descriptions, err := h.service.ListDescriptions(r.Context(), plantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, descriptions, nil)
+}
+
+// SaveDescription handles PUT /api/v1/admin/plants/:id/descriptions/:language?country=
+// Creates or replaces the translation for the language, country-specific when country is set.
+func (h *DescriptionHandler) SaveDescription(w http.ResponseWriter, r *http.Request) {
+	plantID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	var req descriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	description, err := h.service.SaveDescription(r.Context(), plantID, utils.GetPathParam(r, "language"), descriptionCountry(r), &entity.PlantDescription{
+		Summary:     req.Summary,
+		Overview:    req.Overview,
+		Planting:    req.Planting,
+		Care:        req.Care,
+		Propagation: req.Propagation,
+		Uses:        req.Uses,
+		IsPrimary:   req.IsPrimary,
+		SourceID:    req.SourceID,
+	})
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, description, nil)
+}
+
+// DeleteDescription handles DELETE /api/v1/admin/plants/:id/descriptions/:language?country=
+func (h *DescriptionHandler) DeleteDescription(w http.ResponseWriter, r *http.Request) {
+	plantID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteDescription(r.Context(), plantID, utils.GetPathParam(r, "language"), descriptionCountry(r)); err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// descriptionCountry reads the optional country code a translation is specific to
+func descriptionCountry(r *http.Request) *string {
+	if country := utils.GetQueryParam(r, "country"); country != "" {
+		return &country
+	}
+	return nil
+}
+
+// descriptionRequest is the body for authoring a plant description translation
+type descriptionRequest struct {
+	Summary     *string `json:"summary,omitempty"`
+	Overview    *string `json:"overview,omitempty"`
+	Planting    *string `json:"planting,omitempty"`
+	Care        *string `json:"care,omitempty"`
+	Propagation *string `json:"propagation,omitempty"`
+	Uses        *string `json:"uses,omitempty"`
+	IsPrimary   bool    `json:"is_primary"`
+	SourceID    *string `json:"source_id,omitempty"`
+}
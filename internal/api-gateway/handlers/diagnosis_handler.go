@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/health-service/domain/entity"
+	healthService "twigger-backend/backend/health-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// DiagnosisHandler handles plant problem diagnosis HTTP requests
+type DiagnosisHandler struct {
+	service    healthService.DiagnosisService
+	authorizer *GardenAuthorizer
+}
+
+// NewDiagnosisHandler creates a new diagnosis handler
+func NewDiagnosisHandler(service healthService.DiagnosisService, authorizer *GardenAuthorizer) *DiagnosisHandler {
+	return &DiagnosisHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// DiagnosePlant handles POST /api/v1/garden-plants/:id/diagnosis
+// Ranks the likely causes of the observed symptoms, with confidence and recommended treatments.
+func (h *DiagnosisHandler) DiagnosePlant(w http.ResponseWriter, r *http.Request) {
+	gardenPlantID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenPlantID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req entity.DiagnosisRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	if _, ok := h.authorizer.CurrentUserID(w, r); !ok {
+		return
+	}
+
+	gardenID, err := h.service.ResolvePlantGarden(r.Context(), gardenPlantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	diagnosis, err := h.service.Diagnose(r.Context(), gardenPlantID, &req)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, diagnosis, nil)
+}
@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	gardenService "twigger-backend/backend/garden-service/domain/service"
+	"twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// FeatureHandler handles garden feature-related HTTP requests
+type FeatureHandler struct {
+	service    gardenService.GardenFeatureService
+	authorizer *GardenAuthorizer
+}
+
+// NewFeatureHandler creates a new feature handler
+func NewFeatureHandler(service gardenService.GardenFeatureService, authorizer *GardenAuthorizer) *FeatureHandler {
+	return &FeatureHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// CreateFeature handles POST /api/v1/gardens/:id/features
+func (h *FeatureHandler) CreateFeature(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req createFeatureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	feature := &entity.GardenFeature{
+		GardenID:        gardenID,
+		FeatureType:     entity.FeatureType(req.FeatureType),
+		FeatureName:     req.Name,
+		GeometryGeoJSON: req.GeometryGeoJSON,
+		HeightM:         req.HeightM,
+		CanopyDiameterM: req.CanopyDiameterM,
+		Deciduous:       req.Deciduous,
+	}
+
+	created, err := h.service.CreateFeature(r.Context(), feature)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, created)
+}
+
+// ListGardenFeatures handles GET /api/v1/gardens/:id/features
+func (h *FeatureHandler) ListGardenFeatures(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	// Optional type filter
+	var featureType *entity.FeatureType
+	if t := utils.GetQueryParam(r, "type"); t != "" {
+		ft := entity.FeatureType(t)
+		featureType = &ft
+	}
+
+	features, err := h.service.ListGardenFeatures(r.Context(), gardenID, featureType)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, features, nil)
+}
+
+// GetFeature handles GET /api/v1/features/:id
+func (h *FeatureHandler) GetFeature(w http.ResponseWriter, r *http.Request) {
+	featureID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(featureID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	feature, err := h.service.GetFeature(r.Context(), featureID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, feature.GardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	utils.RespondSuccess(w, feature, nil)
+}
+
+// UpdateFeature handles PUT /api/v1/features/:id
+func (h *FeatureHandler) UpdateFeature(w http.ResponseWriter, r *http.Request) {
+	featureID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(featureID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req updateFeatureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	// Get existing feature
+	existing, err := h.service.GetFeature(r.Context(), featureID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, existing.GardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	// Update fields
+	if req.FeatureType != nil {
+		existing.FeatureType = entity.FeatureType(*req.FeatureType)
+	}
+	if req.Name != nil {
+		existing.FeatureName = req.Name
+	}
+	if req.GeometryGeoJSON != nil {
+		existing.GeometryGeoJSON = *req.GeometryGeoJSON
+	}
+	if req.HeightM != nil {
+		existing.HeightM = req.HeightM
+	}
+	if req.CanopyDiameterM != nil {
+		existing.CanopyDiameterM = req.CanopyDiameterM
+	}
+	if req.Deciduous != nil {
+		existing.Deciduous = req.Deciduous
+	}
+
+	updated, err := h.service.UpdateFeature(r.Context(), existing)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, updated, nil)
+}
+
+// DeleteFeature handles DELETE /api/v1/features/:id
+func (h *FeatureHandler) DeleteFeature(w http.ResponseWriter, r *http.Request) {
+	featureID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(featureID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	feature, err := h.service.GetFeature(r.Context(), featureID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, feature.GardenID, authEntity.PermissionDelete); !ok {
+		return
+	}
+
+	if err := h.service.DeleteFeature(r.Context(), featureID); err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// Request DTOs
+type createFeatureRequest struct {
+	FeatureType     string   `json:"feature_type"`
+	Name            *string  `json:"name,omitempty"`
+	GeometryGeoJSON string   `json:"geometry_geojson"`
+	HeightM         *float64 `json:"height_m,omitempty"`
+	CanopyDiameterM *float64 `json:"canopy_diameter_m,omitempty"`
+	Deciduous       *bool    `json:"deciduous,omitempty"`
+}
+
+type updateFeatureRequest struct {
+	FeatureType     *string  `json:"feature_type,omitempty"`
+	Name            *string  `json:"name,omitempty"`
+	GeometryGeoJSON *string  `json:"geometry_geojson,omitempty"`
+	HeightM         *float64 `json:"height_m,omitempty"`
+	CanopyDiameterM *float64 `json:"canopy_diameter_m,omitempty"`
+	Deciduous       *bool    `json:"deciduous,omitempty"`
+}
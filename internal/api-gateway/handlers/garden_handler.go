@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
 	gardenService "twigger-backend/backend/garden-service/domain/service"
 	"twigger-backend/backend/garden-service/domain/entity"
 	"twigger-backend/internal/api-gateway/utils"
@@ -11,22 +12,23 @@ import (
 
 // GardenHandler handles garden-related HTTP requests
 type GardenHandler struct {
-	service gardenService.GardenService
+	service    gardenService.GardenService
+	authorizer *GardenAuthorizer
 }
 
 // NewGardenHandler creates a new garden handler
-func NewGardenHandler(service gardenService.GardenService) *GardenHandler {
+func NewGardenHandler(service gardenService.GardenService, authorizer *GardenAuthorizer) *GardenHandler {
 	return &GardenHandler{
-		service: service,
+		service:    service,
+		authorizer: authorizer,
 	}
 }
 
 // CreateGarden handles POST /api/v1/gardens
 func (h *GardenHandler) CreateGarden(w http.ResponseWriter, r *http.Request) {
-	// Get user ID from context (set by auth middleware)
-	userID := utils.GetUserID(r.Context())
-	if userID == "" {
-		utils.RespondUnauthorized(w, "User not authenticated")
+	// Resolve the caller's user ID (auth middleware only provides the Firebase UID)
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
 		return
 	}
 
@@ -44,8 +46,20 @@ func (h *GardenHandler) CreateGarden(w http.ResponseWriter, r *http.Request) {
 		aspect = &a
 	}
 
+	// Gardens go to the owner's default workspace unless one is chosen explicitly
+	if req.WorkspaceID != nil {
+		if err := utils.ValidateUUID(*req.WorkspaceID); err != nil {
+			utils.RespondValidationError(w, "workspace_id", err.Error())
+			return
+		}
+		if !h.authorizer.AuthorizeWorkspace(w, r, userID, *req.WorkspaceID, authEntity.PermissionEdit) {
+			return
+		}
+	}
+
 	garden := &entity.Garden{
 		UserID:          userID,
+		WorkspaceID:     req.WorkspaceID,
 		GardenName:      req.Name,
 		LocationGeoJSON: req.LocationGeoJSON,
 		BoundaryGeoJSON: req.BoundaryGeoJSON,
@@ -72,21 +86,20 @@ func (h *GardenHandler) GetGarden(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	garden, err := h.service.GetGarden(r.Context(), gardenID)
-	if err != nil {
-		utils.RespondError(w, err)
+	garden, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead)
+	if !ok {
 		return
 	}
 
 	utils.RespondSuccess(w, garden, nil)
 }
 
-// ListGardens handles GET /api/v1/gardens
+// ListGardens handles GET /api/v1/gardens?workspace_id=
+// Returns gardens the caller owns plus those shared through their workspaces.
 func (h *GardenHandler) ListGardens(w http.ResponseWriter, r *http.Request) {
-	// Get user ID from context
-	userID := utils.GetUserID(r.Context())
-	if userID == "" {
-		utils.RespondUnauthorized(w, "User not authenticated")
+	// Resolve the caller's user ID
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
 		return
 	}
 
@@ -94,7 +107,22 @@ func (h *GardenHandler) ListGardens(w http.ResponseWriter, r *http.Request) {
 	limit = utils.ValidateLimit(limit, 100)
 	offset := utils.GetQueryParamInt(r, "offset", 0)
 
-	gardens, err := h.service.ListUserGardens(r.Context(), userID, limit, offset)
+	var gardens []*entity.Garden
+	var err error
+
+	// Optional workspace filter
+	if workspaceID := utils.GetQueryParam(r, "workspace_id"); workspaceID != "" {
+		if err := utils.ValidateUUID(workspaceID); err != nil {
+			utils.RespondValidationError(w, "workspace_id", err.Error())
+			return
+		}
+		if !h.authorizer.AuthorizeWorkspace(w, r, userID, workspaceID, authEntity.PermissionRead) {
+			return
+		}
+		gardens, err = h.service.ListWorkspaceGardens(r.Context(), workspaceID, limit, offset)
+	} else {
+		gardens, err = h.service.ListAccessibleGardens(r.Context(), userID, limit, offset)
+	}
 	if err != nil {
 		utils.RespondError(w, err)
 		return
@@ -118,18 +146,37 @@ func (h *GardenHandler) UpdateGarden(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Get existing garden to ensure it exists and user owns it
-	existing, err := h.service.GetGarden(r.Context(), gardenID)
-	if err != nil {
-		utils.RespondError(w, err)
+	// Get existing garden and verify the caller can edit it
+	existing, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit)
+	if !ok {
 		return
 	}
 
-	// Verify user owns this garden
-	userID := utils.GetUserID(r.Context())
-	if existing.UserID != userID {
-		utils.RespondForbidden(w, "You don't have permission to update this garden")
-		return
+	// Moving a garden between workspaces changes who can see it, so it requires
+	// delete rights on the garden and edit rights in the target workspace.
+	// An empty workspace_id makes the garden private to its owner.
+	if req.WorkspaceID != nil {
+		userID, ok := h.authorizer.CurrentUserID(w, r)
+		if !ok {
+			return
+		}
+		if !h.authorizer.CanAccessGarden(r, userID, existing, authEntity.PermissionDelete) {
+			utils.RespondForbidden(w, "You don't have permission to move this garden")
+			return
+		}
+
+		if *req.WorkspaceID == "" {
+			existing.WorkspaceID = nil
+		} else {
+			if err := utils.ValidateUUID(*req.WorkspaceID); err != nil {
+				utils.RespondValidationError(w, "workspace_id", err.Error())
+				return
+			}
+			if !h.authorizer.AuthorizeWorkspace(w, r, userID, *req.WorkspaceID, authEntity.PermissionEdit) {
+				return
+			}
+			existing.WorkspaceID = req.WorkspaceID
+		}
 	}
 
 	// Update fields
@@ -171,16 +218,8 @@ func (h *GardenHandler) DeleteGarden(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership
-	garden, err := h.service.GetGarden(r.Context(), gardenID)
-	if err != nil {
-		utils.RespondError(w, err)
-		return
-	}
-
-	userID := utils.GetUserID(r.Context())
-	if garden.UserID != userID {
-		utils.RespondForbidden(w, "You don't have permission to delete this garden")
+	// Verify the caller can delete this garden
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionDelete); !ok {
 		return
 	}
 
@@ -195,9 +234,8 @@ func (h *GardenHandler) DeleteGarden(w http.ResponseWriter, r *http.Request) {
 
 // GetGardenStats handles GET /api/v1/gardens/stats
 func (h *GardenHandler) GetGardenStats(w http.ResponseWriter, r *http.Request) {
-	userID := utils.GetUserID(r.Context())
-	if userID == "" {
-		utils.RespondUnauthorized(w, "User not authenticated")
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
 		return
 	}
 
@@ -244,18 +282,32 @@ func (h *GardenHandler) FindNearbyGardens(w http.ResponseWriter, r *http.Request
 		radiusKm = 10.0 // cap at 100km
 	}
 
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
+		return
+	}
+
 	gardens, err := h.service.FindNearbyGardens(r.Context(), lat, lng, radiusKm)
 	if err != nil {
 		utils.RespondError(w, err)
 		return
 	}
 
-	utils.RespondSuccess(w, gardens, nil)
+	// Only return gardens the caller is allowed to see
+	visible := make([]*entity.Garden, 0, len(gardens))
+	for _, garden := range gardens {
+		if h.authorizer.CanAccessGarden(r, userID, garden, authEntity.PermissionRead) {
+			visible = append(visible, garden)
+		}
+	}
+
+	utils.RespondSuccess(w, visible, nil)
 }
 
 // Request DTOs
 type createGardenRequest struct {
 	Name            string   `json:"name"`
+	WorkspaceID     *string  `json:"workspace_id,omitempty"`
 	LocationGeoJSON *string  `json:"location_geojson,omitempty"`
 	BoundaryGeoJSON *string  `json:"boundary_geojson,omitempty"`
 	Aspect          *string  `json:"aspect,omitempty"`          // "N", "NE", "E", etc.
@@ -265,6 +317,7 @@ type createGardenRequest struct {
 
 type updateGardenRequest struct {
 	Name            *string  `json:"name,omitempty"`
+	WorkspaceID     *string  `json:"workspace_id,omitempty"` // empty string removes the garden from its workspace
 	LocationGeoJSON *string  `json:"location_geojson,omitempty"`
 	BoundaryGeoJSON *string  `json:"boundary_geojson,omitempty"`
 	Aspect          *string  `json:"aspect,omitempty"`          // "N", "NE", "E", etc.
@@ -8,17 +8,47 @@ type Handlers struct {
 	GardenHandler         *GardenHandler
 	ZoneHandler           *ZoneHandler
 	PlantPlacementHandler *PlantPlacementHandler
+	FeatureHandler        *FeatureHandler
+	ShadeHandler          *ShadeHandler
+	WorkspaceHandler      *WorkspaceHandler
+	TaskHandler           *TaskHandler
+	JournalHandler        *JournalHandler
+	PhotoHandler          *PhotoHandler
+	HarvestHandler        *HarvestHandler
+	RotationHandler       *RotationHandler
+	PlanningHandler       *PlanningHandler
+	CatalogHandler        *CatalogHandler
+	ProblemHandler        *ProblemHandler
+	DiagnosisHandler      *DiagnosisHandler
+	OutbreakHandler       *OutbreakHandler
+	DescriptionHandler    *DescriptionHandler
+	TranslationHandler    *TranslationHandler
 	HealthHandler         *HealthHandler
 	AuthHandler           *AuthHandler
 }
 
 // NewHandlers creates all handlers
-func NewHandlers(db *sql.DB, plantHandler *PlantHandler, gardenHandler *GardenHandler, zoneHandler *ZoneHandler, plantPlacementHandler *PlantPlacementHandler) *Handlers {
+func NewHandlers(db *sql.DB, plantHandler *PlantHandler, gardenHandler *GardenHandler, zoneHandler *ZoneHandler, plantPlacementHandler *PlantPlacementHandler, featureHandler *FeatureHandler, shadeHandler *ShadeHandler, workspaceHandler *WorkspaceHandler, taskHandler *TaskHandler, journalHandler *JournalHandler, photoHandler *PhotoHandler, harvestHandler *HarvestHandler, rotationHandler *RotationHandler, planningHandler *PlanningHandler, catalogHandler *CatalogHandler, problemHandler *ProblemHandler, diagnosisHandler *DiagnosisHandler, outbreakHandler *OutbreakHandler, descriptionHandler *DescriptionHandler, translationHandler *TranslationHandler) *Handlers {
 	return &Handlers{
 		PlantHandler:          plantHandler,
 		GardenHandler:         gardenHandler,
 		ZoneHandler:           zoneHandler,
 		PlantPlacementHandler: plantPlacementHandler,
+		FeatureHandler:        featureHandler,
+		ShadeHandler:          shadeHandler,
+		WorkspaceHandler:      workspaceHandler,
+		TaskHandler:           taskHandler,
+		JournalHandler:        journalHandler,
+		PhotoHandler:          photoHandler,
+		HarvestHandler:        harvestHandler,
+		RotationHandler:       rotationHandler,
+		PlanningHandler:       planningHandler,
+		CatalogHandler:        catalogHandler,
+		ProblemHandler:        problemHandler,
+		DiagnosisHandler:      diagnosisHandler,
+		OutbreakHandler:       outbreakHandler,
+		DescriptionHandler:    descriptionHandler,
+		TranslationHandler:    translationHandler,
 		HealthHandler:         NewHealthHandler(db),
 		AuthHandler:           NewAuthHandler(db),
 	}
@@ -0,0 +1,350 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/harvest-service/domain/entity"
+	"twigger-backend/backend/harvest-service/domain/repository"
+	harvestService "twigger-backend/backend/harvest-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// HarvestHandler handles harvest logging and yield HTTP requests
+type HarvestHandler struct {
+	service    harvestService.HarvestService
+	authorizer *GardenAuthorizer
+}
+
+// NewHarvestHandler creates a new harvest handler
+func NewHarvestHandler(service harvestService.HarvestService, authorizer *GardenAuthorizer) *HarvestHandler {
+	return &HarvestHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// RecordHarvest handles POST /api/v1/garden-plants/:id/harvests
+func (h *HarvestHandler) RecordHarvest(w http.ResponseWriter, r *http.Request) {
+	gardenPlantID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenPlantID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req harvestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	if req.Quantity == nil {
+		utils.RespondValidationError(w, "quantity", "Quantity is required")
+		return
+	}
+	if req.Unit == nil {
+		utils.RespondValidationError(w, "unit", "Unit is required")
+		return
+	}
+
+	harvest := &entity.Harvest{}
+	if !applyHarvestRequest(w, harvest, req) {
+		return
+	}
+
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
+		return
+	}
+	harvest.RecordedBy = userID
+
+	// Authorize against the plant's garden before recording
+	gardenID, err := h.service.ResolvePlantGarden(r.Context(), gardenPlantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	created, err := h.service.RecordHarvest(r.Context(), gardenPlantID, harvest)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, created)
+}
+
+// ListHarvests handles GET /api/v1/gardens/:id/harvests?from=&to=&zone_id=&plant_id=&garden_plant_id=
+func (h *HarvestHandler) ListHarvests(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	limit := utils.GetQueryParamInt(r, "limit", 20)
+	limit = utils.ValidateLimit(limit, 100)
+	offset := utils.GetQueryParamInt(r, "offset", 0)
+
+	filter := &repository.HarvestFilter{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	var ok bool
+	if filter.From, filter.To, ok = parseDateRange(w, r); !ok {
+		return
+	}
+
+	if zoneID := utils.GetQueryParam(r, "zone_id"); zoneID != "" {
+		if err := utils.ValidateUUID(zoneID); err != nil {
+			utils.RespondValidationError(w, "zone_id", err.Error())
+			return
+		}
+		filter.ZoneID = &zoneID
+	}
+	if plantID := utils.GetQueryParam(r, "plant_id"); plantID != "" {
+		if err := utils.ValidateUUID(plantID); err != nil {
+			utils.RespondValidationError(w, "plant_id", err.Error())
+			return
+		}
+		filter.PlantID = &plantID
+	}
+	if gardenPlantID := utils.GetQueryParam(r, "garden_plant_id"); gardenPlantID != "" {
+		if err := utils.ValidateUUID(gardenPlantID); err != nil {
+			utils.RespondValidationError(w, "garden_plant_id", err.Error())
+			return
+		}
+		filter.GardenPlantID = &gardenPlantID
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	harvests, total, err := h.service.ListHarvests(r.Context(), gardenID, filter)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	meta := &utils.Meta{
+		HasMore: filter.Offset+len(harvests) < total,
+		Limit:   filter.Limit,
+		Total:   &total,
+	}
+
+	utils.RespondSuccess(w, harvests, meta)
+}
+
+// GetYield handles GET /api/v1/gardens/:id/harvests/yield?group_by=species,zone,season&from=&to=
+func (h *HarvestHandler) GetYield(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	query := &repository.YieldQuery{}
+
+	// Dimensions may be repeated (?group_by=zone&group_by=season) or comma separated
+	for _, value := range r.URL.Query()["group_by"] {
+		for _, dimension := range strings.Split(value, ",") {
+			if dimension = strings.TrimSpace(dimension); dimension != "" {
+				query.GroupBy = append(query.GroupBy, entity.YieldDimension(dimension))
+			}
+		}
+	}
+	if len(query.GroupBy) == 0 {
+		query.GroupBy = []entity.YieldDimension{entity.YieldBySpecies}
+	}
+
+	var ok bool
+	if query.From, query.To, ok = parseDateRange(w, r); !ok {
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	summaries, err := h.service.GetYield(r.Context(), gardenID, query)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, summaries, nil)
+}
+
+// GetHarvest handles GET /api/v1/harvests/:id
+func (h *HarvestHandler) GetHarvest(w http.ResponseWriter, r *http.Request) {
+	harvestID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(harvestID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	harvest, err := h.service.GetHarvest(r.Context(), harvestID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, harvest.GardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	utils.RespondSuccess(w, harvest, nil)
+}
+
+// UpdateHarvest handles PUT /api/v1/harvests/:id
+func (h *HarvestHandler) UpdateHarvest(w http.ResponseWriter, r *http.Request) {
+	harvestID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(harvestID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req harvestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	existing, err := h.service.GetHarvest(r.Context(), harvestID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, existing.GardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	// Apply updates (only non-nil fields)
+	if !applyHarvestRequest(w, existing, req) {
+		return
+	}
+
+	updated, err := h.service.UpdateHarvest(r.Context(), existing)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, updated, nil)
+}
+
+// DeleteHarvest handles DELETE /api/v1/harvests/:id
+// Recorders may delete their own harvests; other harvests need delete permission.
+func (h *HarvestHandler) DeleteHarvest(w http.ResponseWriter, r *http.Request) {
+	harvestID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(harvestID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	harvest, err := h.service.GetHarvest(r.Context(), harvestID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	perm := authEntity.PermissionDelete
+	if harvest.RecordedBy == userID {
+		perm = authEntity.PermissionEdit
+	}
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, harvest.GardenID, perm); !ok {
+		return
+	}
+
+	if err := h.service.DeleteHarvest(r.Context(), harvestID); err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// applyHarvestRequest copies the non-nil fields of a request onto a harvest.
+// An empty quality or notes clears it. Writes a validation error and returns false if invalid.
+func applyHarvestRequest(w http.ResponseWriter, harvest *entity.Harvest, req harvestRequest) bool {
+	if req.HarvestedOn != nil {
+		parsed, err := time.Parse("2006-01-02", *req.HarvestedOn)
+		if err != nil {
+			utils.RespondValidationError(w, "harvested_on", "Date must be in YYYY-MM-DD format")
+			return false
+		}
+		harvest.HarvestedOn = parsed
+	}
+
+	if req.Quantity != nil {
+		harvest.Quantity = *req.Quantity
+	}
+	if req.Unit != nil {
+		harvest.Unit = entity.HarvestUnit(*req.Unit)
+	}
+
+	if req.Quality != nil {
+		harvest.Quality = nil
+		if *req.Quality != "" {
+			quality := entity.HarvestQuality(*req.Quality)
+			harvest.Quality = &quality
+		}
+	}
+
+	if req.Notes != nil {
+		harvest.Notes = nil
+		if *req.Notes != "" {
+			harvest.Notes = req.Notes
+		}
+	}
+
+	return true
+}
+
+// parseDateRange reads the optional inclusive from/to query dates.
+// Writes a validation error and returns false if either is malformed.
+func parseDateRange(w http.ResponseWriter, r *http.Request) (*time.Time, *time.Time, bool) {
+	var from, to *time.Time
+
+	if dateStr := utils.GetQueryParam(r, "from"); dateStr != "" {
+		parsed, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			utils.RespondValidationError(w, "from", "Date must be in YYYY-MM-DD format")
+			return nil, nil, false
+		}
+		from = &parsed
+	}
+	if dateStr := utils.GetQueryParam(r, "to"); dateStr != "" {
+		parsed, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			utils.RespondValidationError(w, "to", "Date must be in YYYY-MM-DD format")
+			return nil, nil, false
+		}
+		to = &parsed
+	}
+
+	return from, to, true
+}
+
+// Request DTOs
+type harvestRequest struct {
+	HarvestedOn *string  `json:"harvested_on,omitempty"` // YYYY-MM-DD, defaults to today
+	Quantity    *float64 `json:"quantity,omitempty"`
+	Unit        *string  `json:"unit,omitempty"` // kg, g, lb, oz or count
+	Quality     *string  `json:"quality,omitempty"`
+	Notes       *string  `json:"notes,omitempty"`
+}
@@ -19,6 +19,7 @@ import (
 	plantService "twigger-backend/backend/plant-service/domain/service"
 	plantRepo "twigger-backend/backend/plant-service/infrastructure/database"
 	testhelpers "twigger-backend/backend/plant-service/infrastructure/database/testing"
+	plantPersistence "twigger-backend/backend/plant-service/infrastructure/persistence"
 	"twigger-backend/internal/api-gateway/middleware"
 	"twigger-backend/internal/api-gateway/router"
 	"twigger-backend/internal/api-gateway/utils"
@@ -55,13 +56,15 @@ func SetupTestServer(t *testing.T) *TestServer {
 
 	// Initialize services
 	plantSvc := plantService.NewPlantService(plantRepository)
+	consensusSvc := plantService.NewConsensusService(plantRepository, plantPersistence.NewPostgresDataSourceRepository(db))
+	descriptionSvc := plantService.NewDescriptionService(plantPersistence.NewPostgresPlantDescriptionRepository(db), plantRepository, plantPersistence.NewPostgresLanguageRepository(db), plantRepo.NewPostgresCountryRepository(db))
 	gardenSvc := service.NewGardenService(gardenRepository, zoneRepository, plantRepository)
 	zoneSvc := service.NewZoneManagementService(zoneRepository, gardenRepository)
 	placementSvc := service.NewPlantPlacementService(plantPlacementRepo, plantRepository, zoneRepository, gardenRepository)
 
 	// Initialize handlers
 	handlers := &Handlers{
-		PlantHandler:          NewPlantHandler(plantSvc),
+		PlantHandler:          NewPlantHandler(plantSvc, consensusSvc, descriptionSvc),
 		GardenHandler:         NewGardenHandler(gardenSvc),
 		ZoneHandler:           NewZoneHandler(zoneSvc),
 		PlantPlacementHandler: NewPlantPlacementHandler(placementSvc),
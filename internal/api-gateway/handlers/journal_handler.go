@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/journal-service/domain/entity"
+	"twigger-backend/backend/journal-service/domain/repository"
+	journalService "twigger-backend/backend/journal-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// JournalHandler handles garden journal HTTP requests
+type JournalHandler struct {
+	service    journalService.JournalService
+	authorizer *GardenAuthorizer
+}
+
+// NewJournalHandler creates a new journal handler
+func NewJournalHandler(service journalService.JournalService, authorizer *GardenAuthorizer) *JournalHandler {
+	return &JournalHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// CreateEntry handles POST /api/v1/gardens/:id/journal
+func (h *JournalHandler) CreateEntry(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req journalEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	if req.Body == nil {
+		utils.RespondValidationError(w, "body", "Body is required")
+		return
+	}
+
+	entry := &entity.JournalEntry{
+		GardenID: gardenID,
+		Title:    req.Title,
+		Body:     *req.Body,
+		Tags:     req.Tags,
+	}
+	if !applyJournalLinks(w, entry, req) {
+		return
+	}
+
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
+		return
+	}
+	entry.AuthorID = userID
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	created, err := h.service.CreateEntry(r.Context(), entry)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, created)
+}
+
+// ListEntries handles GET /api/v1/gardens/:id/journal?from=&to=&tag=&zone_id=&garden_plant_id=&q=
+func (h *JournalHandler) ListEntries(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	limit := utils.GetQueryParamInt(r, "limit", 20)
+	limit = utils.ValidateLimit(limit, 100)
+	offset := utils.GetQueryParamInt(r, "offset", 0)
+
+	filter := &repository.JournalFilter{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	// Optional date range (inclusive)
+	if dateStr := utils.GetQueryParam(r, "from"); dateStr != "" {
+		from, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			utils.RespondValidationError(w, "from", "Date must be in YYYY-MM-DD format")
+			return
+		}
+		filter.From = &from
+	}
+	if dateStr := utils.GetQueryParam(r, "to"); dateStr != "" {
+		to, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			utils.RespondValidationError(w, "to", "Date must be in YYYY-MM-DD format")
+			return
+		}
+		filter.To = &to
+	}
+
+	// Tags may be repeated (?tag=a&tag=b) or comma separated
+	for _, value := range r.URL.Query()["tag"] {
+		filter.Tags = append(filter.Tags, strings.Split(value, ",")...)
+	}
+
+	if zoneID := utils.GetQueryParam(r, "zone_id"); zoneID != "" {
+		if err := utils.ValidateUUID(zoneID); err != nil {
+			utils.RespondValidationError(w, "zone_id", err.Error())
+			return
+		}
+		filter.ZoneID = &zoneID
+	}
+	if gardenPlantID := utils.GetQueryParam(r, "garden_plant_id"); gardenPlantID != "" {
+		if err := utils.ValidateUUID(gardenPlantID); err != nil {
+			utils.RespondValidationError(w, "garden_plant_id", err.Error())
+			return
+		}
+		filter.GardenPlantID = &gardenPlantID
+	}
+	if q := utils.GetQueryParam(r, "q"); q != "" {
+		filter.Query = &q
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	entries, total, err := h.service.ListEntries(r.Context(), gardenID, filter)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	meta := &utils.Meta{
+		HasMore: filter.Offset+len(entries) < total,
+		Limit:   filter.Limit,
+		Total:   &total,
+	}
+
+	utils.RespondSuccess(w, entries, meta)
+}
+
+// ListTags handles GET /api/v1/gardens/:id/journal/tags
+func (h *JournalHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	tags, err := h.service.ListTags(r.Context(), gardenID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, tags, nil)
+}
+
+// GetEntry handles GET /api/v1/journal/:id
+func (h *JournalHandler) GetEntry(w http.ResponseWriter, r *http.Request) {
+	entryID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(entryID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	entry, err := h.service.GetEntry(r.Context(), entryID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, entry.GardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	utils.RespondSuccess(w, entry, nil)
+}
+
+// UpdateEntry handles PUT /api/v1/journal/:id
+func (h *JournalHandler) UpdateEntry(w http.ResponseWriter, r *http.Request) {
+	entryID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(entryID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req journalEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	existing, err := h.service.GetEntry(r.Context(), entryID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, existing.GardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	// Apply updates (only non-nil fields)
+	if req.Title != nil {
+		existing.Title = req.Title
+		if *req.Title == "" {
+			existing.Title = nil
+		}
+	}
+	if req.Body != nil {
+		existing.Body = *req.Body
+	}
+	if req.Tags != nil {
+		existing.Tags = req.Tags
+	}
+	if !applyJournalLinks(w, existing, req) {
+		return
+	}
+
+	updated, err := h.service.UpdateEntry(r.Context(), existing)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, updated, nil)
+}
+
+// DeleteEntry handles DELETE /api/v1/journal/:id
+// Authors may delete their own entries; other entries need delete permission.
+func (h *JournalHandler) DeleteEntry(w http.ResponseWriter, r *http.Request) {
+	entryID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(entryID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	entry, err := h.service.GetEntry(r.Context(), entryID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	perm := authEntity.PermissionDelete
+	if entry.AuthorID == userID {
+		perm = authEntity.PermissionEdit
+	}
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, entry.GardenID, perm); !ok {
+		return
+	}
+
+	if err := h.service.DeleteEntry(r.Context(), entryID); err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// applyJournalLinks copies date, zone and plant fields from a request onto an entry.
+// An empty zone or plant ID clears the link. Writes a validation error and returns false if invalid.
+func applyJournalLinks(w http.ResponseWriter, entry *entity.JournalEntry, req journalEntryRequest) bool {
+	if req.EntryDate != nil {
+		parsed, err := time.Parse("2006-01-02", *req.EntryDate)
+		if err != nil {
+			utils.RespondValidationError(w, "entry_date", "Date must be in YYYY-MM-DD format")
+			return false
+		}
+		entry.EntryDate = parsed
+	}
+
+	if req.ZoneID != nil {
+		entry.ZoneID = nil
+		if *req.ZoneID != "" {
+			if err := utils.ValidateUUID(*req.ZoneID); err != nil {
+				utils.RespondValidationError(w, "zone_id", err.Error())
+				return false
+			}
+			entry.ZoneID = req.ZoneID
+		}
+	}
+
+	if req.GardenPlantID != nil {
+		entry.GardenPlantID = nil
+		if *req.GardenPlantID != "" {
+			if err := utils.ValidateUUID(*req.GardenPlantID); err != nil {
+				utils.RespondValidationError(w, "garden_plant_id", err.Error())
+				return false
+			}
+			entry.GardenPlantID = req.GardenPlantID
+		}
+	}
+
+	return true
+}
+
+// Request DTOs
+type journalEntryRequest struct {
+	Title         *string  `json:"title,omitempty"`
+	Body          *string  `json:"body,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	EntryDate     *string  `json:"entry_date,omitempty"` // YYYY-MM-DD
+	ZoneID        *string  `json:"zone_id,omitempty"`
+	GardenPlantID *string  `json:"garden_plant_id,omitempty"`
+}
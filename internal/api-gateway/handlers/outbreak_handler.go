@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/health-service/domain/entity"
+	healthService "twigger-backend/backend/health-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// OutbreakHandler handles problem reports on garden plants and garden outbreak HTTP requests
+type OutbreakHandler struct {
+	service    healthService.OutbreakService
+	authorizer *GardenAuthorizer
+}
+
+// NewOutbreakHandler creates a new outbreak handler
+func NewOutbreakHandler(service healthService.OutbreakService, authorizer *GardenAuthorizer) *OutbreakHandler {
+	return &OutbreakHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// problemReportRequest is the body for reporting a problem on a garden plant
+type problemReportRequest struct {
+	ProblemID string  `json:"problem_id"`
+	Notes     *string `json:"notes,omitempty"`
+}
+
+// ReportProblem handles POST /api/v1/garden-plants/:id/problems
+// Links a catalogue problem to a plant marked diseased and returns the garden's outbreak
+// of that problem with the susceptible plants at risk.
+func (h *OutbreakHandler) ReportProblem(w http.ResponseWriter, r *http.Request) {
+	gardenPlantID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenPlantID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req problemReportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+	if err := utils.ValidateUUID(req.ProblemID); err != nil {
+		utils.RespondValidationError(w, "problem_id", err.Error())
+		return
+	}
+
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	gardenID, err := h.service.ResolvePlantGarden(r.Context(), gardenPlantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	outbreak, err := h.service.ReportProblem(r.Context(), gardenPlantID, &entity.ProblemReport{
+		ProblemID:  req.ProblemID,
+		Notes:      req.Notes,
+		ReportedBy: userID,
+	})
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, outbreak)
+}
+
+// ListProblemReports handles GET /api/v1/garden-plants/:id/problems
+func (h *OutbreakHandler) ListProblemReports(w http.ResponseWriter, r *http.Request) {
+	gardenPlantID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenPlantID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	gardenID, err := h.service.ResolvePlantGarden(r.Context(), gardenPlantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	reports, err := h.service.ListReports(r.Context(), gardenPlantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, reports, nil)
+}
+
+// DeleteProblemReport handles DELETE /api/v1/garden-plants/:id/problems/:reportId
+func (h *OutbreakHandler) DeleteProblemReport(w http.ResponseWriter, r *http.Request) {
+	gardenPlantID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenPlantID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+	reportID := utils.GetPathParam(r, "reportId")
+	if err := utils.ValidateUUID(reportID); err != nil {
+		utils.RespondValidationError(w, "reportId", err.Error())
+		return
+	}
+
+	gardenID, err := h.service.ResolvePlantGarden(r.Context(), gardenPlantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	if err := h.service.DeleteReport(r.Context(), gardenPlantID, reportID); err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// GetGardenOutbreaks handles GET /api/v1/gardens/:id/outbreaks
+// Returns the problems reported on diseased plants, grouped by problem, with the susceptible
+// plants at risk weighted by distance. Most urgent outbreak first.
+func (h *OutbreakHandler) GetGardenOutbreaks(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	outbreaks, err := h.service.GetGardenOutbreaks(r.Context(), gardenID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, outbreaks, nil)
+}
@@ -0,0 +1,319 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/photo-service/domain/entity"
+	photoService "twigger-backend/backend/photo-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// multipartOverhead allows room for form fields and boundaries around the file
+const multipartOverhead = 1 << 20
+
+// PhotoHandler handles photo upload and retrieval HTTP requests
+type PhotoHandler struct {
+	service    photoService.PhotoService
+	authorizer *GardenAuthorizer
+}
+
+// NewPhotoHandler creates a new photo handler
+func NewPhotoHandler(service photoService.PhotoService, authorizer *GardenAuthorizer) *PhotoHandler {
+	return &PhotoHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// UploadJournalEntryPhoto handles POST /api/v1/journal/:id/photos (multipart field "file")
+func (h *PhotoHandler) UploadJournalEntryPhoto(w http.ResponseWriter, r *http.Request) {
+	h.upload(w, r, entity.OwnerJournalEntry)
+}
+
+// ListJournalEntryPhotos handles GET /api/v1/journal/:id/photos
+func (h *PhotoHandler) ListJournalEntryPhotos(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, entity.OwnerJournalEntry)
+}
+
+// UploadGardenPlantPhoto handles POST /api/v1/garden-plants/:id/photos (multipart field "file")
+func (h *PhotoHandler) UploadGardenPlantPhoto(w http.ResponseWriter, r *http.Request) {
+	h.upload(w, r, entity.OwnerGardenPlant)
+}
+
+// ListGardenPlantPhotos handles GET /api/v1/garden-plants/:id/photos
+func (h *PhotoHandler) ListGardenPlantPhotos(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, entity.OwnerGardenPlant)
+}
+
+// UploadPlantProblemPhoto handles POST /api/v1/plant-problems/:id/photos (multipart field "file")
+func (h *PhotoHandler) UploadPlantProblemPhoto(w http.ResponseWriter, r *http.Request) {
+	h.upload(w, r, entity.OwnerPlantProblem)
+}
+
+// ListPlantProblemPhotos handles GET /api/v1/plant-problems/:id/photos
+func (h *PhotoHandler) ListPlantProblemPhotos(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, entity.OwnerPlantProblem)
+}
+
+// GetPhoto handles GET /api/v1/photos/:id
+func (h *PhotoHandler) GetPhoto(w http.ResponseWriter, r *http.Request) {
+	photo, ok := h.authorizePhoto(w, r, authEntity.PermissionRead)
+	if !ok {
+		return
+	}
+
+	utils.RespondSuccess(w, newPhotoResponse(photo), nil)
+}
+
+// GetPhotoContent handles GET /api/v1/photos/:id/content
+func (h *PhotoHandler) GetPhotoContent(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, false)
+}
+
+// GetPhotoThumbnail handles GET /api/v1/photos/:id/thumbnail
+func (h *PhotoHandler) GetPhotoThumbnail(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, true)
+}
+
+// DeletePhoto handles DELETE /api/v1/photos/:id
+// Uploaders may delete their own photos; garden photos can also be deleted with delete permission.
+func (h *PhotoHandler) DeletePhoto(w http.ResponseWriter, r *http.Request) {
+	photoID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(photoID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	photo, err := h.service.GetPhoto(r.Context(), photoID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	isUploader := photo.UploadedBy == userID
+	if photo.GardenID != nil {
+		perm := authEntity.PermissionDelete
+		if isUploader {
+			perm = authEntity.PermissionEdit
+		}
+		if _, ok := h.authorizer.AuthorizeGarden(w, r, *photo.GardenID, perm); !ok {
+			return
+		}
+	} else if !isUploader {
+		utils.RespondForbidden(w, "Only the uploader can delete this photo")
+		return
+	}
+
+	if err := h.service.DeletePhoto(r.Context(), photoID); err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// upload reads a multipart image and attaches it to the owner in the path
+func (h *PhotoHandler) upload(w http.ResponseWriter, r *http.Request, ownerType entity.OwnerType) {
+	ownerID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(ownerID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, photoService.MaxPhotoBytes+multipartOverhead)
+	if err := r.ParseMultipartForm(photoService.MaxPhotoBytes + multipartOverhead); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			respondPhotoTooLarge(w)
+			return
+		}
+		utils.RespondValidationError(w, "body", "Request must be multipart/form-data")
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		utils.RespondValidationError(w, "file", "File is required")
+		return
+	}
+	defer file.Close()
+
+	if header.Size > photoService.MaxPhotoBytes {
+		respondPhotoTooLarge(w)
+		return
+	}
+
+	// The declared type is checked here; the service also sniffs the actual bytes
+	if declared := header.Header.Get("Content-Type"); declared != "" && !entity.IsAllowedContentType(declared) {
+		utils.RespondValidationError(w, "file", "File must be a JPEG or PNG image")
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, photoService.MaxPhotoBytes+1))
+	if err != nil {
+		utils.RespondValidationError(w, "file", "Failed to read file")
+		return
+	}
+
+	userID, ok := h.authorizeOwner(w, r, ownerType, ownerID, authEntity.PermissionEdit)
+	if !ok {
+		return
+	}
+
+	input := &photoService.UploadPhotoInput{
+		OwnerType:  ownerType,
+		OwnerID:    ownerID,
+		UploadedBy: userID,
+		Data:       data,
+	}
+	if header.Filename != "" {
+		input.OriginalFilename = &header.Filename
+	}
+	if caption := r.FormValue("caption"); caption != "" {
+		input.Caption = &caption
+	}
+
+	photo, err := h.service.UploadPhoto(r.Context(), input)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, newPhotoResponse(photo))
+}
+
+// list returns the photos attached to the owner in the path
+func (h *PhotoHandler) list(w http.ResponseWriter, r *http.Request, ownerType entity.OwnerType) {
+	ownerID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(ownerID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	if _, ok := h.authorizeOwner(w, r, ownerType, ownerID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	photos, err := h.service.ListPhotos(r.Context(), ownerType, ownerID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	responses := make([]*photoResponse, len(photos))
+	for i, photo := range photos {
+		responses[i] = newPhotoResponse(photo)
+	}
+
+	utils.RespondSuccess(w, responses, nil)
+}
+
+// serve streams a stored image or its thumbnail
+func (h *PhotoHandler) serve(w http.ResponseWriter, r *http.Request, thumbnail bool) {
+	photo, ok := h.authorizePhoto(w, r, authEntity.PermissionRead)
+	if !ok {
+		return
+	}
+
+	reader, err := h.service.OpenPhoto(r.Context(), photo, thumbnail)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+	defer reader.Close()
+
+	contentType := photo.ContentType
+	if thumbnail {
+		contentType = entity.ContentTypeJPEG
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(w, reader)
+}
+
+// authorizeOwner checks permission on the owner's garden. Plant problem photos are
+// shared catalogue data: any signed-in user may view them, and the router limits
+// uploads to admins.
+// Returns the caller's user ID.
+func (h *PhotoHandler) authorizeOwner(w http.ResponseWriter, r *http.Request, ownerType entity.OwnerType, ownerID string, perm authEntity.Permission) (string, bool) {
+	gardenID, err := h.service.ResolveOwnerGarden(r.Context(), ownerType, ownerID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return "", false
+	}
+
+	if gardenID != nil {
+		if _, ok := h.authorizer.AuthorizeGarden(w, r, *gardenID, perm); !ok {
+			return "", false
+		}
+	}
+
+	return h.authorizer.CurrentUserID(w, r)
+}
+
+// authorizePhoto loads the photo from the path and checks permission on its garden.
+// Plant problem photos only need a signed-in user.
+func (h *PhotoHandler) authorizePhoto(w http.ResponseWriter, r *http.Request, perm authEntity.Permission) (*entity.Photo, bool) {
+	photoID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(photoID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return nil, false
+	}
+
+	photo, err := h.service.GetPhoto(r.Context(), photoID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return nil, false
+	}
+
+	if photo.GardenID != nil {
+		if _, ok := h.authorizer.AuthorizeGarden(w, r, *photo.GardenID, perm); !ok {
+			return nil, false
+		}
+		return photo, true
+	}
+
+	if _, ok := h.authorizer.CurrentUserID(w, r); !ok {
+		return nil, false
+	}
+
+	return photo, true
+}
+
+// respondPhotoTooLarge writes a 413 response for oversized uploads
+func respondPhotoTooLarge(w http.ResponseWriter) {
+	utils.RespondJSON(w, http.StatusRequestEntityTooLarge, utils.ErrorResponse{
+		Error:   "payload_too_large",
+		Code:    "PAYLOAD_TOO_LARGE",
+		Message: fmt.Sprintf("Photos must be %d MB or smaller", photoService.MaxPhotoBytes>>20),
+	})
+}
+
+// Response DTOs
+type photoResponse struct {
+	*entity.Photo
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// newPhotoResponse adds the gateway URLs images are served from
+func newPhotoResponse(photo *entity.Photo) *photoResponse {
+	return &photoResponse{
+		Photo:        photo,
+		URL:          "/api/v1/photos/" + photo.PhotoID + "/content",
+		ThumbnailURL: "/api/v1/photos/" + photo.PhotoID + "/thumbnail",
+	}
+}
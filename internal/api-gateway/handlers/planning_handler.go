@@ -0,0 +1,269 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/planning-service/domain/entity"
+	planningService "twigger-backend/backend/planning-service/domain/service"
+	"twigger-backend/backend/plant-service/pkg/types"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// PlanningHandler handles garden layout planning HTTP requests
+type PlanningHandler struct {
+	service               planningService.PlacementAdvisorService
+	fillService           planningService.ZoneFillService
+	recommendationService planningService.RecommendationService
+	suitabilityService    planningService.SuitabilityService
+	regionalStatusService planningService.RegionalStatusService
+	authorizer            *GardenAuthorizer
+}
+
+// NewPlanningHandler creates a new planning handler
+func NewPlanningHandler(
+	service planningService.PlacementAdvisorService,
+	fillService planningService.ZoneFillService,
+	recommendationService planningService.RecommendationService,
+	suitabilityService planningService.SuitabilityService,
+	regionalStatusService planningService.RegionalStatusService,
+	authorizer *GardenAuthorizer,
+) *PlanningHandler {
+	return &PlanningHandler{
+		service:               service,
+		fillService:           fillService,
+		recommendationService: recommendationService,
+		suitabilityService:    suitabilityService,
+		regionalStatusService: regionalStatusService,
+		authorizer:            authorizer,
+	}
+}
+
+// GetLayoutReport handles GET /api/v1/gardens/:id/layout-report
+// Lists every pair of plants growing closer than their mature spreads allow.
+func (h *PlanningHandler) GetLayoutReport(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	report, err := h.service.GetLayoutReport(r.Context(), gardenID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, report, nil)
+}
+
+// GetRegionalStatusReport handles GET /api/v1/gardens/:id/regional-status
+// Lists plants in the garden that are prohibited, restricted or invasive in its country.
+func (h *PlanningHandler) GetRegionalStatusReport(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	report, err := h.regionalStatusService.GetRegionalStatusReport(r.Context(), gardenID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, report, nil)
+}
+
+// FillZone handles POST /api/v1/zones/:id/fill
+// Lays out a square, hex or rows grid of one plant inside the zone and places it.
+// With dry_run the proposed points are returned without placing anything.
+func (h *PlanningHandler) FillZone(w http.ResponseWriter, r *http.Request) {
+	zoneID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(zoneID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req zoneFillRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	if err := utils.ValidateUUID(req.PlantID); err != nil {
+		utils.RespondValidationError(w, "plant_id", err.Error())
+		return
+	}
+
+	// Previewing a layout changes nothing
+	perm := authEntity.PermissionEdit
+	if req.DryRun {
+		perm = authEntity.PermissionRead
+	}
+	if _, ok := h.authorizer.AuthorizeZone(w, r, zoneID, perm); !ok {
+		return
+	}
+
+	plan, err := h.fillService.FillZone(r.Context(), zoneID, &entity.ZoneFillOptions{
+		PlantID:     req.PlantID,
+		Pattern:     entity.GridPattern(req.Pattern),
+		SpacingM:    req.SpacingM,
+		RowSpacingM: req.RowSpacingM,
+		DryRun:      req.DryRun,
+	})
+	if err != nil {
+		respondPlacementError(w, err)
+		return
+	}
+
+	if plan.DryRun {
+		utils.RespondSuccess(w, plan, nil)
+		return
+	}
+	utils.RespondCreated(w, plan)
+}
+
+// GetZoneRecommendations handles GET /api/v1/zones/:id/recommendations
+// Ranks plants suited to the zone's sun, soil and irrigation and the garden's climate.
+func (h *PlanningHandler) GetZoneRecommendations(w http.ResponseWriter, r *http.Request) {
+	zoneID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(zoneID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	limit := utils.GetQueryParamInt(r, "limit", 10)
+	limit = utils.ValidateLimit(limit, entity.MaxRecommendations)
+
+	if _, ok := h.authorizer.AuthorizeZone(w, r, zoneID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	recommendations, err := h.recommendationService.RecommendForZone(r.Context(), zoneID, limit)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, recommendations, nil)
+}
+
+// GetGardenSuitability handles GET /api/v1/gardens/:id/suitability?plant_id=
+// Scores how well a plant suits the garden, with a per-factor breakdown.
+func (h *PlanningHandler) GetGardenSuitability(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	req, ok := parseSuitabilityRequest(w, r)
+	if !ok {
+		return
+	}
+	req.GardenID = gardenID
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	score, err := h.suitabilityService.ScoreSuitability(r.Context(), req)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, score, nil)
+}
+
+// GetZoneSuitability handles GET /api/v1/zones/:id/suitability?plant_id=
+// Scores how well a plant suits the zone, adding its sun, soil and irrigation to the garden's climate.
+func (h *PlanningHandler) GetZoneSuitability(w http.ResponseWriter, r *http.Request) {
+	zoneID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(zoneID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	req, ok := parseSuitabilityRequest(w, r)
+	if !ok {
+		return
+	}
+	req.ZoneID = &zoneID
+
+	if _, ok := h.authorizer.AuthorizeZone(w, r, zoneID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	score, err := h.suitabilityService.ScoreSuitability(r.Context(), req)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, score, nil)
+}
+
+// parseSuitabilityRequest reads the plant and optional site observations from the query string
+func parseSuitabilityRequest(w http.ResponseWriter, r *http.Request) (*entity.SuitabilityRequest, bool) {
+	req := &entity.SuitabilityRequest{PlantID: utils.GetQueryParam(r, "plant_id")}
+	if err := utils.ValidateUUID(req.PlantID); err != nil {
+		utils.RespondValidationError(w, "plant_id", err.Error())
+		return nil, false
+	}
+
+	if value := utils.GetQueryParam(r, "soil_ph"); value != "" {
+		ph, err := utils.ParseFloat64(value)
+		if err != nil {
+			utils.RespondValidationError(w, "soil_ph", "soil_ph must be a number")
+			return nil, false
+		}
+		req.SoilPH = &ph
+	}
+
+	if value := utils.GetQueryParam(r, "soil_drainage"); value != "" {
+		drainage := types.SoilDrainage(value)
+		req.SoilDrainage = &drainage
+	}
+
+	for _, param := range []struct {
+		name   string
+		target **bool
+	}{
+		{"salt_exposed", &req.SaltExposed},
+		{"wind_exposed", &req.WindExposed},
+	} {
+		value := utils.GetQueryParam(r, param.name)
+		if value == "" {
+			continue
+		}
+		exposed, err := strconv.ParseBool(value)
+		if err != nil {
+			utils.RespondValidationError(w, param.name, param.name+" must be true or false")
+			return nil, false
+		}
+		*param.target = &exposed
+	}
+
+	return req, true
+}
+
+// Request DTOs
+type zoneFillRequest struct {
+	PlantID     string   `json:"plant_id"`
+	Pattern     string   `json:"pattern"`                 // square, hex or rows
+	SpacingM    *float64 `json:"spacing_m,omitempty"`     // Defaults to the plant's mature spread
+	RowSpacingM *float64 `json:"row_spacing_m,omitempty"` // Rows pattern only
+	DryRun      bool     `json:"dry_run"`
+}
@@ -15,13 +15,17 @@ import (
 
 // PlantHandler handles plant-related HTTP requests
 type PlantHandler struct {
-	service *plantService.PlantService
+	service            *plantService.PlantService
+	consensusService   *plantService.ConsensusService
+	descriptionService *plantService.DescriptionService
 }
 
 // NewPlantHandler creates a new plant handler
-func NewPlantHandler(service *plantService.PlantService) *PlantHandler {
+func NewPlantHandler(service *plantService.PlantService, consensusService *plantService.ConsensusService, descriptionService *plantService.DescriptionService) *PlantHandler {
 	return &PlantHandler{
-		service: service,
+		service:            service,
+		consensusService:   consensusService,
+		descriptionService: descriptionService,
 	}
 }
 
@@ -32,7 +36,7 @@ func NewPlantHandler(service *plantService.PlantService) *PlantHandler {
 // @Accept json
 // @Produce json
 // @Param id path string true "Plant ID (UUID)"
-// @Param include_details query boolean false "Include detailed characteristics" default(false)
+// @Param include_details query boolean false "Include detailed characteristics and the localized description and care guide" default(false)
 // @Param country_id query string false "Country ID for region-specific growing conditions"
 // @Header 200 {string} Accept-Language "Language for localized plant names (e.g., 'en', 'es', 'fr')"
 // @Success 200 {object} utils.SuccessResponse "Plant details"
@@ -57,7 +61,7 @@ func (h *PlantHandler) GetPlant(w http.ResponseWriter, r *http.Request) {
 	// Get plant with language context
 	// Note: We need to update the service to accept language context
 	// For now, the service uses hardcoded "en", but this will be fixed
-	var plant interface{}
+	var plant *entity.Plant
 	var err error
 
 	if countryID != "" {
@@ -71,6 +75,15 @@ func (h *PlantHandler) GetPlant(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Description sections fall back to English when missing in the requested language
+	if includeDetails {
+		plant.Guide, err = h.descriptionService.GetGuide(r.Context(), plantID, requestLocale(r))
+		if err != nil {
+			utils.RespondError(w, err)
+			return
+		}
+	}
+
 	utils.RespondSuccess(w, plant, nil)
 }
 
@@ -80,7 +93,7 @@ func (h *PlantHandler) GetPlant(w http.ResponseWriter, r *http.Request) {
 // @Tags plants
 // @Accept json
 // @Produce json
-// @Param q query string false "Search query (matches common names and botanical names)"
+// @Param q query string false "Search query (matches common names, botanical names and deprecated synonyms)"
 // @Param limit query integer false "Maximum number of results" default(20) maximum(100)
 // @Param cursor query string false "Pagination cursor from previous response"
 // @Param min_height query number false "Minimum mature height in meters"
@@ -194,6 +207,120 @@ func (h *PlantHandler) GetCompanions(w http.ResponseWriter, r *http.Request) {
 	utils.RespondSuccess(w, companions, nil)
 }
 
+// GetGrowingConditionsConsensus handles GET /api/v1/plants/:id/growing-conditions
+// @Summary Get consensus growing conditions
+// @Description Merge every data source's growing conditions for a plant in a country, weighted by source reliability and confidence, and flag conflicts between sources
+// @Tags plants
+// @Accept json
+// @Produce json
+// @Param id path string true "Plant ID (UUID)"
+// @Param country_id query string true "Country ID (UUID)"
+// @Success 200 {object} utils.SuccessResponse{data=entity.GrowingConditionsConsensus} "Merged growing conditions with conflicts"
+// @Failure 400 {object} utils.ErrorResponse "Invalid plant or country ID"
+// @Failure 404 {object} utils.ErrorResponse "Plant not found"
+// @Failure 500 {object} utils.ErrorResponse "Internal server error"
+// @Router /plants/{id}/growing-conditions [get]
+func (h *PlantHandler) GetGrowingConditionsConsensus(w http.ResponseWriter, r *http.Request) {
+	plantID, countryID, ok := parseGrowingConditionsParams(w, r)
+	if !ok {
+		return
+	}
+
+	consensus, err := h.consensusService.GetConsensus(r.Context(), plantID, countryID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, consensus, nil)
+}
+
+// GetGrowingConditionsProvenance handles GET /api/v1/plants/:id/growing-conditions/sources
+// @Summary Get growing conditions by source
+// @Description List each data source's growing conditions for a plant in a country with its weight and the fields where it disagrees with the consensus
+// @Tags plants
+// @Accept json
+// @Produce json
+// @Param id path string true "Plant ID (UUID)"
+// @Param country_id query string true "Country ID (UUID)"
+// @Success 200 {object} utils.SuccessResponse{data=entity.GrowingConditionsProvenance} "Per-source growing conditions"
+// @Failure 400 {object} utils.ErrorResponse "Invalid plant or country ID"
+// @Failure 404 {object} utils.ErrorResponse "Plant not found"
+// @Failure 500 {object} utils.ErrorResponse "Internal server error"
+// @Router /plants/{id}/growing-conditions/sources [get]
+func (h *PlantHandler) GetGrowingConditionsProvenance(w http.ResponseWriter, r *http.Request) {
+	plantID, countryID, ok := parseGrowingConditionsParams(w, r)
+	if !ok {
+		return
+	}
+
+	provenance, err := h.consensusService.GetProvenance(r.Context(), plantID, countryID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, provenance, nil)
+}
+
+// parseGrowingConditionsParams validates the plant ID path parameter and the required
+// country_id query parameter, responding with a validation error if either is invalid
+func parseGrowingConditionsParams(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	plantID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(plantID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return "", "", false
+	}
+
+	countryID := utils.GetQueryParam(r, "country_id")
+	if countryID == "" {
+		utils.RespondValidationError(w, "country_id", "country_id is required")
+		return "", "", false
+	}
+	if err := utils.ValidateUUID(countryID); err != nil {
+		utils.RespondValidationError(w, "country_id", err.Error())
+		return "", "", false
+	}
+
+	return plantID, countryID, true
+}
+
+// GetPlantByName handles GET /api/v1/plants/by-name/:name
+// @Summary Get plant by botanical name
+// @Description Get a plant by its exact botanical name. Deprecated names resolve to the current accepted plant, which is returned with matched_synonym set and a Content-Location header pointing at its canonical URL.
+// @Tags plants
+// @Accept json
+// @Produce json
+// @Param name path string true "Botanical name, current or deprecated (e.g., 'Aster novae-angliae')"
+// @Header 200 {string} Content-Location "Canonical plant URL when the name was a synonym"
+// @Success 200 {object} utils.SuccessResponse{data=entity.Plant} "Plant details"
+// @Failure 400 {object} utils.ErrorResponse "Invalid plant name"
+// @Failure 404 {object} utils.ErrorResponse "Plant not found"
+// @Failure 500 {object} utils.ErrorResponse "Internal server error"
+// @Router /plants/by-name/{name} [get]
+func (h *PlantHandler) GetPlantByName(w http.ResponseWriter, r *http.Request) {
+	name := utils.GetPathParam(r, "name")
+	if name == "" {
+		utils.RespondValidationError(w, "name", "Plant name is required")
+		return
+	}
+
+	// Extract language context (TODO: pass to service when language param added)
+	_ = utils.ExtractLanguageContext(r)
+
+	plant, err := h.service.FindByBotanicalName(r.Context(), name)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if plant.MatchedSynonym != nil {
+		w.Header().Set("Content-Location", "/api/v1/plants/"+plant.PlantID)
+	}
+
+	utils.RespondSuccess(w, plant, nil)
+}
+
 // FindByFamily handles GET /api/v1/plants/family/:name
 // @Summary Find plants by family
 // @Description Get all plants belonging to a specific plant family
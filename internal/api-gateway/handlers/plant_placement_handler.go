@@ -2,22 +2,29 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strings"
+	"time"
 
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
 	gardenService "twigger-backend/backend/garden-service/domain/service"
 	"twigger-backend/backend/garden-service/domain/entity"
+	"twigger-backend/backend/garden-service/domain/repository"
 	"twigger-backend/internal/api-gateway/utils"
 )
 
 // PlantPlacementHandler handles plant placement-related HTTP requests
 type PlantPlacementHandler struct {
-	service gardenService.PlantPlacementService
+	service    gardenService.PlantPlacementService
+	authorizer *GardenAuthorizer
 }
 
 // NewPlantPlacementHandler creates a new plant placement handler
-func NewPlantPlacementHandler(service gardenService.PlantPlacementService) *PlantPlacementHandler {
+func NewPlantPlacementHandler(service gardenService.PlantPlacementService, authorizer *GardenAuthorizer) *PlantPlacementHandler {
 	return &PlantPlacementHandler{
-		service: service,
+		service:    service,
+		authorizer: authorizer,
 	}
 }
 
@@ -35,6 +42,10 @@ func (h *PlantPlacementHandler) PlacePlant(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
 	gardenPlant := &entity.GardenPlant{
 		GardenID:       gardenID,
 		PlantID:        req.PlantID,
@@ -42,11 +53,12 @@ func (h *PlantPlacementHandler) PlacePlant(w http.ResponseWriter, r *http.Reques
 		ZoneID:         req.ZoneID,
 		Quantity:       req.Quantity,
 		Notes:          req.Notes,
+		RejectAntagonists: req.RejectAntagonists,
 	}
 
 	created, err := h.service.PlacePlant(r.Context(), gardenPlant)
 	if err != nil {
-		utils.RespondError(w, err)
+		respondPlacementError(w, err)
 		return
 	}
 
@@ -67,6 +79,10 @@ func (h *PlantPlacementHandler) BulkPlacePlants(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
 	// Build garden plants from request
 	var gardenPlants []*entity.GardenPlant
 	for _, plant := range req.Plants {
@@ -77,12 +93,13 @@ func (h *PlantPlacementHandler) BulkPlacePlants(w http.ResponseWriter, r *http.R
 			ZoneID:         plant.ZoneID,
 			Quantity:       plant.Quantity,
 			Notes:          plant.Notes,
+			RejectAntagonists: plant.RejectAntagonists,
 		})
 	}
 
 	created, err := h.service.BulkPlacePlants(r.Context(), gardenPlants)
 	if err != nil {
-		utils.RespondError(w, err)
+		respondPlacementError(w, err)
 		return
 	}
 
@@ -97,6 +114,10 @@ func (h *PlantPlacementHandler) ListGardenPlants(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
 	// Build optional filter
 	filter := &gardenService.GardenPlantFilter{
 		ActiveOnly: utils.GetQueryParamBool(r, "active_only", false),
@@ -134,6 +155,10 @@ func (h *PlantPlacementHandler) GetGardenPlant(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenPlant.GardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
 	utils.RespondSuccess(w, gardenPlant, nil)
 }
 
@@ -158,6 +183,10 @@ func (h *PlantPlacementHandler) UpdatePlantPlacement(w http.ResponseWriter, r *h
 		return
 	}
 
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, existing.GardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
 	// Update fields
 	if req.LocationGeoJSON != nil {
 		existing.LocationGeoJSON = *req.LocationGeoJSON
@@ -171,6 +200,10 @@ func (h *PlantPlacementHandler) UpdatePlantPlacement(w http.ResponseWriter, r *h
 	if req.Notes != nil {
 		existing.Notes = req.Notes
 	}
+	if req.HealthStatus != nil {
+		healthStatus := entity.HealthStatus(*req.HealthStatus)
+		existing.HealthStatus = &healthStatus
+	}
 
 	updated, err := h.service.UpdatePlantPlacement(r.Context(), existing)
 	if err != nil {
@@ -189,6 +222,16 @@ func (h *PlantPlacementHandler) RemovePlant(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	gardenPlant, err := h.service.GetGardenPlant(r.Context(), gardenPlantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenPlant.GardenID, authEntity.PermissionDelete); !ok {
+		return
+	}
+
 	if err := h.service.RemovePlant(r.Context(), gardenPlantID); err != nil {
 		utils.RespondError(w, err)
 		return
@@ -197,6 +240,122 @@ func (h *PlantPlacementHandler) RemovePlant(w http.ResponseWriter, r *http.Reque
 	utils.RespondNoContent(w)
 }
 
+// GetPlantHistory handles GET /api/v1/garden-plants/:id/history?type=watered,harvested
+// Removed plants keep their history.
+func (h *PlantPlacementHandler) GetPlantHistory(w http.ResponseWriter, r *http.Request) {
+	gardenPlantID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenPlantID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	limit := utils.GetQueryParamInt(r, "limit", 50)
+	limit = utils.ValidateLimit(limit, 200)
+	offset := utils.GetQueryParamInt(r, "offset", 0)
+
+	filter := &repository.GardenPlantEventFilter{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	// Types may be repeated (?type=a&type=b) or comma separated
+	for _, value := range r.URL.Query()["type"] {
+		for _, eventType := range strings.Split(value, ",") {
+			filter.EventTypes = append(filter.EventTypes, entity.GardenPlantEventType(strings.TrimSpace(eventType)))
+		}
+	}
+
+	gardenID, err := h.service.ResolvePlantGarden(r.Context(), gardenPlantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	history, err := h.service.GetPlantHistory(r.Context(), gardenPlantID, filter)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	meta := &utils.Meta{
+		HasMore: filter.Offset+len(history.Events) < history.Total,
+		Limit:   filter.Limit,
+		Total:   &history.Total,
+	}
+
+	utils.RespondSuccess(w, history, meta)
+}
+
+// RecordPlantEvent handles POST /api/v1/garden-plants/:id/history
+// Only care events (watered, harvested) can be logged directly.
+func (h *PlantPlacementHandler) RecordPlantEvent(w http.ResponseWriter, r *http.Request) {
+	gardenPlantID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenPlantID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req plantEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	input := &gardenService.PlantEventInput{
+		EventType: entity.GardenPlantEventType(req.EventType),
+		Notes:     req.Notes,
+		Details:   req.Details,
+	}
+	if req.OccurredAt != nil {
+		occurredAt, err := time.Parse(time.RFC3339, *req.OccurredAt)
+		if err != nil {
+			utils.RespondValidationError(w, "occurred_at", "occurred_at must be an RFC 3339 timestamp")
+			return
+		}
+		input.OccurredAt = &occurredAt
+	}
+
+	gardenPlant, err := h.service.GetGardenPlant(r.Context(), gardenPlantID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenPlant.GardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	event, err := h.service.RecordPlantEvent(r.Context(), gardenPlantID, input)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, event)
+}
+
+// respondPlacementError writes a rejected placement as 409 Conflict with the warnings
+// that caused it, and any other error through the standard mapping
+func respondPlacementError(w http.ResponseWriter, err error) {
+	var rejected *entity.PlacementRejectedError
+	if errors.As(err, &rejected) {
+		utils.RespondJSON(w, http.StatusConflict, utils.ErrorResponse{
+			Error:   "placement_rejected",
+			Code:    "PLACEMENT_REJECTED",
+			Message: rejected.Message,
+			Details: map[string]interface{}{
+				"warnings": rejected.Warnings,
+			},
+		})
+		return
+	}
+	utils.RespondError(w, err)
+}
+
 // Request DTOs
 type placePlantRequest struct {
 	PlantID        string  `json:"plant_id"`
@@ -204,6 +363,9 @@ type placePlantRequest struct {
 	ZoneID         *string `json:"zone_id,omitempty"`
 	Quantity       int     `json:"quantity"`
 	Notes          *string `json:"notes,omitempty"`
+
+	// Reject the placement instead of warning when antagonistic plants are nearby
+	RejectAntagonists bool `json:"reject_antagonists,omitempty"`
 }
 
 type bulkPlacePlantsRequest struct {
@@ -215,4 +377,12 @@ type updatePlantPlacementRequest struct {
 	ZoneID          *string `json:"zone_id,omitempty"`
 	Quantity        *int    `json:"quantity,omitempty"`
 	Notes           *string `json:"notes,omitempty"`
+	HealthStatus    *string `json:"health_status,omitempty"`
+}
+
+type plantEventRequest struct {
+	EventType  string                 `json:"event_type"`            // watered or harvested
+	OccurredAt *string                `json:"occurred_at,omitempty"` // RFC 3339, defaults to now
+	Notes      *string                `json:"notes,omitempty"`
+	Details    map[string]interface{} `json:"details,omitempty"`
 }
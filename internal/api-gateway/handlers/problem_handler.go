@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"twigger-backend/backend/plant-service/domain/repository"
+	plantService "twigger-backend/backend/plant-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// ProblemHandler handles HTTP requests for the plant pest, disease and deficiency catalogue
+type ProblemHandler struct {
+	service *plantService.ProblemService
+}
+
+// NewProblemHandler creates a new plant problem handler
+func NewProblemHandler(service *plantService.ProblemService) *ProblemHandler {
+	return &ProblemHandler{
+		service: service,
+	}
+}
+
+// ListPlantProblems handles GET /api/v1/plants/:id/problems?type=&limit=&offset=
+func (h *ProblemHandler) ListPlantProblems(w http.ResponseWriter, r *http.Request) {
+	plantID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(plantID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	limit := utils.ValidateLimit(utils.GetQueryParamInt(r, "limit", 50), 100)
+	offset := utils.GetQueryParamInt(r, "offset", 0)
+
+	problems, err := h.service.ListPlantProblems(r.Context(), plantID, utils.GetQueryParam(r, "type"), requestLocale(r), limit, offset)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, problems, nil)
+}
+
+// SearchProblems handles GET /api/v1/plant-problems?q=&type=&severity=&part=&month=&plant_id=&limit=&offset=
+func (h *ProblemHandler) SearchProblems(w http.ResponseWriter, r *http.Request) {
+	filter := &repository.ProblemSearchFilter{
+		Query:  utils.GetQueryParam(r, "q"),
+		Limit:  utils.ValidateLimit(utils.GetQueryParamInt(r, "limit", 20), 100),
+		Offset: utils.GetQueryParamInt(r, "offset", 0),
+	}
+
+	plantID, ok := optionalCatalogID(w, r, "plant_id")
+	if !ok {
+		return
+	}
+	if plantID != "" {
+		filter.PlantID = &plantID
+	}
+	if problemType := utils.GetQueryParam(r, "type"); problemType != "" {
+		filter.ProblemType = &problemType
+	}
+	if severity := utils.GetQueryParam(r, "severity"); severity != "" {
+		filter.Severity = &severity
+	}
+	if part := utils.GetQueryParam(r, "part"); part != "" {
+		filter.AffectedPart = &part
+	}
+	if monthParam := utils.GetQueryParam(r, "month"); monthParam != "" {
+		month, err := strconv.Atoi(monthParam)
+		if err != nil {
+			utils.RespondValidationError(w, "month", "Month must be a number between 1 and 12")
+			return
+		}
+		filter.Month = &month
+	}
+
+	result, err := h.service.SearchProblems(r.Context(), filter, requestLocale(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	total := int(result.Total)
+	utils.RespondSuccess(w, result.Problems, &utils.Meta{
+		HasMore: result.HasMore,
+		Limit:   result.Limit,
+		Total:   &total,
+	})
+}
+
+// GetProblem handles GET /api/v1/plant-problems/:id
+func (h *ProblemHandler) GetProblem(w http.ResponseWriter, r *http.Request) {
+	problemID, ok := catalogID(w, r)
+	if !ok {
+		return
+	}
+
+	problem, err := h.service.GetProblem(r.Context(), problemID, requestLocale(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, problem, nil)
+}
+
+// requestLocale reads the requested language and country from the user's preferences or
+// the Accept-Language header
+func requestLocale(r *http.Request) plantService.Locale {
+	langCtx := utils.ExtractLanguageContext(r)
+	return plantService.Locale{
+		LanguageCode: langCtx.LanguageID,
+		CountryCode:  langCtx.CountryID,
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	rotationService "twigger-backend/backend/rotation-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// RotationHandler handles crop rotation planning HTTP requests
+type RotationHandler struct {
+	service    rotationService.RotationService
+	authorizer *GardenAuthorizer
+}
+
+// NewRotationHandler creates a new rotation handler
+func NewRotationHandler(service rotationService.RotationService, authorizer *GardenAuthorizer) *RotationHandler {
+	return &RotationHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// PlanZone handles GET /api/v1/zones/:id/rotation?year=&gap_years=&plant_id=
+// Returns the zone's planting history and candidate plants scored for the target season.
+func (h *RotationHandler) PlanZone(w http.ResponseWriter, r *http.Request) {
+	zoneID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(zoneID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	options := &rotationService.PlanOptions{
+		Year: utils.GetQueryParamInt(r, "year", 0),
+	}
+
+	if gapStr := utils.GetQueryParam(r, "gap_years"); gapStr != "" {
+		gapYears, err := strconv.Atoi(gapStr)
+		if err != nil {
+			utils.RespondValidationError(w, "gap_years", "gap_years must be a whole number")
+			return
+		}
+		options.GapYears = &gapYears
+	}
+
+	// Candidate plants may be repeated (?plant_id=a&plant_id=b) or comma separated
+	for _, value := range r.URL.Query()["plant_id"] {
+		for _, plantID := range strings.Split(value, ",") {
+			if plantID = strings.TrimSpace(plantID); plantID == "" {
+				continue
+			}
+			if err := utils.ValidateUUID(plantID); err != nil {
+				utils.RespondValidationError(w, "plant_id", err.Error())
+				return
+			}
+			options.PlantIDs = append(options.PlantIDs, plantID)
+		}
+	}
+
+	if _, ok := h.authorizer.AuthorizeZone(w, r, zoneID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	plan, err := h.service.PlanZone(r.Context(), zoneID, options)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, plan, nil)
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	gardenService "twigger-backend/backend/garden-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// ShadeHandler handles shade simulation HTTP requests
+type ShadeHandler struct {
+	service    gardenService.ShadeService
+	authorizer *GardenAuthorizer
+}
+
+// NewShadeHandler creates a new shade handler
+func NewShadeHandler(service gardenService.ShadeService, authorizer *GardenAuthorizer) *ShadeHandler {
+	return &ShadeHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// GetShadows handles GET /api/v1/gardens/:id/shade?date=YYYY-MM-DD
+func (h *ShadeHandler) GetShadows(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	// Default to today
+	date := time.Now().UTC()
+	if dateStr := utils.GetQueryParam(r, "date"); dateStr != "" {
+		parsed, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			utils.RespondValidationError(w, "date", "Date must be in YYYY-MM-DD format")
+			return
+		}
+		date = parsed
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	shadows, err := h.service.CalculateShadows(r.Context(), gardenID, date)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, shadows, nil)
+}
+
+// GetZoneSunHours handles GET /api/v1/gardens/:id/shade/sun-hours
+func (h *ShadeHandler) GetZoneSunHours(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	analysis, err := h.service.CalculateZoneSunHours(r.Context(), gardenID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, analysis, nil)
+}
+
+// RecalculateZoneSunHours handles POST /api/v1/gardens/:id/shade/recalculate
+func (h *ShadeHandler) RecalculateZoneSunHours(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	analysis, err := h.service.RecalculateZoneSunHours(r.Context(), gardenID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, analysis, nil)
+}
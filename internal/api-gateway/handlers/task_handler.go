@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	"twigger-backend/backend/task-service/domain/entity"
+	"twigger-backend/backend/task-service/domain/repository"
+	taskService "twigger-backend/backend/task-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// TaskHandler handles garden care task HTTP requests
+type TaskHandler struct {
+	service    taskService.TaskService
+	authorizer *GardenAuthorizer
+}
+
+// NewTaskHandler creates a new task handler
+func NewTaskHandler(service taskService.TaskService, authorizer *GardenAuthorizer) *TaskHandler {
+	return &TaskHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// ListGardenTasks handles GET /api/v1/gardens/:id/tasks?status=&type=&due_before=YYYY-MM-DD
+func (h *TaskHandler) ListGardenTasks(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	filter := &repository.TaskFilter{
+		Limit:  utils.ValidateLimit(utils.GetQueryParamInt(r, "limit", 50), 200),
+		Offset: utils.GetQueryParamInt(r, "offset", 0),
+	}
+
+	// Optional filters
+	if s := utils.GetQueryParam(r, "status"); s != "" {
+		if s == "open" {
+			filter.OpenOnly = true
+		} else {
+			status := entity.TaskStatus(s)
+			if !status.IsValid() {
+				utils.RespondValidationError(w, "status", "Status must be one of: open, pending, snoozed, completed, skipped")
+				return
+			}
+			filter.Status = &status
+		}
+	}
+	if t := utils.GetQueryParam(r, "type"); t != "" {
+		taskType := entity.TaskType(t)
+		if !taskType.IsValid() {
+			utils.RespondValidationError(w, "type", "Type must be one of: watering, pruning, fertilizing, harvest")
+			return
+		}
+		filter.TaskType = &taskType
+	}
+	if dateStr := utils.GetQueryParam(r, "due_before"); dateStr != "" {
+		dueBefore, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			utils.RespondValidationError(w, "due_before", "Date must be in YYYY-MM-DD format")
+			return
+		}
+		filter.DueBefore = &dueBefore
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
+	tasks, err := h.service.ListGardenTasks(r.Context(), gardenID, filter)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, tasks, nil)
+}
+
+// GenerateGardenTasks handles POST /api/v1/gardens/:id/tasks/generate
+func (h *TaskHandler) GenerateGardenTasks(w http.ResponseWriter, r *http.Request) {
+	gardenID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(gardenID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
+	result, err := h.service.GenerateGardenTasks(r.Context(), gardenID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, result, nil)
+}
+
+// GetTask handles GET /api/v1/tasks/:id
+func (h *TaskHandler) GetTask(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.authorizeTask(w, r, authEntity.PermissionRead)
+	if !ok {
+		return
+	}
+
+	utils.RespondSuccess(w, task, nil)
+}
+
+// CompleteTask handles POST /api/v1/tasks/:id/complete
+func (h *TaskHandler) CompleteTask(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.authorizeTask(w, r, authEntity.PermissionEdit)
+	if !ok {
+		return
+	}
+
+	userID, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.CompleteTask(r.Context(), task.TaskID, userID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, result, nil)
+}
+
+// SnoozeTask handles POST /api/v1/tasks/:id/snooze
+// Accepts either an explicit "until" date or a number of "days" from today.
+func (h *TaskHandler) SnoozeTask(w http.ResponseWriter, r *http.Request) {
+	taskID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(taskID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return
+	}
+
+	var req snoozeTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	var until time.Time
+	switch {
+	case req.Until != nil:
+		parsed, err := time.Parse("2006-01-02", *req.Until)
+		if err != nil {
+			utils.RespondValidationError(w, "until", "Date must be in YYYY-MM-DD format")
+			return
+		}
+		until = parsed
+	case req.Days != nil:
+		if *req.Days <= 0 {
+			utils.RespondValidationError(w, "days", "Days must be positive")
+			return
+		}
+		now := time.Now()
+		until = time.Date(now.Year(), now.Month(), now.Day()+*req.Days, 0, 0, 0, 0, now.Location())
+	default:
+		utils.RespondValidationError(w, "until", "Either until or days is required")
+		return
+	}
+
+	task, ok := h.authorizeTask(w, r, authEntity.PermissionEdit)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.SnoozeTask(r.Context(), task.TaskID, until)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, result, nil)
+}
+
+// SkipTask handles POST /api/v1/tasks/:id/skip
+func (h *TaskHandler) SkipTask(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.authorizeTask(w, r, authEntity.PermissionEdit)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.SkipTask(r.Context(), task.TaskID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, result, nil)
+}
+
+// authorizeTask loads the task from the path and checks permission on its garden.
+// Writes an error response and returns false if not allowed.
+func (h *TaskHandler) authorizeTask(w http.ResponseWriter, r *http.Request, perm authEntity.Permission) (*entity.Task, bool) {
+	taskID := utils.GetPathParam(r, "id")
+	if err := utils.ValidateUUID(taskID); err != nil {
+		utils.RespondValidationError(w, "id", err.Error())
+		return nil, false
+	}
+
+	task, err := h.service.GetTask(r.Context(), taskID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return nil, false
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, task.GardenID, perm); !ok {
+		return nil, false
+	}
+
+	return task, true
+}
+
+// Request DTOs
+type snoozeTaskRequest struct {
+	Until *string `json:"until,omitempty"` // YYYY-MM-DD
+	Days  *int    `json:"days,omitempty"`
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+
+	"twigger-backend/backend/plant-service/domain/entity"
+	plantService "twigger-backend/backend/plant-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// maxTranslationImportBytes bounds the size of a bulk translation upload
+const maxTranslationImportBytes = 10 << 20
+
+// TranslationHandler handles admin HTTP requests for translation management
+type TranslationHandler struct {
+	service *plantService.TranslationService
+}
+
+// NewTranslationHandler creates a new translation handler
+func NewTranslationHandler(service *plantService.TranslationService) *TranslationHandler {
+	return &TranslationHandler{
+		service: service,
+	}
+}
+
+// GetCoverage handles GET /api/v1/admin/translations/coverage?language=
+// Returns the translated percentage per domain for each active language, least
+// translated language first.
+func (h *TranslationHandler) GetCoverage(w http.ResponseWriter, r *http.Request) {
+	coverage, err := h.service.Coverage(r.Context(), utils.GetQueryParam(r, "language"))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, coverage, nil)
+}
+
+// ListMissing handles GET /api/v1/admin/translations/missing?domain=&language=&limit=&offset=
+// Lists records with no translation in the language, with the English text to translate.
+func (h *TranslationHandler) ListMissing(w http.ResponseWriter, r *http.Request) {
+	limit := utils.ValidateLimit(utils.GetQueryParamInt(r, "limit", 100), 500)
+	offset := utils.GetQueryParamInt(r, "offset", 0)
+
+	result, err := h.service.ListMissing(r.Context(), utils.GetQueryParam(r, "domain"), utils.GetQueryParam(r, "language"), limit, offset)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	total := int(result.Total)
+	utils.RespondSuccess(w, result.Translations, &utils.Meta{
+		HasMore: result.HasMore,
+		Limit:   result.Limit,
+		Total:   &total,
+	})
+}
+
+// ImportTranslations handles POST /api/v1/admin/translations/import
+// Accepts text/csv with a header row (domain, key, field, language, country, value) or
+// JSON {"translations": [...]}. Rows that fail are reported; the rest are saved.
+func (h *TranslationHandler) ImportTranslations(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxTranslationImportBytes)
+
+	var translations []*entity.Translation
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "text/csv" {
+		var err error
+		translations, err = plantService.ParseTranslationCSV(r.Body)
+		if err != nil {
+			utils.RespondError(w, err)
+			return
+		}
+	} else {
+		var req translationImportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			utils.RespondValidationError(w, "body", "Invalid request body")
+			return
+		}
+		translations = req.Translations
+	}
+
+	result, err := h.service.Import(r.Context(), translations)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, result, nil)
+}
+
+// translationImportRequest is the JSON body for a bulk translation import
+type translationImportRequest struct {
+	Translations []*entity.Translation `json:"translations"`
+}
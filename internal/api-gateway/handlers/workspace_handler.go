@@ -0,0 +1,281 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
+	authService "twigger-backend/backend/auth-service/domain/service"
+	"twigger-backend/internal/api-gateway/utils"
+)
+
+// WorkspaceHandler handles workspace and membership HTTP requests
+type WorkspaceHandler struct {
+	service    *authService.WorkspaceService
+	authorizer *GardenAuthorizer
+}
+
+// NewWorkspaceHandler creates a new workspace handler
+func NewWorkspaceHandler(service *authService.WorkspaceService, authorizer *GardenAuthorizer) *WorkspaceHandler {
+	return &WorkspaceHandler{
+		service:    service,
+		authorizer: authorizer,
+	}
+}
+
+// CreateWorkspace handles POST /api/v1/workspaces
+func (h *WorkspaceHandler) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req workspaceNameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	workspace, err := h.service.CreateWorkspace(r.Context(), userID, req.Name, getClientIP(r), getUserAgent(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, workspace)
+}
+
+// ListWorkspaces handles GET /api/v1/workspaces
+func (h *WorkspaceHandler) ListWorkspaces(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	workspaces, err := h.service.ListWorkspaces(r.Context(), userID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, workspaces, nil)
+}
+
+// GetWorkspace handles GET /api/v1/workspaces/:id
+func (h *WorkspaceHandler) GetWorkspace(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := parseUUIDParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	workspace, err := h.service.GetWorkspace(r.Context(), userID, workspaceID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, workspace, nil)
+}
+
+// RenameWorkspace handles PUT /api/v1/workspaces/:id
+func (h *WorkspaceHandler) RenameWorkspace(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := parseUUIDParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req workspaceNameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	workspace, err := h.service.RenameWorkspace(r.Context(), userID, workspaceID, req.Name, getClientIP(r), getUserAgent(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, workspace, nil)
+}
+
+// ListMembers handles GET /api/v1/workspaces/:id/members
+func (h *WorkspaceHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := parseUUIDParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.service.ListMembers(r.Context(), userID, workspaceID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, members, nil)
+}
+
+// InviteMember handles POST /api/v1/workspaces/:id/members
+func (h *WorkspaceHandler) InviteMember(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := parseUUIDParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req inviteMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	// Default new members to the standard member role
+	role := authEntity.RoleMember
+	if req.Role != nil {
+		role = authEntity.WorkspaceRole(*req.Role)
+	}
+	if !role.IsValid() {
+		utils.RespondValidationError(w, "role", "Role must be one of: admin, member, viewer")
+		return
+	}
+
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	member, err := h.service.InviteMember(r.Context(), userID, workspaceID, req.Email, role, getClientIP(r), getUserAgent(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondCreated(w, member)
+}
+
+// UpdateMemberRole handles PUT /api/v1/workspaces/:id/members/:userId
+func (h *WorkspaceHandler) UpdateMemberRole(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := parseUUIDParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	memberID, ok := parseUUIDParam(w, r, "userId")
+	if !ok {
+		return
+	}
+
+	var req updateMemberRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondValidationError(w, "body", "Invalid request body")
+		return
+	}
+
+	role := authEntity.WorkspaceRole(req.Role)
+	if !role.IsValid() {
+		utils.RespondValidationError(w, "role", "Role must be one of: admin, member, viewer")
+		return
+	}
+
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	member, err := h.service.UpdateMemberRole(r.Context(), userID, workspaceID, memberID, role, getClientIP(r), getUserAgent(r))
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondSuccess(w, member, nil)
+}
+
+// RemoveMember handles DELETE /api/v1/workspaces/:id/members/:userId
+func (h *WorkspaceHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := parseUUIDParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	memberID, ok := parseUUIDParam(w, r, "userId")
+	if !ok {
+		return
+	}
+
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveMember(r.Context(), userID, workspaceID, memberID, getClientIP(r), getUserAgent(r)); err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	utils.RespondNoContent(w)
+}
+
+// currentUser resolves the caller's user ID as a UUID
+func (h *WorkspaceHandler) currentUser(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userIDStr, ok := h.authorizer.CurrentUserID(w, r)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		utils.RespondUnauthorized(w, "User not registered")
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// parseUUIDParam reads and validates a UUID path parameter.
+// Writes a validation error response and returns false if invalid.
+func parseUUIDParam(w http.ResponseWriter, r *http.Request, name string) (uuid.UUID, bool) {
+	value := utils.GetPathParam(r, name)
+	if err := utils.ValidateUUID(value); err != nil {
+		utils.RespondValidationError(w, name, err.Error())
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		utils.RespondValidationError(w, name, "Invalid UUID")
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// Request DTOs
+type workspaceNameRequest struct {
+	Name string `json:"name"`
+}
+
+type inviteMemberRequest struct {
+	Email string  `json:"email"`
+	Role  *string `json:"role,omitempty"`
+}
+
+type updateMemberRoleRequest struct {
+	Role string `json:"role"`
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	authEntity "twigger-backend/backend/auth-service/domain/entity"
 	gardenService "twigger-backend/backend/garden-service/domain/service"
 	"twigger-backend/backend/garden-service/domain/entity"
 	"twigger-backend/internal/api-gateway/utils"
@@ -11,13 +12,15 @@ import (
 
 // ZoneHandler handles garden zone-related HTTP requests
 type ZoneHandler struct {
-	service gardenService.ZoneManagementService
+	service    gardenService.ZoneManagementService
+	authorizer *GardenAuthorizer
 }
 
 // NewZoneHandler creates a new zone handler
-func NewZoneHandler(service gardenService.ZoneManagementService) *ZoneHandler {
+func NewZoneHandler(service gardenService.ZoneManagementService, authorizer *GardenAuthorizer) *ZoneHandler {
 	return &ZoneHandler{
-		service: service,
+		service:    service,
+		authorizer: authorizer,
 	}
 }
 
@@ -35,6 +38,10 @@ func (h *ZoneHandler) CreateZone(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
 	// Convert string to ZoneType enum
 	var zoneType *entity.ZoneType
 	if req.ZoneType != "" {
@@ -75,6 +82,10 @@ func (h *ZoneHandler) ListGardenZones(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, gardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
 	zones, err := h.service.ListGardenZones(r.Context(), gardenID)
 	if err != nil {
 		utils.RespondError(w, err)
@@ -98,6 +109,10 @@ func (h *ZoneHandler) GetZone(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, zone.GardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
 	utils.RespondSuccess(w, zone, nil)
 }
 
@@ -122,6 +137,10 @@ func (h *ZoneHandler) UpdateZone(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, existing.GardenID, authEntity.PermissionEdit); !ok {
+		return
+	}
+
 	// Update fields
 	if req.Name != nil {
 		existing.ZoneName = req.Name
@@ -154,6 +173,16 @@ func (h *ZoneHandler) DeleteZone(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	zone, err := h.service.GetZone(r.Context(), zoneID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, zone.GardenID, authEntity.PermissionDelete); !ok {
+		return
+	}
+
 	if err := h.service.DeleteZone(r.Context(), zoneID); err != nil {
 		utils.RespondError(w, err)
 		return
@@ -170,6 +199,16 @@ func (h *ZoneHandler) CalculateZoneArea(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	zone, err := h.service.GetZone(r.Context(), zoneID)
+	if err != nil {
+		utils.RespondError(w, err)
+		return
+	}
+
+	if _, ok := h.authorizer.AuthorizeGarden(w, r, zone.GardenID, authEntity.PermissionRead); !ok {
+		return
+	}
+
 	area, err := h.service.CalculateZoneArea(r.Context(), zoneID)
 	if err != nil {
 		utils.RespondError(w, err)
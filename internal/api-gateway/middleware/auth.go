@@ -107,6 +107,26 @@ func (m *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 	})
 }
 
+// RequireAdmin is middleware that restricts a route to users whose token carries the admin
+// custom claim. It must run after RequireAuth, which stores the claims.
+func (m *AuthMiddleware) RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Skip the check for OPTIONS (preflight) requests and when auth is disabled (development mode)
+		if r.Method == "OPTIONS" || !m.enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, _ := r.Context().Value("firebase_claims").(map[string]interface{})
+		if admin, ok := claims["admin"].(bool); !ok || !admin {
+			utils.RespondForbidden(w, "Admin role required")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 // OptionalAuth is middleware that optionally handles authentication
 // If a valid token is provided, user context is set. Otherwise, request proceeds without auth.
 func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
@@ -58,6 +58,19 @@ func NewRouter(h *handlers.Handlers, authMiddleware *middleware.AuthMiddleware,
 	meRouter.Use(authMiddleware.RequireAuth)
 	meRouter.HandleFunc("", h.AuthHandler.HandleMe).Methods("GET", "OPTIONS")
 
+	// Workspace routes (all require auth)
+	workspaceRouter := api.PathPrefix("/workspaces").Subrouter()
+	workspaceRouter.Use(authMiddleware.RequireAuth)
+
+	workspaceRouter.HandleFunc("", h.WorkspaceHandler.ListWorkspaces).Methods("GET")
+	workspaceRouter.HandleFunc("", h.WorkspaceHandler.CreateWorkspace).Methods("POST")
+	workspaceRouter.HandleFunc("/{id}", h.WorkspaceHandler.GetWorkspace).Methods("GET")
+	workspaceRouter.HandleFunc("/{id}", h.WorkspaceHandler.RenameWorkspace).Methods("PUT")
+	workspaceRouter.HandleFunc("/{id}/members", h.WorkspaceHandler.ListMembers).Methods("GET")
+	workspaceRouter.HandleFunc("/{id}/members", h.WorkspaceHandler.InviteMember).Methods("POST")
+	workspaceRouter.HandleFunc("/{id}/members/{userId}", h.WorkspaceHandler.UpdateMemberRole).Methods("PUT")
+	workspaceRouter.HandleFunc("/{id}/members/{userId}", h.WorkspaceHandler.RemoveMember).Methods("DELETE")
+
 	// Plant routes (optional auth for search, required for modifications)
 	plantRouter := api.PathPrefix("/plants").Subrouter()
 
@@ -66,8 +79,13 @@ func NewRouter(h *handlers.Handlers, authMiddleware *middleware.AuthMiddleware,
 	plantRouter.HandleFunc("/recommend", h.PlantHandler.RecommendPlants).Methods("GET")
 	plantRouter.HandleFunc("/family/{name}", h.PlantHandler.FindByFamily).Methods("GET")
 	plantRouter.HandleFunc("/genus/{name}", h.PlantHandler.FindByGenus).Methods("GET")
+	plantRouter.HandleFunc("/by-name/{name}", h.PlantHandler.GetPlantByName).Methods("GET")
 	plantRouter.HandleFunc("/{id}", h.PlantHandler.GetPlant).Methods("GET")
 	plantRouter.HandleFunc("/{id}/companions", h.PlantHandler.GetCompanions).Methods("GET")
+	plantRouter.HandleFunc("/{id}/growing-conditions", h.PlantHandler.GetGrowingConditionsConsensus).Methods("GET")
+	plantRouter.HandleFunc("/{id}/growing-conditions/sources", h.PlantHandler.GetGrowingConditionsProvenance).Methods("GET")
+	plantRouter.HandleFunc("/{id}/problems", h.ProblemHandler.ListPlantProblems).Methods("GET")
+	plantRouter.HandleFunc("/{id}/guide", h.DescriptionHandler.GetGuide).Methods("GET")
 
 	// Admin plant routes (require auth)
 	authPlantRouter := plantRouter.NewRoute().Subrouter()
@@ -92,6 +110,31 @@ func NewRouter(h *handlers.Handlers, authMiddleware *middleware.AuthMiddleware,
 	gardenRouter.HandleFunc("/{id}/zones", h.ZoneHandler.CreateZone).Methods("POST")
 	gardenRouter.HandleFunc("/{id}/zones", h.ZoneHandler.ListGardenZones).Methods("GET")
 
+	// Feature routes (nested under gardens)
+	gardenRouter.HandleFunc("/{id}/features", h.FeatureHandler.CreateFeature).Methods("POST")
+	gardenRouter.HandleFunc("/{id}/features", h.FeatureHandler.ListGardenFeatures).Methods("GET")
+
+	// Shade simulation routes (nested under gardens)
+	gardenRouter.HandleFunc("/{id}/shade", h.ShadeHandler.GetShadows).Methods("GET")
+	gardenRouter.HandleFunc("/{id}/shade/sun-hours", h.ShadeHandler.GetZoneSunHours).Methods("GET")
+	gardenRouter.HandleFunc("/{id}/shade/recalculate", h.ShadeHandler.RecalculateZoneSunHours).Methods("POST")
+
+	// Care task routes (nested under gardens)
+	gardenRouter.HandleFunc("/{id}/tasks", h.TaskHandler.ListGardenTasks).Methods("GET")
+	gardenRouter.HandleFunc("/{id}/tasks/generate", h.TaskHandler.GenerateGardenTasks).Methods("POST")
+
+	// Journal routes (nested under gardens)
+	gardenRouter.HandleFunc("/{id}/journal", h.JournalHandler.CreateEntry).Methods("POST")
+	gardenRouter.HandleFunc("/{id}/journal", h.JournalHandler.ListEntries).Methods("GET")
+	gardenRouter.HandleFunc("/{id}/journal/tags", h.JournalHandler.ListTags).Methods("GET")
+
+	// Harvest routes (nested under gardens)
+	gardenRouter.HandleFunc("/{id}/harvests", h.HarvestHandler.ListHarvests).Methods("GET")
+	gardenRouter.HandleFunc("/{id}/harvests/yield", h.HarvestHandler.GetYield).Methods("GET")
+
+	// Pest and disease outbreak routes (nested under gardens)
+	gardenRouter.HandleFunc("/{id}/outbreaks", h.OutbreakHandler.GetGardenOutbreaks).Methods("GET")
+
 	// Zone routes (standalone)
 	zoneRouter := api.PathPrefix("/zones").Subrouter()
 	zoneRouter.Use(authMiddleware.RequireAuth)
@@ -100,11 +143,26 @@ func NewRouter(h *handlers.Handlers, authMiddleware *middleware.AuthMiddleware,
 	zoneRouter.HandleFunc("/{id}", h.ZoneHandler.UpdateZone).Methods("PUT")
 	zoneRouter.HandleFunc("/{id}", h.ZoneHandler.DeleteZone).Methods("DELETE")
 	zoneRouter.HandleFunc("/{id}/area", h.ZoneHandler.CalculateZoneArea).Methods("GET")
+	zoneRouter.HandleFunc("/{id}/rotation", h.RotationHandler.PlanZone).Methods("GET")
+	zoneRouter.HandleFunc("/{id}/fill", h.PlanningHandler.FillZone).Methods("POST")
+	zoneRouter.HandleFunc("/{id}/recommendations", h.PlanningHandler.GetZoneRecommendations).Methods("GET")
+	zoneRouter.HandleFunc("/{id}/suitability", h.PlanningHandler.GetZoneSuitability).Methods("GET")
+
+	// Feature routes (standalone)
+	featureRouter := api.PathPrefix("/features").Subrouter()
+	featureRouter.Use(authMiddleware.RequireAuth)
+
+	featureRouter.HandleFunc("/{id}", h.FeatureHandler.GetFeature).Methods("GET")
+	featureRouter.HandleFunc("/{id}", h.FeatureHandler.UpdateFeature).Methods("PUT")
+	featureRouter.HandleFunc("/{id}", h.FeatureHandler.DeleteFeature).Methods("DELETE")
 
 	// Plant placement routes (nested under gardens)
 	gardenRouter.HandleFunc("/{id}/plants", h.PlantPlacementHandler.PlacePlant).Methods("POST")
 	gardenRouter.HandleFunc("/{id}/plants", h.PlantPlacementHandler.ListGardenPlants).Methods("GET")
 	gardenRouter.HandleFunc("/{id}/plants/bulk", h.PlantPlacementHandler.BulkPlacePlants).Methods("POST")
+	gardenRouter.HandleFunc("/{id}/layout-report", h.PlanningHandler.GetLayoutReport).Methods("GET")
+	gardenRouter.HandleFunc("/{id}/suitability", h.PlanningHandler.GetGardenSuitability).Methods("GET")
+	gardenRouter.HandleFunc("/{id}/regional-status", h.PlanningHandler.GetRegionalStatusReport).Methods("GET")
 
 	// Garden plant routes (standalone)
 	gardenPlantRouter := api.PathPrefix("/garden-plants").Subrouter()
@@ -113,6 +171,114 @@ func NewRouter(h *handlers.Handlers, authMiddleware *middleware.AuthMiddleware,
 	gardenPlantRouter.HandleFunc("/{id}", h.PlantPlacementHandler.GetGardenPlant).Methods("GET")
 	gardenPlantRouter.HandleFunc("/{id}", h.PlantPlacementHandler.UpdatePlantPlacement).Methods("PUT")
 	gardenPlantRouter.HandleFunc("/{id}", h.PlantPlacementHandler.RemovePlant).Methods("DELETE")
+	gardenPlantRouter.HandleFunc("/{id}/history", h.PlantPlacementHandler.GetPlantHistory).Methods("GET")
+	gardenPlantRouter.HandleFunc("/{id}/history", h.PlantPlacementHandler.RecordPlantEvent).Methods("POST")
+	gardenPlantRouter.HandleFunc("/{id}/photos", h.PhotoHandler.UploadGardenPlantPhoto).Methods("POST")
+	gardenPlantRouter.HandleFunc("/{id}/photos", h.PhotoHandler.ListGardenPlantPhotos).Methods("GET")
+	gardenPlantRouter.HandleFunc("/{id}/harvests", h.HarvestHandler.RecordHarvest).Methods("POST")
+	gardenPlantRouter.HandleFunc("/{id}/diagnosis", h.DiagnosisHandler.DiagnosePlant).Methods("POST")
+	gardenPlantRouter.HandleFunc("/{id}/problems", h.OutbreakHandler.ReportProblem).Methods("POST")
+	gardenPlantRouter.HandleFunc("/{id}/problems", h.OutbreakHandler.ListProblemReports).Methods("GET")
+	gardenPlantRouter.HandleFunc("/{id}/problems/{reportId}", h.OutbreakHandler.DeleteProblemReport).Methods("DELETE")
+
+	// Task routes (standalone)
+	taskRouter := api.PathPrefix("/tasks").Subrouter()
+	taskRouter.Use(authMiddleware.RequireAuth)
+
+	taskRouter.HandleFunc("/{id}", h.TaskHandler.GetTask).Methods("GET")
+	taskRouter.HandleFunc("/{id}/complete", h.TaskHandler.CompleteTask).Methods("POST")
+	taskRouter.HandleFunc("/{id}/snooze", h.TaskHandler.SnoozeTask).Methods("POST")
+	taskRouter.HandleFunc("/{id}/skip", h.TaskHandler.SkipTask).Methods("POST")
+
+	// Journal entry routes (standalone)
+	journalRouter := api.PathPrefix("/journal").Subrouter()
+	journalRouter.Use(authMiddleware.RequireAuth)
+
+	journalRouter.HandleFunc("/{id}", h.JournalHandler.GetEntry).Methods("GET")
+	journalRouter.HandleFunc("/{id}", h.JournalHandler.UpdateEntry).Methods("PUT")
+	journalRouter.HandleFunc("/{id}", h.JournalHandler.DeleteEntry).Methods("DELETE")
+	journalRouter.HandleFunc("/{id}/photos", h.PhotoHandler.UploadJournalEntryPhoto).Methods("POST")
+	journalRouter.HandleFunc("/{id}/photos", h.PhotoHandler.ListJournalEntryPhotos).Methods("GET")
+
+	// Plant problem routes (public catalogue; photos for any signed-in user, uploaded by admins)
+	plantProblemRouter := api.PathPrefix("/plant-problems").Subrouter()
+
+	plantProblemRouter.HandleFunc("", h.ProblemHandler.SearchProblems).Methods("GET")
+	plantProblemRouter.HandleFunc("/{id}", h.ProblemHandler.GetProblem).Methods("GET")
+
+	authProblemRouter := plantProblemRouter.NewRoute().Subrouter()
+	authProblemRouter.Use(authMiddleware.RequireAuth)
+	authProblemRouter.HandleFunc("/{id}/photos", h.PhotoHandler.ListPlantProblemPhotos).Methods("GET")
+
+	adminProblemRouter := authProblemRouter.NewRoute().Subrouter()
+	adminProblemRouter.Use(authMiddleware.RequireAdmin)
+	adminProblemRouter.HandleFunc("/{id}/photos", h.PhotoHandler.UploadPlantProblemPhoto).Methods("POST")
+
+	// Photo routes (standalone)
+	photoRouter := api.PathPrefix("/photos").Subrouter()
+	photoRouter.Use(authMiddleware.RequireAuth)
+
+	photoRouter.HandleFunc("/{id}", h.PhotoHandler.GetPhoto).Methods("GET")
+	photoRouter.HandleFunc("/{id}", h.PhotoHandler.DeletePhoto).Methods("DELETE")
+	photoRouter.HandleFunc("/{id}/content", h.PhotoHandler.GetPhotoContent).Methods("GET")
+	photoRouter.HandleFunc("/{id}/thumbnail", h.PhotoHandler.GetPhotoThumbnail).Methods("GET")
+
+	// Harvest routes (standalone)
+	harvestRouter := api.PathPrefix("/harvests").Subrouter()
+	harvestRouter.Use(authMiddleware.RequireAuth)
+
+	harvestRouter.HandleFunc("/{id}", h.HarvestHandler.GetHarvest).Methods("GET")
+	harvestRouter.HandleFunc("/{id}", h.HarvestHandler.UpdateHarvest).Methods("PUT")
+	harvestRouter.HandleFunc("/{id}", h.HarvestHandler.DeleteHarvest).Methods("DELETE")
+
+	// Admin catalog routes (require the admin claim)
+	adminRouter := api.PathPrefix("/admin").Subrouter()
+	adminRouter.Use(authMiddleware.RequireAuth)
+	adminRouter.Use(authMiddleware.RequireAdmin)
+
+	adminRouter.HandleFunc("/data-sources", h.CatalogHandler.ListDataSources).Methods("GET")
+	adminRouter.HandleFunc("/data-sources", h.CatalogHandler.CreateDataSource).Methods("POST")
+	adminRouter.HandleFunc("/data-sources/{id}", h.CatalogHandler.GetDataSource).Methods("GET")
+	adminRouter.HandleFunc("/data-sources/{id}", h.CatalogHandler.UpdateDataSource).Methods("PUT")
+	adminRouter.HandleFunc("/data-sources/{id}", h.CatalogHandler.DeleteDataSource).Methods("DELETE")
+
+	adminRouter.HandleFunc("/families", h.CatalogHandler.ListFamilies).Methods("GET")
+	adminRouter.HandleFunc("/families", h.CatalogHandler.CreateFamily).Methods("POST")
+	adminRouter.HandleFunc("/families/{id}", h.CatalogHandler.GetFamily).Methods("GET")
+	adminRouter.HandleFunc("/families/{id}", h.CatalogHandler.UpdateFamily).Methods("PUT")
+	adminRouter.HandleFunc("/families/{id}", h.CatalogHandler.DeleteFamily).Methods("DELETE")
+
+	adminRouter.HandleFunc("/genera", h.CatalogHandler.ListGenera).Methods("GET")
+	adminRouter.HandleFunc("/genera", h.CatalogHandler.CreateGenus).Methods("POST")
+	adminRouter.HandleFunc("/genera/{id}", h.CatalogHandler.GetGenus).Methods("GET")
+	adminRouter.HandleFunc("/genera/{id}", h.CatalogHandler.UpdateGenus).Methods("PUT")
+	adminRouter.HandleFunc("/genera/{id}", h.CatalogHandler.DeleteGenus).Methods("DELETE")
+
+	adminRouter.HandleFunc("/species", h.CatalogHandler.ListSpecies).Methods("GET")
+	adminRouter.HandleFunc("/species", h.CatalogHandler.CreateSpecies).Methods("POST")
+	adminRouter.HandleFunc("/species/{id}", h.CatalogHandler.GetSpecies).Methods("GET")
+	adminRouter.HandleFunc("/species/{id}", h.CatalogHandler.UpdateSpecies).Methods("PUT")
+	adminRouter.HandleFunc("/species/{id}", h.CatalogHandler.DeleteSpecies).Methods("DELETE")
+
+	adminRouter.HandleFunc("/cultivars", h.CatalogHandler.ListCultivars).Methods("GET")
+	adminRouter.HandleFunc("/cultivars", h.CatalogHandler.CreateCultivar).Methods("POST")
+	adminRouter.HandleFunc("/cultivars/{id}", h.CatalogHandler.GetCultivar).Methods("GET")
+	adminRouter.HandleFunc("/cultivars/{id}", h.CatalogHandler.UpdateCultivar).Methods("PUT")
+	adminRouter.HandleFunc("/cultivars/{id}", h.CatalogHandler.DeleteCultivar).Methods("DELETE")
+
+	adminRouter.HandleFunc("/synonyms", h.CatalogHandler.ListSynonyms).Methods("GET")
+	adminRouter.HandleFunc("/synonyms", h.CatalogHandler.CreateSynonym).Methods("POST")
+	adminRouter.HandleFunc("/synonyms/{id}", h.CatalogHandler.GetSynonym).Methods("GET")
+	adminRouter.HandleFunc("/synonyms/{id}", h.CatalogHandler.UpdateSynonym).Methods("PUT")
+	adminRouter.HandleFunc("/synonyms/{id}", h.CatalogHandler.DeleteSynonym).Methods("DELETE")
+
+	adminRouter.HandleFunc("/plants/{id}/descriptions", h.DescriptionHandler.ListDescriptions).Methods("GET")
+	adminRouter.HandleFunc("/plants/{id}/descriptions/{language}", h.DescriptionHandler.SaveDescription).Methods("PUT")
+	adminRouter.HandleFunc("/plants/{id}/descriptions/{language}", h.DescriptionHandler.DeleteDescription).Methods("DELETE")
+
+	adminRouter.HandleFunc("/translations/coverage", h.TranslationHandler.GetCoverage).Methods("GET")
+	adminRouter.HandleFunc("/translations/missing", h.TranslationHandler.ListMissing).Methods("GET")
+	adminRouter.HandleFunc("/translations/import", h.TranslationHandler.ImportTranslations).Methods("POST")
 
 	return r
 }
@@ -2,8 +2,11 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
+
+	authService "twigger-backend/backend/auth-service/domain/service"
 )
 
 // ErrorResponse represents a standardized error response
@@ -76,6 +79,15 @@ func MapError(err error) (int, ErrorResponse) {
 	errMsg := err.Error()
 	errLower := strings.ToLower(errMsg)
 
+	// Check for authorization errors
+	if errors.Is(err, authService.ErrForbidden) {
+		return http.StatusForbidden, ErrorResponse{
+			Error:   "forbidden",
+			Code:    "FORBIDDEN",
+			Message: errMsg,
+		}
+	}
+
 	// Check for not found errors
 	if strings.Contains(errLower, "not found") || strings.Contains(errLower, "does not exist") {
 		return http.StatusNotFound, ErrorResponse{
@@ -0,0 +1,15 @@
+-- ============================================================================
+-- Migration 009 Rollback: Attach Gardens to Workspaces
+-- Description: Restore the original workspace foreign key and drop indexes
+-- Note: The workspace_id backfill and any default workspaces created by the
+--       up migration are kept, since they cannot be told apart from user data.
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_gardens_workspace_created;
+
+ALTER TABLE gardens DROP CONSTRAINT IF EXISTS gardens_workspace_id_fkey;
+ALTER TABLE gardens
+ADD CONSTRAINT gardens_workspace_id_fkey
+    FOREIGN KEY (workspace_id) REFERENCES workspaces(workspace_id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN gardens.workspace_id IS NULL;
@@ -0,0 +1,56 @@
+-- ============================================================================
+-- Migration 009: Attach Gardens to Workspaces
+-- Description: Backfill gardens.workspace_id to the owner's default workspace
+--              so workspace members can share gardens
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- ============================================================================
+-- SECTION 1: Ensure Every Garden Owner Has a Default Workspace
+-- ============================================================================
+
+-- Users created before migration 008 may not have a workspace yet.
+-- trigger_add_workspace_owner adds each owner as admin.
+INSERT INTO workspaces (owner_id, name)
+SELECT DISTINCT u.user_id, u.username || '''s Garden'
+FROM gardens g
+INNER JOIN users u ON u.user_id = g.user_id
+WHERE NOT EXISTS (
+    SELECT 1 FROM workspaces w WHERE w.owner_id = g.user_id
+);
+
+-- ============================================================================
+-- SECTION 2: Backfill Garden Workspaces
+-- ============================================================================
+
+-- The default workspace is the owner's oldest one (created at registration)
+UPDATE gardens g
+SET workspace_id = (
+    SELECT w.workspace_id
+    FROM workspaces w
+    WHERE w.owner_id = g.user_id
+    ORDER BY w.created_at ASC
+    LIMIT 1
+)
+WHERE g.workspace_id IS NULL;
+
+-- ============================================================================
+-- SECTION 3: Keep Gardens When a Workspace Is Deleted
+-- ============================================================================
+
+-- Migration 008 cascaded workspace deletes to gardens. Now that every garden
+-- belongs to a workspace, deleting one must not destroy the owner's gardens.
+ALTER TABLE gardens DROP CONSTRAINT IF EXISTS gardens_workspace_id_fkey;
+ALTER TABLE gardens
+ADD CONSTRAINT gardens_workspace_id_fkey
+    FOREIGN KEY (workspace_id) REFERENCES workspaces(workspace_id) ON DELETE SET NULL;
+
+-- ============================================================================
+-- SECTION 4: Indexes for Workspace Listing
+-- ============================================================================
+
+-- Supports "all gardens in my workspaces" ordered by creation date
+CREATE INDEX IF NOT EXISTS idx_gardens_workspace_created
+ON gardens(workspace_id, created_at DESC);
+
+COMMENT ON COLUMN gardens.workspace_id IS 'Workspace sharing this garden; defaults to the owner''s first workspace';
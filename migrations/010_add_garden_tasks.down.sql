@@ -0,0 +1,9 @@
+-- ============================================================================
+-- Migration 010 Rollback: Garden Care Tasks
+-- Description: Drop the garden_tasks table
+-- Date: 2026-10-16
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_garden_tasks_open_plant_type;
+DROP INDEX IF EXISTS idx_garden_tasks_garden_status_due;
+DROP TABLE IF EXISTS garden_tasks;
@@ -0,0 +1,41 @@
+-- ============================================================================
+-- Migration 010: Garden Care Tasks
+-- Description: Add garden_tasks for generated watering, pruning, fertilizing
+--              and harvest reminders
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- ============================================================================
+-- SECTION 1: Create Garden Tasks Table
+-- ============================================================================
+
+CREATE TABLE garden_tasks (
+    task_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    garden_id UUID NOT NULL REFERENCES gardens(garden_id) ON DELETE CASCADE,
+    garden_plant_id UUID REFERENCES garden_plants(garden_plant_id) ON DELETE CASCADE,
+    plant_id UUID REFERENCES plants(plant_id),
+    task_type VARCHAR(20) NOT NULL CHECK (task_type IN ('watering', 'pruning', 'fertilizing', 'harvest')),
+    title VARCHAR(200) NOT NULL,
+    description TEXT,
+    due_date DATE NOT NULL,
+    recurrence_days INTEGER CHECK (recurrence_days > 0),  -- NULL = one-off task
+    active_months INTEGER[],                              -- Months (1-12) the task recurs in
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'snoozed', 'completed', 'skipped')),
+    completed_at TIMESTAMPTZ,
+    completed_by UUID REFERENCES users(user_id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- SECTION 2: Indexes
+-- ============================================================================
+
+-- Garden task lists filter by status and sort by due date
+CREATE INDEX idx_garden_tasks_garden_status_due ON garden_tasks(garden_id, status, due_date);
+
+-- Task generation looks up open tasks per plant and type
+CREATE INDEX idx_garden_tasks_open_plant_type ON garden_tasks(garden_plant_id, task_type)
+    WHERE status IN ('pending', 'snoozed');
+
+COMMENT ON TABLE garden_tasks IS 'Care tasks generated from plant growing conditions; completing a recurring task schedules the next one';
@@ -0,0 +1,13 @@
+-- ============================================================================
+-- Migration 011 Rollback: Garden Journal
+-- Description: Drop the garden_journal_entries table
+-- Date: 2026-10-16
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_journal_entries_title_trgm;
+DROP INDEX IF EXISTS idx_journal_entries_body_trgm;
+DROP INDEX IF EXISTS idx_journal_entries_tags;
+DROP INDEX IF EXISTS idx_journal_entries_garden_plant;
+DROP INDEX IF EXISTS idx_journal_entries_zone;
+DROP INDEX IF EXISTS idx_journal_entries_garden_date;
+DROP TABLE IF EXISTS garden_journal_entries;
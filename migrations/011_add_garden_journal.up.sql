@@ -0,0 +1,47 @@
+-- ============================================================================
+-- Migration 011: Garden Journal
+-- Description: Add garden_journal_entries for dated notes about a garden,
+--              optionally linked to a zone or garden plant
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- ============================================================================
+-- SECTION 1: Create Journal Entries Table
+-- ============================================================================
+
+CREATE TABLE garden_journal_entries (
+    entry_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    garden_id UUID NOT NULL REFERENCES gardens(garden_id) ON DELETE CASCADE,
+    zone_id UUID REFERENCES garden_zones(zone_id) ON DELETE SET NULL,
+    garden_plant_id UUID REFERENCES garden_plants(garden_plant_id) ON DELETE SET NULL,
+    author_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    title VARCHAR(200),
+    body TEXT NOT NULL CHECK (length(body) <= 20000),
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    entry_date DATE NOT NULL DEFAULT CURRENT_DATE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- SECTION 2: Indexes
+-- ============================================================================
+
+-- Journal timelines list a garden's entries newest first
+CREATE INDEX idx_journal_entries_garden_date ON garden_journal_entries(garden_id, entry_date DESC);
+
+-- Entries about a specific zone or plant
+CREATE INDEX idx_journal_entries_zone ON garden_journal_entries(zone_id) WHERE zone_id IS NOT NULL;
+CREATE INDEX idx_journal_entries_garden_plant ON garden_journal_entries(garden_plant_id) WHERE garden_plant_id IS NOT NULL;
+
+-- Tag filters (tags @> ARRAY[...])
+CREATE INDEX idx_journal_entries_tags ON garden_journal_entries USING GIN (tags);
+
+-- Text search over titles and bodies (pg_trgm enabled in migration 006)
+CREATE INDEX IF NOT EXISTS idx_journal_entries_body_trgm
+ON garden_journal_entries USING GIN (body gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_journal_entries_title_trgm
+ON garden_journal_entries USING GIN (title gin_trgm_ops);
+
+COMMENT ON COLUMN garden_journal_entries.tags IS 'Lowercase tags without a leading #';
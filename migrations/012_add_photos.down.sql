@@ -0,0 +1,13 @@
+-- ============================================================================
+-- Migration 012 Rollback: Photo Attachments
+-- Description: Drop the photos table
+-- Note: Stored image objects are not removed from blob storage.
+-- Date: 2026-10-16
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_photos_orphaned;
+DROP INDEX IF EXISTS idx_photos_garden;
+DROP INDEX IF EXISTS idx_photos_problem;
+DROP INDEX IF EXISTS idx_photos_garden_plant;
+DROP INDEX IF EXISTS idx_photos_journal_entry;
+DROP TABLE IF EXISTS photos;
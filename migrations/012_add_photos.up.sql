@@ -0,0 +1,53 @@
+-- ============================================================================
+-- Migration 012: Photo Attachments
+-- Description: Add photo metadata for images attached to journal entries,
+--              garden plants and plant problems. Image bytes live in blob storage.
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- ============================================================================
+-- SECTION 1: Create Photos Table
+-- ============================================================================
+
+CREATE TABLE photos (
+    photo_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+
+    -- One owner. Deleting the owner or uploader detaches the photo rather than deleting
+    -- the row, so the sweeper can remove its stored images before removing the record.
+    journal_entry_id UUID REFERENCES garden_journal_entries(entry_id) ON DELETE SET NULL,
+    garden_plant_id UUID REFERENCES garden_plants(garden_plant_id) ON DELETE SET NULL,
+    problem_id UUID REFERENCES plant_problems(problem_id) ON DELETE SET NULL,
+    garden_id UUID REFERENCES gardens(garden_id) ON DELETE SET NULL,  -- NULL for plant problem photos
+
+    uploaded_by UUID REFERENCES users(user_id) ON DELETE SET NULL,  -- Always set on upload
+    storage_key TEXT NOT NULL UNIQUE,
+    thumbnail_key TEXT NOT NULL UNIQUE,
+    content_type VARCHAR(50) NOT NULL CHECK (content_type IN ('image/jpeg', 'image/png')),
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    width INTEGER NOT NULL CHECK (width > 0),
+    height INTEGER NOT NULL CHECK (height > 0),
+    original_filename VARCHAR(255),
+    caption VARCHAR(500),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    -- No owner means the owner was deleted. Garden ownership is checked by the application,
+    -- since a garden delete may null garden_id before the cascade reaches the owner.
+    CONSTRAINT photos_single_owner CHECK (num_nonnulls(journal_entry_id, garden_plant_id, problem_id) <= 1)
+);
+
+-- ============================================================================
+-- SECTION 2: Indexes
+-- ============================================================================
+
+CREATE INDEX idx_photos_journal_entry ON photos(journal_entry_id, created_at DESC) WHERE journal_entry_id IS NOT NULL;
+CREATE INDEX idx_photos_garden_plant ON photos(garden_plant_id, created_at DESC) WHERE garden_plant_id IS NOT NULL;
+CREATE INDEX idx_photos_problem ON photos(problem_id, created_at DESC) WHERE problem_id IS NOT NULL;
+CREATE INDEX idx_photos_garden ON photos(garden_id) WHERE garden_id IS NOT NULL;
+
+-- Detached photos awaiting the sweeper
+CREATE INDEX idx_photos_orphaned ON photos(created_at)
+    WHERE uploaded_by IS NULL
+       OR num_nonnulls(journal_entry_id, garden_plant_id, problem_id) = 0
+       OR (problem_id IS NULL AND garden_id IS NULL);
+
+COMMENT ON TABLE photos IS 'Photo metadata; GPS EXIF data is stripped before upload to blob storage';
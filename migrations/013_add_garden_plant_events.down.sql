@@ -0,0 +1,12 @@
+-- ============================================================================
+-- Migration 013 Rollback: Garden Plant Event Log
+-- Description: Drop the garden_plant_events table and its append-only guard
+-- Date: 2026-10-16
+-- ============================================================================
+
+DROP TRIGGER IF EXISTS trigger_garden_plant_events_append_only ON garden_plant_events;
+DROP FUNCTION IF EXISTS prevent_garden_plant_event_update();
+DROP INDEX IF EXISTS idx_garden_plant_events_garden;
+DROP INDEX IF EXISTS idx_garden_plant_events_zone_time;
+DROP INDEX IF EXISTS idx_garden_plant_events_plant_time;
+DROP TABLE IF EXISTS garden_plant_events;
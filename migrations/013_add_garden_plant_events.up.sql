@@ -0,0 +1,72 @@
+-- ============================================================================
+-- Migration 013: Garden Plant Event Log
+-- Description: Add garden_plant_events, an append-only history of what
+--              happened to each garden plant (planted, moved, health
+--              changed, watered, harvested, removed)
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- ============================================================================
+-- SECTION 1: Create Events Table
+-- ============================================================================
+
+-- garden_plant_id and zone_id deliberately have no foreign keys: the history
+-- outlives removed plants and zones, and is only dropped with its garden.
+CREATE TABLE garden_plant_events (
+    event_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    garden_plant_id UUID NOT NULL,
+    garden_id UUID NOT NULL REFERENCES gardens(garden_id) ON DELETE CASCADE,
+    plant_id UUID NOT NULL REFERENCES plants(plant_id),
+    zone_id UUID,
+    event_type TEXT NOT NULL CHECK (event_type IN (
+        'planted', 'moved', 'health_changed', 'watered', 'harvested', 'removed'
+    )),
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    notes TEXT CHECK (length(notes) <= 2000),
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- SECTION 2: Indexes
+-- ============================================================================
+
+-- Timeline for a single plant, newest first
+CREATE INDEX idx_garden_plant_events_plant_time ON garden_plant_events(garden_plant_id, occurred_at DESC);
+
+-- What has grown in a zone over time (crop rotation)
+CREATE INDEX idx_garden_plant_events_zone_time ON garden_plant_events(zone_id, occurred_at DESC) WHERE zone_id IS NOT NULL;
+
+CREATE INDEX idx_garden_plant_events_garden ON garden_plant_events(garden_id);
+
+-- ============================================================================
+-- SECTION 3: Append-Only Guard
+-- ============================================================================
+
+-- Events are never edited; rows are only removed along with their garden
+CREATE OR REPLACE FUNCTION prevent_garden_plant_event_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'garden_plant_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_garden_plant_events_append_only
+    BEFORE UPDATE ON garden_plant_events
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_garden_plant_event_update();
+
+-- ============================================================================
+-- SECTION 4: Backfill
+-- ============================================================================
+
+-- Existing plants get a planted event so their timelines are not empty
+INSERT INTO garden_plant_events (garden_plant_id, garden_id, plant_id, zone_id, event_type, occurred_at, details)
+SELECT
+    garden_plant_id, garden_id, plant_id, zone_id, 'planted',
+    COALESCE(planted_date::timestamptz, created_at, CURRENT_TIMESTAMP),
+    jsonb_build_object('location', ST_AsGeoJSON(location)::jsonb, 'quantity', quantity)
+FROM garden_plants;
+
+COMMENT ON TABLE garden_plant_events IS 'Append-only history of garden plants';
+COMMENT ON COLUMN garden_plant_events.details IS 'Event-specific data, e.g. from/to location for moves or health status changes';
@@ -0,0 +1,10 @@
+-- ============================================================================
+-- Migration 014 Rollback: Harvests
+-- Description: Drop the harvests table
+-- Date: 2026-10-16
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_harvests_zone;
+DROP INDEX IF EXISTS idx_harvests_garden_plant;
+DROP INDEX IF EXISTS idx_harvests_garden_date;
+DROP TABLE IF EXISTS harvests;
@@ -0,0 +1,52 @@
+-- ============================================================================
+-- Migration 014: Harvests
+-- Description: Add harvests for recording yield per garden plant, with
+--              indexes for yield aggregation by plant, zone and date
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- ============================================================================
+-- SECTION 1: Create Harvests Table
+-- ============================================================================
+
+-- garden_plant_id has no foreign key so yield history survives plant removal
+-- (garden plants are hard deleted). zone_id is the plant's zone at harvest time.
+CREATE TABLE harvests (
+    harvest_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    garden_plant_id UUID NOT NULL,
+    garden_id UUID NOT NULL REFERENCES gardens(garden_id) ON DELETE CASCADE,
+    plant_id UUID NOT NULL REFERENCES plants(plant_id),
+    zone_id UUID REFERENCES garden_zones(zone_id) ON DELETE SET NULL,
+    harvested_on DATE NOT NULL DEFAULT CURRENT_DATE,
+    quantity NUMERIC(12,3) NOT NULL CHECK (quantity > 0),
+    unit TEXT NOT NULL CHECK (unit IN ('kg', 'g', 'lb', 'oz', 'count')),
+    weight_kg NUMERIC(12,3) CHECK (weight_kg >= 0),
+    quality TEXT CHECK (quality IN ('excellent', 'good', 'fair', 'poor')),
+    notes TEXT CHECK (length(notes) <= 2000),
+    recorded_by UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    -- Weight units are normalized to kg; counted harvests have no weight
+    CONSTRAINT harvests_weight_matches_unit CHECK (
+        (unit = 'count' AND weight_kg IS NULL) OR
+        (unit <> 'count' AND weight_kg IS NOT NULL)
+    )
+);
+
+-- ============================================================================
+-- SECTION 2: Indexes
+-- ============================================================================
+
+-- Harvest lists and yield reports cover one garden over a date range
+CREATE INDEX idx_harvests_garden_date ON harvests(garden_id, harvested_on DESC);
+
+-- Harvests of a single plant
+CREATE INDEX idx_harvests_garden_plant ON harvests(garden_plant_id, harvested_on DESC);
+
+-- Yield per zone
+CREATE INDEX idx_harvests_zone ON harvests(zone_id) WHERE zone_id IS NOT NULL;
+
+COMMENT ON TABLE harvests IS 'Yield records per garden plant; kept after the plant is removed';
+COMMENT ON COLUMN harvests.weight_kg IS 'quantity converted to kg for weight units, NULL for counted harvests';
+COMMENT ON COLUMN harvests.zone_id IS 'Zone the plant was in when harvested';
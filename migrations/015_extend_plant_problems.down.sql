@@ -0,0 +1,41 @@
+-- ============================================================================
+-- Migration 015 Rollback: Extend Plant Problems
+-- Description: Drop problem catalogue indexes and the symptom, seasonality and
+--              treatment columns, and restore the original severity values
+-- Date: 2026-10-16
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_plant_problems_i18n_name_trgm;
+DROP INDEX IF EXISTS idx_plant_problems_name_trgm;
+DROP INDEX IF EXISTS idx_plant_problems_affected_parts;
+DROP INDEX IF EXISTS idx_plant_problems_type;
+
+ALTER TABLE plant_problems_i18n
+    DROP COLUMN IF EXISTS chemical_treatments,
+    DROP COLUMN IF EXISTS organic_treatments;
+
+ALTER TABLE plant_problems
+    DROP CONSTRAINT IF EXISTS plant_problems_active_months_check,
+    DROP CONSTRAINT IF EXISTS plant_problems_affected_parts_check,
+    DROP COLUMN IF EXISTS chemical_treatments,
+    DROP COLUMN IF EXISTS organic_treatments,
+    DROP COLUMN IF EXISTS active_months,
+    DROP COLUMN IF EXISTS affected_parts;
+
+-- Toxicity problems have no equivalent in the original schema
+DELETE FROM plant_problems WHERE problem_type = 'toxicity';
+ALTER TABLE plant_problems DROP CONSTRAINT IF EXISTS plant_problems_problem_type_check;
+ALTER TABLE plant_problems ADD CONSTRAINT plant_problems_problem_type_check
+    CHECK (problem_type IN ('pest', 'disease', 'deficiency'));
+
+ALTER TABLE plant_problems DROP CONSTRAINT IF EXISTS plant_problems_severity_check;
+UPDATE plant_problems SET severity = CASE severity
+    WHEN 'low' THEN 'minor'
+    WHEN 'medium' THEN 'moderate'
+    WHEN 'high' THEN 'severe'
+    ELSE severity
+END;
+ALTER TABLE plant_problems ADD CONSTRAINT plant_problems_severity_check
+    CHECK (severity IN ('minor', 'moderate', 'severe'));
+
+-- updated_at is kept: the repository has always written it, and it may predate this migration
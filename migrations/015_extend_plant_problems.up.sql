@@ -0,0 +1,72 @@
+-- ============================================================================
+-- Migration 015: Extend Plant Problems
+-- Description: Add affected parts, seasonality and organic/chemical treatments
+--              to plant problems (and their translations), align severity and
+--              problem type values with the domain model, and index the
+--              problem catalogue for search
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- ============================================================================
+-- SECTION 1: Align plant_problems With the Domain Model
+-- ============================================================================
+
+ALTER TABLE plant_problems ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP;
+
+-- Severity was minor/moderate/severe in the original schema; the API uses low/medium/high
+ALTER TABLE plant_problems DROP CONSTRAINT IF EXISTS plant_problems_severity_check;
+UPDATE plant_problems SET severity = CASE severity
+    WHEN 'minor' THEN 'low'
+    WHEN 'moderate' THEN 'medium'
+    WHEN 'severe' THEN 'high'
+    ELSE severity
+END;
+ALTER TABLE plant_problems ADD CONSTRAINT plant_problems_severity_check
+    CHECK (severity IN ('low', 'medium', 'high'));
+
+ALTER TABLE plant_problems DROP CONSTRAINT IF EXISTS plant_problems_problem_type_check;
+ALTER TABLE plant_problems ADD CONSTRAINT plant_problems_problem_type_check
+    CHECK (problem_type IN ('pest', 'disease', 'deficiency', 'toxicity'));
+
+-- ============================================================================
+-- SECTION 2: Symptoms, Seasonality and Treatments
+-- ============================================================================
+
+-- Affected parts and active months are language independent, so they live on the base row.
+-- An empty active_months array means the problem occurs year-round.
+ALTER TABLE plant_problems
+    ADD COLUMN affected_parts TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN active_months SMALLINT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN organic_treatments TEXT[],
+    ADD COLUMN chemical_treatments TEXT[],
+    ADD CONSTRAINT plant_problems_affected_parts_check CHECK (
+        affected_parts <@ ARRAY['leaves', 'stems', 'roots', 'flowers', 'fruit', 'seeds', 'bulbs', 'bark', 'whole_plant']
+    ),
+    ADD CONSTRAINT plant_problems_active_months_check CHECK (
+        active_months <@ ARRAY[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12]::SMALLINT[]
+    );
+
+-- treatments stays the general/cultural list; organic and chemical options are split out
+ALTER TABLE plant_problems_i18n
+    ADD COLUMN organic_treatments TEXT[],
+    ADD COLUMN chemical_treatments TEXT[];
+
+-- ============================================================================
+-- SECTION 3: Indexes
+-- ============================================================================
+
+-- Catalogue filters
+CREATE INDEX idx_plant_problems_type ON plant_problems(problem_type);
+CREATE INDEX idx_plant_problems_affected_parts ON plant_problems USING GIN (affected_parts);
+
+-- Catalogue name search (ILIKE)
+CREATE INDEX IF NOT EXISTS idx_plant_problems_name_trgm
+ON plant_problems USING GIN (problem_name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_plant_problems_i18n_name_trgm
+ON plant_problems_i18n USING GIN (problem_name gin_trgm_ops);
+
+COMMENT ON COLUMN plant_problems.affected_parts IS 'Plant parts showing symptoms (leaves, stems, roots, flowers, fruit, seeds, bulbs, bark, whole_plant)';
+COMMENT ON COLUMN plant_problems.active_months IS 'Months (1-12) the problem is typically active; empty means year-round';
+COMMENT ON COLUMN plant_problems.organic_treatments IS 'Organic treatment options in the default language';
+COMMENT ON COLUMN plant_problems.chemical_treatments IS 'Chemical treatment options in the default language';
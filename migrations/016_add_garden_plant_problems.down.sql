@@ -0,0 +1,9 @@
+-- ============================================================================
+-- Migration 016 Rollback: Garden Plant Problems
+-- Description: Drop the garden_plant_problems table
+-- Date: 2026-10-16
+-- ============================================================================
+
+DROP INDEX IF EXISTS idx_garden_plant_problems_problem;
+DROP INDEX IF EXISTS idx_garden_plant_problems_garden;
+DROP TABLE IF EXISTS garden_plant_problems;
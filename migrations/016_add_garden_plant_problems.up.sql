@@ -0,0 +1,38 @@
+-- ============================================================================
+-- Migration 016: Garden Plant Problems
+-- Description: Link diseased garden plants to the catalogue problem affecting
+--              them, for garden-wide outbreak tracking
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- ============================================================================
+-- SECTION 1: Create Garden Plant Problems Table
+-- ============================================================================
+
+-- A report stays on record after the plant recovers; it counts towards an
+-- outbreak only while the plant is active and marked diseased
+CREATE TABLE garden_plant_problems (
+    report_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    garden_plant_id UUID NOT NULL REFERENCES garden_plants(garden_plant_id) ON DELETE CASCADE,
+    garden_id UUID NOT NULL REFERENCES gardens(garden_id) ON DELETE CASCADE,
+    problem_id UUID NOT NULL REFERENCES plant_problems(problem_id) ON DELETE CASCADE,
+    notes TEXT CHECK (length(notes) <= 2000),
+    reported_by UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    -- Reporting the same problem again updates the existing report
+    CONSTRAINT garden_plant_problems_unique UNIQUE (garden_plant_id, problem_id)
+);
+
+-- ============================================================================
+-- SECTION 2: Indexes
+-- ============================================================================
+
+-- Outbreaks are gathered per garden
+CREATE INDEX idx_garden_plant_problems_garden ON garden_plant_problems(garden_id);
+
+-- Reports against a catalogue problem (cascading deletes)
+CREATE INDEX idx_garden_plant_problems_problem ON garden_plant_problems(problem_id);
+
+COMMENT ON TABLE garden_plant_problems IS 'Catalogue problems reported on diseased garden plants';
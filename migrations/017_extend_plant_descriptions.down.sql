@@ -0,0 +1,91 @@
+-- ============================================================================
+-- Migration 017 Rollback: Plant Description Care Guides
+-- Description: Drop the care guide sections and restore the description
+--              lookup and localized plant view from 005_add_localization.sql
+-- Date: 2026-10-16
+-- ============================================================================
+
+DROP VIEW IF EXISTS v_plants_localized;
+DROP FUNCTION IF EXISTS get_plant_description(UUID, VARCHAR, UUID, UUID);
+
+DROP INDEX IF EXISTS idx_plant_descriptions_unique_global;
+
+ALTER TABLE plant_descriptions
+    DROP COLUMN IF EXISTS uses,
+    DROP COLUMN IF EXISTS propagation_instructions,
+    DROP COLUMN IF EXISTS planting_instructions;
+
+-- ============================================================================
+-- Restore the Migration 005 Lookup
+-- ============================================================================
+
+-- Function to get localized plant description
+CREATE OR REPLACE FUNCTION get_plant_description(
+    p_plant_id UUID,
+    p_description_type VARCHAR(50),
+    p_language_id UUID,
+    p_country_id UUID DEFAULT NULL
+) RETURNS TEXT AS $$
+DECLARE
+    v_description TEXT;
+BEGIN
+    -- Try country-specific first
+    IF p_country_id IS NOT NULL THEN
+        SELECT content INTO v_description
+        FROM plant_descriptions
+        WHERE plant_id = p_plant_id
+          AND description_type = p_description_type
+          AND language_id = p_language_id
+          AND country_id = p_country_id;
+    END IF;
+
+    -- Fall back to global for this language
+    IF v_description IS NULL THEN
+        SELECT content INTO v_description
+        FROM plant_descriptions
+        WHERE plant_id = p_plant_id
+          AND description_type = p_description_type
+          AND language_id = p_language_id
+          AND country_id IS NULL;
+    END IF;
+
+    RETURN v_description;
+END;
+$$ LANGUAGE plpgsql;
+
+-- The view reads the description_type/content columns of the migration 005
+-- layout, so it can only be recreated where plant_descriptions has them
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM information_schema.columns
+        WHERE table_name = 'plant_descriptions'
+          AND column_name = 'description_type'
+    ) THEN
+        EXECUTE $view$
+            CREATE OR REPLACE VIEW v_plants_localized AS
+            SELECT
+                p.plant_id,
+                p.full_botanical_name,
+                u.user_id,
+                u.preferred_language_id,
+                (
+                    SELECT array_agg(pcn.common_name ORDER BY pcn.is_primary DESC)
+                    FROM plant_common_names pcn
+                    WHERE pcn.plant_id = p.plant_id
+                      AND pcn.language_id = u.preferred_language_id
+                ) AS common_names,
+                (
+                    SELECT pd.content
+                    FROM plant_descriptions pd
+                    WHERE pd.plant_id = p.plant_id
+                      AND pd.language_id = u.preferred_language_id
+                      AND pd.description_type = 'general'
+                    LIMIT 1
+                ) AS description
+            FROM plants p
+            CROSS JOIN users u
+        $view$;
+    END IF;
+END $$;
@@ -0,0 +1,111 @@
+-- ============================================================================
+-- Migration 017: Plant Description Care Guides
+-- Description: Extend plant_descriptions into a localized care guide with
+--              planting, propagation and uses sections, and redefine the
+--              description lookup for the per-language row layout
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- plant_descriptions holds one row per plant, language and optional country
+-- (migration 000002). Sections map to columns:
+--   summary     -> short_description
+--   overview    -> full_description
+--   planting    -> planting_instructions
+--   care        -> care_instructions
+--   propagation -> propagation_instructions
+--   uses        -> uses
+
+-- ============================================================================
+-- SECTION 1: Care Guide Sections
+-- ============================================================================
+
+ALTER TABLE plant_descriptions
+    ADD COLUMN IF NOT EXISTS planting_instructions TEXT,
+    ADD COLUMN IF NOT EXISTS propagation_instructions TEXT,
+    ADD COLUMN IF NOT EXISTS uses TEXT;
+
+-- The unique constraint treats NULL countries as distinct, so guard the
+-- country-independent row separately. Existing duplicates are not removed
+-- automatically; the migration stops and lists them so they can be merged
+-- by hand.
+DO $$
+DECLARE
+    conflicts TEXT;
+BEGIN
+    SELECT string_agg(format('plant %s, language %s (%s rows)',
+                             plant_id, language_id, copies), E'\n')
+    INTO conflicts
+    FROM (
+        SELECT plant_id, language_id, COUNT(*) AS copies
+        FROM plant_descriptions
+        WHERE country_id IS NULL
+        GROUP BY plant_id, language_id
+        HAVING COUNT(*) > 1
+    ) duplicates;
+
+    IF conflicts IS NOT NULL THEN
+        RAISE EXCEPTION 'duplicate country-independent plant descriptions must be merged before migration 017'
+            USING DETAIL = conflicts;
+    END IF;
+END $$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_plant_descriptions_unique_global
+ON plant_descriptions(plant_id, language_id)
+WHERE country_id IS NULL;
+
+-- ============================================================================
+-- SECTION 2: Localized Lookup
+-- ============================================================================
+
+-- Returns one section of a plant's guide in the requested language, falling back
+-- to English. Country-specific text is preferred over country-independent text.
+DROP VIEW IF EXISTS v_plants_localized;
+DROP FUNCTION IF EXISTS get_plant_description(UUID, VARCHAR, UUID, UUID);
+
+CREATE FUNCTION get_plant_description(
+    p_plant_id UUID,
+    p_section VARCHAR(50),
+    p_language_id UUID,
+    p_country_id UUID DEFAULT NULL
+) RETURNS TEXT AS $$
+    SELECT section.content
+    FROM plant_descriptions pd
+    INNER JOIN languages l ON pd.language_id = l.language_id
+    CROSS JOIN LATERAL (
+        SELECT CASE p_section
+            WHEN 'summary' THEN pd.short_description
+            WHEN 'overview' THEN pd.full_description
+            WHEN 'planting' THEN pd.planting_instructions
+            WHEN 'care' THEN pd.care_instructions
+            WHEN 'propagation' THEN pd.propagation_instructions
+            WHEN 'uses' THEN pd.uses
+        END AS content
+    ) section
+    WHERE pd.plant_id = p_plant_id
+      AND (pd.language_id = p_language_id OR l.language_code = 'en')
+      AND (pd.country_id IS NULL OR pd.country_id = p_country_id)
+      AND section.content IS NOT NULL
+    ORDER BY (pd.language_id = p_language_id) DESC, (pd.country_id IS NOT NULL) DESC
+    LIMIT 1;
+$$ LANGUAGE sql STABLE;
+
+-- Plant information in each user's preferred language
+CREATE VIEW v_plants_localized AS
+SELECT
+    p.plant_id,
+    p.full_botanical_name,
+    u.user_id,
+    u.preferred_language_id,
+    (
+        SELECT array_agg(pcn.common_name ORDER BY pcn.is_primary DESC)
+        FROM plant_common_names pcn
+        WHERE pcn.plant_id = p.plant_id
+          AND pcn.language_id = u.preferred_language_id
+    ) AS common_names,
+    get_plant_description(p.plant_id, 'overview', u.preferred_language_id) AS description
+FROM plants p
+CROSS JOIN users u;
+
+COMMENT ON COLUMN plant_descriptions.planting_instructions IS 'Care guide: when and how to plant';
+COMMENT ON COLUMN plant_descriptions.propagation_instructions IS 'Care guide: seed, cutting and division propagation';
+COMMENT ON COLUMN plant_descriptions.uses IS 'Care guide: culinary, medicinal, ornamental and other uses';
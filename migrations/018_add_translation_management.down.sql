@@ -0,0 +1,16 @@
+-- ============================================================================
+-- Migration 018 Rollback: Translation Management
+-- Description: Drop the characteristic lookup, change tracking and the trait
+--              and growing condition translation tables
+-- Date: 2026-10-16
+-- ============================================================================
+
+DROP FUNCTION IF EXISTS translate_characteristic(VARCHAR, VARCHAR, UUID);
+
+DROP INDEX IF EXISTS idx_plant_common_names_unique_global;
+
+ALTER TABLE companion_benefits_i18n DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE characteristic_translations DROP COLUMN IF EXISTS updated_at;
+
+DROP TABLE IF EXISTS growing_conditions_i18n;
+DROP TABLE IF EXISTS physical_traits_i18n;
@@ -0,0 +1,111 @@
+-- ============================================================================
+-- Migration 018: Translation Management
+-- Description: Create the trait and growing condition translation tables for
+--              the migrated schema, track when translations change, and point
+--              translate_characteristic at the characteristic_translations
+--              columns from migration 000002
+-- Date: 2026-10-16
+-- ============================================================================
+
+-- ============================================================================
+-- SECTION 1: Trait and Growing Condition Translations
+-- ============================================================================
+
+-- Same layout as 005_add_localization.sql, which migration 000002 left out
+
+CREATE TABLE IF NOT EXISTS physical_traits_i18n (
+    trait_i18n_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    characteristic_id UUID NOT NULL REFERENCES physical_characteristics(characteristic_id) ON DELETE CASCADE,
+    language_id UUID NOT NULL REFERENCES languages(language_id),
+
+    -- Keys match the original traits JSONB, values are translated
+    traits_localized JSONB NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_traits_translation
+        UNIQUE(characteristic_id, language_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_physical_traits_i18n_char ON physical_traits_i18n(characteristic_id);
+CREATE INDEX IF NOT EXISTS idx_physical_traits_i18n_lang ON physical_traits_i18n(language_id);
+
+CREATE TABLE IF NOT EXISTS growing_conditions_i18n (
+    growing_i18n_id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    assertion_id UUID NOT NULL REFERENCES growing_conditions_assertions(assertion_id) ON DELETE CASCADE,
+    language_id UUID NOT NULL REFERENCES languages(language_id),
+
+    soil_types_localized TEXT[],
+    special_requirements TEXT,
+    regional_tips TEXT,
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_growing_translation
+        UNIQUE(assertion_id, language_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_growing_conditions_i18n_assertion ON growing_conditions_i18n(assertion_id);
+CREATE INDEX IF NOT EXISTS idx_growing_conditions_i18n_language ON growing_conditions_i18n(language_id);
+
+-- ============================================================================
+-- SECTION 2: Change Tracking
+-- ============================================================================
+
+ALTER TABLE characteristic_translations ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE companion_benefits_i18n ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE physical_traits_i18n ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE growing_conditions_i18n ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP;
+
+-- The unique constraint treats NULL countries as distinct, so guard
+-- country-independent common names separately. Names are compared
+-- case-insensitively, as the translation import does. Existing duplicates
+-- are not removed automatically; the migration stops and lists them so
+-- they can be merged by hand.
+DO $$
+DECLARE
+    conflicts TEXT;
+BEGIN
+    SELECT string_agg(format('plant %s, language %s, name %L (%s rows)',
+                             plant_id, language_id, name, copies), E'\n')
+    INTO conflicts
+    FROM (
+        SELECT plant_id, language_id, LOWER(common_name) AS name, COUNT(*) AS copies
+        FROM plant_common_names
+        WHERE country_id IS NULL
+        GROUP BY plant_id, language_id, LOWER(common_name)
+        HAVING COUNT(*) > 1
+    ) duplicates;
+
+    IF conflicts IS NOT NULL THEN
+        RAISE EXCEPTION 'duplicate country-independent common names must be merged before migration 018'
+            USING DETAIL = conflicts;
+    END IF;
+END $$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_plant_common_names_unique_global
+ON plant_common_names(plant_id, language_id, LOWER(common_name))
+WHERE country_id IS NULL;
+
+-- ============================================================================
+-- SECTION 3: Characteristic Lookup
+-- ============================================================================
+
+-- Used by CharacteristicTranslator; returns the original value when untranslated
+CREATE OR REPLACE FUNCTION translate_characteristic(
+    p_characteristic_type VARCHAR(100),
+    p_characteristic_value VARCHAR(100),
+    p_language_id UUID
+) RETURNS VARCHAR(200) AS $$
+    SELECT COALESCE(
+        (SELECT translated_label
+         FROM characteristic_translations
+         WHERE characteristic_category = p_characteristic_type
+           AND characteristic_value = p_characteristic_value
+           AND language_id = p_language_id),
+        p_characteristic_value
+    );
+$$ LANGUAGE sql STABLE;
+
+COMMENT ON TABLE physical_traits_i18n IS 'Translated physical trait values, keyed like physical_characteristics.traits';
+COMMENT ON TABLE growing_conditions_i18n IS 'Translated soil types and notes for growing condition assertions';